@@ -1,14 +1,19 @@
 package aws
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
@@ -117,10 +122,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 			SessionToken:    c.Token,
 		}},
 		&awsCredentials.EnvProvider{},
-		&awsCredentials.SharedCredentialsProvider{
-			Filename: c.CredsFilename,
-			Profile:  c.Profile,
-		},
+		&sharedConfigProvider{config: c},
 	}
 
 	// Build isolated HTTP client to avoid issues with globally-shared settings
@@ -177,53 +179,77 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	}
 
 	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
-		return awsCredentials.NewChainCredentials(providers), nil
+	if len(c.AssumeRoles) == 0 && c.AssumeRoleWithWebIdentity == nil {
+		return newChainCredentials(providers), nil
 	}
 
-	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
-
-	creds := awsCredentials.NewChainCredentials(providers)
-	cp, err := creds.Get()
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, errors.New(`No valid credential sources found for AWS Provider.
+	var creds *awsCredentials.Credentials
+	if c.AssumeRoleWithWebIdentity != nil {
+		// The web identity token is the only source of credentials, so the
+		// role is assumed without any of the other providers.
+		var err error
+		creds, err = getWebIdentityCredentials(c)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Otherwise we need to construct and STS client with the main credentials, and verify
+		// that we can assume the defined roles.
+		creds = newChainCredentials(providers)
+		cp, err := creds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, errors.New(`No valid credential sources found for AWS Provider.
   Please see https://terraform.io/docs/providers/aws/index.html for more information on
   providing credentials for the AWS Provider`)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 		}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 	}
 
-	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	// Each role is assumed with the credentials of the previous one
+	for i, role := range c.AssumeRoles {
+		var err error
+		creds, err = getAssumeRoleCredentials(c, creds, role, i)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	awsConfig := &aws.Config{
-		Credentials:      creds,
-		Region:           aws.String(c.Region),
-		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	return creds, nil
+}
+
+// getAssumeRoleCredentials assumes the role at position index of the
+// assume_role chain with the given credentials.
+func getAssumeRoleCredentials(c *Config, creds *awsCredentials.Credentials, role *AssumeRole, index int) (*awsCredentials.Credentials, error) {
+	sessionName, err := renderAssumeRoleSessionName(role.SessionName, role.RoleARN, index)
+	if err != nil {
+		return nil, err
 	}
 
-	stsclient := sts.New(session.New(awsConfig))
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, DurationSeconds: %d)",
+		role.RoleARN, sessionName, role.ExternalID, role.Policy, role.DurationSeconds)
+
+	stsclient := sts.New(session.New(assumeRoleConfig(c, creds)))
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
-		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		Client:          stsclient,
+		RoleARN:         role.RoleARN,
+		RoleSessionName: sessionName,
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if role.ExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(role.ExternalID)
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if role.Policy != "" {
+		assumeRoleProvider.Policy = aws.String(role.Policy)
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	if role.DurationSeconds > 0 {
+		assumeRoleProvider.Duration = time.Duration(role.DurationSeconds) * time.Second
 	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+	providers := []awsCredentials.Provider{assumeRoleProvider}
 
 	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
 	_, err = assumeRoleCreds.Get()
@@ -234,7 +260,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 				"    * The credentials used in order to assume the role are invalid\n"+
 				"    * The credentials do not have appropriate permission to assume the role\n"+
 				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+				role.RoleARN)
 		}
 
 		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -243,6 +269,157 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+// getWebIdentityCredentials assumes the role of the
+// assume_role_with_web_identity block with the token in its token file.
+func getWebIdentityCredentials(c *Config) (*awsCredentials.Credentials, error) {
+	role := c.AssumeRoleWithWebIdentity
+
+	sessionName, err := renderAssumeRoleSessionName(role.SessionName, role.RoleARN, 0)
+	if err != nil {
+		return nil, err
+	}
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("terraform-%d", time.Now().UTC().UnixNano())
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, WebIdentityTokenFile: %q, Policy: %q, DurationSeconds: %d)",
+		role.RoleARN, sessionName, role.WebIdentityTokenFile, role.Policy, role.DurationSeconds)
+
+	creds := awsCredentials.NewCredentials(&webIdentityRoleProvider{
+		client:      sts.New(session.New(assumeRoleConfig(c, awsCredentials.AnonymousCredentials))),
+		role:        role,
+		sessionName: sessionName,
+	})
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("Error assuming role %q with web identity: %s", role.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// assumeRoleConfig returns the configuration of the STS client used to
+// assume a role with the given credentials.
+func assumeRoleConfig(c *Config, creds *awsCredentials.Credentials) *aws.Config {
+	return &aws.Config{
+		Credentials:      creds,
+		Region:           aws.String(c.Region),
+		MaxRetries:       aws.Int(c.MaxRetries),
+		HTTPClient:       cleanhttp.DefaultClient(),
+		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+		Endpoint:         aws.String(c.Endpoints["sts"]),
+	}
+}
+
+// webIdentityRoleProviderName is the name of the credentials provider for
+// assume_role_with_web_identity.
+const webIdentityRoleProviderName = "WebIdentityRoleProvider"
+
+// webIdentityRoleProvider retrieves credentials by assuming a role with a
+// web identity token. The token file is read again on every refresh, as
+// OIDC tokens issued to CI runners are short-lived and rotated in place.
+type webIdentityRoleProvider struct {
+	awsCredentials.Expiry
+
+	client      *sts.STS
+	role        *AssumeRoleWithWebIdentity
+	sessionName string
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := ioutil.ReadFile(p.role.WebIdentityTokenFile)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityRoleProviderName},
+			fmt.Errorf("Error reading web identity token file: %s", err)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.role.RoleARN),
+		RoleSessionName:  aws.String(p.sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	}
+	if p.role.Policy != "" {
+		input.Policy = aws.String(p.role.Policy)
+	}
+	if p.role.DurationSeconds > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.role.DurationSeconds))
+	}
+
+	resp, err := p.client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityRoleProviderName}, err
+	}
+
+	// Refresh the credentials a little before they expire
+	p.SetExpiration(aws.TimeValue(resp.Credentials.Expiration), time.Minute)
+
+	return awsCredentials.Value{
+		AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+		ProviderName:    webIdentityRoleProviderName,
+	}, nil
+}
+
+// assumeRoleSessionNameData is the data available to session_name templates.
+type assumeRoleSessionNameData struct {
+	// Index is the position of the role in the assume_role chain, from 0.
+	Index int
+	// AccountID and RoleName are those of the role being assumed.
+	AccountID string
+	RoleName  string
+	// Timestamp is the current time in seconds since the epoch.
+	Timestamp int64
+}
+
+var assumeRoleSessionNameRegexp = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+
+// renderAssumeRoleSessionName renders a session_name template for the role
+// at position index of the assume_role chain. Besides the fields of
+// assumeRoleSessionNameData, templates can read environment variables with
+// the env function, e.g. {{env "CI_JOB_ID"}}.
+func renderAssumeRoleSessionName(name, roleARN string, index int) (string, error) {
+	if !strings.Contains(name, "{{") {
+		return name, nil
+	}
+
+	tmpl, err := template.New("session_name").Funcs(template.FuncMap{"env": os.Getenv}).Parse(name)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing session_name %q: %s", name, err)
+	}
+
+	data := assumeRoleSessionNameData{
+		Index:     index,
+		Timestamp: time.Now().UTC().Unix(),
+	}
+	if parsed, err := arn.Parse(roleARN); err == nil {
+		data.AccountID = parsed.AccountID
+		data.RoleName = parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("Error rendering session_name %q: %s", name, err)
+	}
+
+	sessionName := buf.String()
+	if !assumeRoleSessionNameRegexp.MatchString(sessionName) {
+		return "", fmt.Errorf("session_name %q rendered to %q, which is not a valid role session name: "+
+			"it must be 2 to 64 characters long and only contain letters, digits and +=,.@_-", name, sessionName)
+	}
+
+	return sessionName, nil
+}
+
+// newChainCredentials returns credentials from the first of the providers
+// that succeeds, reporting the errors of all of them otherwise so that
+// misconfigured profiles can be told apart from missing credentials.
+func newChainCredentials(providers []awsCredentials.Provider) *awsCredentials.Credentials {
+	return awsCredentials.NewCredentials(&awsCredentials.ChainProvider{
+		Providers:     providers,
+		VerboseErrors: true,
+	})
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
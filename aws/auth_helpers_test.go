@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -671,6 +674,177 @@ func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_assumeRoleChain(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	api := newAwsMockApi(t)
+	defer api.Close()
+
+	var calls []string
+	api.Handle("sts", "AssumeRole", func(r *awsMockApiRequest) *awsMockResponse {
+		calls = append(calls, fmt.Sprintf("%s %s %s %s", testAwsMockAccessKey(r),
+			r.Params.Get("RoleArn"), r.Params.Get("RoleSessionName"), r.Params.Get("DurationSeconds")))
+		return testAwsMockAssumeRoleResponse("AssumeRole", fmt.Sprintf("hop%d", len(calls)))
+	})
+
+	cfg := Config{
+		AccessKey:            "base",
+		SecretKey:            "secret",
+		Region:               "us-east-1",
+		Endpoints:            map[string]string{"sts": api.URL},
+		SkipMetadataApiCheck: true,
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN:     "arn:aws:iam::111111111111:role/tooling",
+				SessionName: "tooling",
+			},
+			{
+				RoleARN:         "arn:aws:iam::222222222222:role/workload",
+				SessionName:     "{{.RoleName}}-{{.AccountID}}-{{.Index}}",
+				DurationSeconds: 3600,
+			},
+		},
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error getting creds: %s", err)
+	}
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error getting creds: %s", err)
+	}
+	if v.AccessKeyID != "hop2" {
+		t.Fatalf("Expected credentials of the last role, got %q", v.AccessKeyID)
+	}
+
+	expected := []string{
+		"base arn:aws:iam::111111111111:role/tooling tooling 900",
+		"hop1 arn:aws:iam::222222222222:role/workload workload-222222222222-1 3600",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("Expected AssumeRole calls %q, got %q", expected, calls)
+	}
+}
+
+func TestAWSGetCredentials_assumeRoleWithWebIdentity(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	api := newAwsMockApi(t)
+	defer api.Close()
+
+	tokenFile, err := ioutil.TempFile("", "tf-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+	tokenFile.WriteString("mock-token\n")
+	tokenFile.Close()
+
+	var calls []string
+	// AssumeRoleWithWebIdentity requests are not signed, so they have no
+	// service name.
+	api.Handle("", "AssumeRoleWithWebIdentity", func(r *awsMockApiRequest) *awsMockResponse {
+		calls = append(calls, fmt.Sprintf("%s %s %s", r.Params.Get("RoleArn"),
+			r.Params.Get("RoleSessionName"), r.Params.Get("WebIdentityToken")))
+		return testAwsMockAssumeRoleResponse("AssumeRoleWithWebIdentity", "web")
+	})
+	api.Handle("sts", "AssumeRole", func(r *awsMockApiRequest) *awsMockResponse {
+		calls = append(calls, fmt.Sprintf("%s %s", testAwsMockAccessKey(r), r.Params.Get("RoleArn")))
+		return testAwsMockAssumeRoleResponse("AssumeRole", "workload")
+	})
+
+	cfg := Config{
+		Region:               "us-east-1",
+		Endpoints:            map[string]string{"sts": api.URL},
+		SkipMetadataApiCheck: true,
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/ci",
+			SessionName:          "ci",
+			WebIdentityTokenFile: tokenFile.Name(),
+		},
+		AssumeRoles: []*AssumeRole{
+			{RoleARN: "arn:aws:iam::222222222222:role/workload"},
+		},
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error getting creds: %s", err)
+	}
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error getting creds: %s", err)
+	}
+	if v.AccessKeyID != "workload" {
+		t.Fatalf("Expected credentials of the last role, got %q", v.AccessKeyID)
+	}
+
+	expected := []string{
+		"arn:aws:iam::111111111111:role/ci ci mock-token",
+		"web arn:aws:iam::222222222222:role/workload",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("Expected calls %q, got %q", expected, calls)
+	}
+}
+
+func TestAWSRenderAssumeRoleSessionName(t *testing.T) {
+	os.Setenv("TF_TEST_SESSION_JOB", "1234")
+	defer os.Unsetenv("TF_TEST_SESSION_JOB")
+
+	cases := []struct {
+		Name        string
+		Expected    string
+		ExpectError bool
+	}{
+		{"", "", false},
+		{"static", "static", false},
+		{"{{.RoleName}}@{{.AccountID}}", "deploy@123456789012", false},
+		{"ci-{{env \"TF_TEST_SESSION_JOB\"}}-{{.Index}}", "ci-1234-2", false},
+		{"{{.Nope}}", "", true},
+		{"{{.RoleName", "", true},
+		{"{{env \"TF_TEST_SESSION_UNSET\"}}", "", true},
+		{"{{.RoleName}} with spaces", "", true},
+	}
+
+	for _, tc := range cases {
+		name, err := renderAssumeRoleSessionName(tc.Name, "arn:aws:iam::123456789012:role/path/deploy", 2)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected error for %q, got %q", tc.Name, name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error rendering %q: %s", tc.Name, err)
+		}
+		if name != tc.Expected {
+			t.Fatalf("Expected %q to render to %q, got %q", tc.Name, tc.Expected, name)
+		}
+	}
+}
+
+var testAwsMockAccessKeyRegexp = regexp.MustCompile(`Credential=([^/]+)/`)
+
+// testAwsMockAccessKey returns the access key a mock request is signed with.
+func testAwsMockAccessKey(r *awsMockApiRequest) string {
+	if m := testAwsMockAccessKeyRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// testAwsMockAssumeRoleResponse returns credentials using accessKey for an
+// STS AssumeRole* operation.
+func testAwsMockAssumeRoleResponse(operation, accessKey string) *awsMockResponse {
+	return awsMockQueryResponse(operation, fmt.Sprintf(`<Credentials><AccessKeyId>%[1]s</AccessKeyId>`+
+		`<SecretAccessKey>%[1]s-secret</SecretAccessKey><SessionToken>%[1]s-token</SessionToken>`+
+		`<Expiration>%[2]s</Expiration></Credentials>`, accessKey, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)))
+}
+
 func testGetAccountInfo(t *testing.T, iamSess, stsSess *session.Session, credProviderName string) {
 
 	iamConn := iam.New(iamSess)
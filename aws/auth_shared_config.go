@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/go-ini/ini"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const sharedConfigProviderName = "SharedConfigProvider"
+
+// sharedConfigProvider retrieves the credentials of a profile of the shared
+// credentials and config files, resolving it the way the AWS CLI does:
+//
+//   * role_arn assumes the role with the credentials of source_profile or
+//     credential_source, getting a token from mfa_token_command when
+//     mfa_serial is set
+//   * aws_access_key_id and aws_secret_access_key are static credentials
+//   * credential_process runs a command printing the credentials as JSON
+//
+// Settings of the credentials file take precedence over those of the config
+// file for the same profile.
+type sharedConfigProvider struct {
+	config *Config
+
+	// creds holds the credentials the profile resolved to, which are
+	// refreshed on their own once resolved.
+	creds *awsCredentials.Credentials
+}
+
+func (p *sharedConfigProvider) Retrieve() (awsCredentials.Value, error) {
+	if p.creds == nil {
+		profile := sharedConfigProfileName(p.config.Profile)
+		creds, err := p.resolve(profile, map[string]bool{})
+		if err != nil {
+			return awsCredentials.Value{ProviderName: sharedConfigProviderName}, err
+		}
+		p.creds = creds
+	}
+
+	v, err := p.creds.Get()
+	if err != nil {
+		return awsCredentials.Value{ProviderName: sharedConfigProviderName}, err
+	}
+	if v.ProviderName == "" {
+		v.ProviderName = sharedConfigProviderName
+	}
+	return v, nil
+}
+
+func (p *sharedConfigProvider) IsExpired() bool {
+	return p.creds == nil || p.creds.IsExpired()
+}
+
+// resolve returns the credentials of the named profile. visited holds the
+// profiles already followed through source_profile.
+func (p *sharedConfigProvider) resolve(name string, visited map[string]bool) (*awsCredentials.Credentials, error) {
+	if visited[name] {
+		return nil, fmt.Errorf("Profile %q is part of a source_profile loop", name)
+	}
+	visited[name] = true
+
+	profile, err := loadSharedConfigProfile(p.config.CredsFilename, p.config.ConfigFilename, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile["role_arn"] != "" {
+		source, err := p.resolveSource(name, profile, visited)
+		if err != nil {
+			return nil, err
+		}
+		return p.assumeRole(name, profile, source)
+	}
+
+	if creds := sharedConfigStaticCredentials(profile); creds != nil {
+		log.Printf("[INFO] Using static credentials of profile %q", name)
+		return creds, nil
+	}
+
+	if command := profile["credential_process"]; command != "" {
+		log.Printf("[INFO] Using credential_process of profile %q", name)
+		return awsCredentials.NewCredentials(&processCredentialsProvider{command: command}), nil
+	}
+
+	return nil, fmt.Errorf("Profile %q has no credentials", name)
+}
+
+// resolveSource returns the credentials the role of a profile is assumed
+// with.
+func (p *sharedConfigProvider) resolveSource(name string, profile map[string]string, visited map[string]bool) (*awsCredentials.Credentials, error) {
+	sourceProfile, credentialSource := profile["source_profile"], profile["credential_source"]
+
+	switch {
+	case sourceProfile != "" && credentialSource != "":
+		return nil, fmt.Errorf("Profile %q sets both source_profile and credential_source", name)
+
+	case sourceProfile == name:
+		// A profile can source its own static credentials
+		if creds := sharedConfigStaticCredentials(profile); creds != nil {
+			return creds, nil
+		}
+		return nil, fmt.Errorf("Profile %q is its own source_profile but has no static credentials", name)
+
+	case sourceProfile != "":
+		return p.resolve(sourceProfile, visited)
+	}
+
+	switch credentialSource {
+	case "Environment":
+		return awsCredentials.NewCredentials(&awsCredentials.EnvProvider{}), nil
+
+	case "Ec2InstanceMetadata":
+		cfg := &aws.Config{}
+		setOptionalEndpoint(cfg)
+		return awsCredentials.NewCredentials(&ec2rolecreds.EC2RoleProvider{
+			Client: ec2metadata.New(session.New(cfg)),
+		}), nil
+
+	case "EcsContainer":
+		return awsCredentials.NewCredentials(defaults.RemoteCredProvider(*defaults.Config(), defaults.Handlers())), nil
+
+	case "":
+		return nil, fmt.Errorf("Profile %q sets role_arn without source_profile or credential_source", name)
+	}
+
+	return nil, fmt.Errorf("Profile %q has an unsupported credential_source: %q", name, credentialSource)
+}
+
+// assumeRole returns the credentials of the role of a profile, assumed with
+// the credentials of its source.
+func (p *sharedConfigProvider) assumeRole(name string, profile map[string]string, source *awsCredentials.Credentials) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Assuming role %s of profile %q", profile["role_arn"], name)
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:          sts.New(session.New(assumeRoleConfig(p.config, source))),
+		RoleARN:         profile["role_arn"],
+		RoleSessionName: profile["role_session_name"],
+	}
+	if v := profile["external_id"]; v != "" {
+		provider.ExternalID = aws.String(v)
+	}
+	if v := profile["duration_seconds"]; v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("Profile %q has an invalid duration_seconds: %q", name, v)
+		}
+		provider.Duration = time.Duration(seconds) * time.Second
+	}
+	if v := profile["mfa_serial"]; v != "" {
+		if p.config.MFATokenCommand == "" {
+			return nil, fmt.Errorf("Profile %q sets mfa_serial, which requires mfa_token_command to be set", name)
+		}
+		provider.SerialNumber = aws.String(v)
+		provider.TokenProvider = func() (string, error) {
+			out, err := runSharedConfigCommand(p.config.MFATokenCommand)
+			if err != nil {
+				return "", fmt.Errorf("Error running mfa_token_command: %s", err)
+			}
+			return strings.TrimSpace(string(out)), nil
+		}
+	}
+
+	return awsCredentials.NewCredentials(provider), nil
+}
+
+// sharedConfigProfileName returns the profile to use when none is given in
+// the configuration.
+func sharedConfigProfileName(profile string) string {
+	if profile != "" {
+		return profile
+	}
+	if profile := os.Getenv("AWS_PROFILE"); profile != "" {
+		return profile
+	}
+	return "default"
+}
+
+// loadSharedConfigProfile returns the settings of a profile, read from the
+// config file and then the credentials file. Empty filenames default to the
+// locations used by the AWS CLI.
+func loadSharedConfigProfile(credsFilename, configFilename, name string) (map[string]string, error) {
+	if credsFilename == "" {
+		credsFilename = sharedConfigFilename("AWS_SHARED_CREDENTIALS_FILE", "credentials")
+	}
+	if configFilename == "" {
+		configFilename = sharedConfigFilename("AWS_CONFIG_FILE", "config")
+	}
+
+	profile := make(map[string]string)
+	found := false
+
+	// Profiles other than the default one are prefixed in the config file
+	configSection := "profile " + name
+	if name == "default" {
+		configSection = name
+	}
+
+	for _, f := range []struct {
+		filename, section string
+	}{
+		{configFilename, configSection},
+		{credsFilename, name},
+	} {
+		if _, err := os.Stat(f.filename); os.IsNotExist(err) {
+			continue
+		}
+
+		file, err := ini.Load(f.filename)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading %s: %s", f.filename, err)
+		}
+
+		section, err := file.GetSection(f.section)
+		if err != nil {
+			continue
+		}
+
+		found = true
+		for _, key := range section.Keys() {
+			profile[key.Name()] = key.String()
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("Profile %q not found in %s or %s", name, credsFilename, configFilename)
+	}
+
+	return profile, nil
+}
+
+func sharedConfigFilename(envVar, name string) string {
+	if filename := os.Getenv(envVar); filename != "" {
+		return filename
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".aws", name)
+}
+
+// sharedConfigStaticCredentials returns the static credentials of a profile,
+// or nil if it has none.
+func sharedConfigStaticCredentials(profile map[string]string) *awsCredentials.Credentials {
+	if profile["aws_access_key_id"] == "" || profile["aws_secret_access_key"] == "" {
+		return nil
+	}
+
+	return awsCredentials.NewStaticCredentialsFromCreds(awsCredentials.Value{
+		AccessKeyID:     profile["aws_access_key_id"],
+		SecretAccessKey: profile["aws_secret_access_key"],
+		SessionToken:    profile["aws_session_token"],
+		ProviderName:    sharedConfigProviderName,
+	})
+}
+
+const processCredentialsProviderName = "ProcessProvider"
+
+// processCredentialsProvider retrieves credentials from the JSON output of a
+// credential_process command.
+type processCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	command   string
+	expires   bool
+	retrieved bool
+}
+
+type processCredentialsOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+func (p *processCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	out, err := runSharedConfigCommand(p.command)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Error running credential_process: %s", err)
+	}
+
+	var output processCredentialsOutput
+	if err := json.Unmarshal(out, &output); err != nil {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Error parsing credential_process output: %s", err)
+	}
+	if output.Version != 1 {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Unsupported credential_process output version: %d", output.Version)
+	}
+	if output.AccessKeyId == "" || output.SecretAccessKey == "" {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("credential_process output is missing AccessKeyId or SecretAccessKey")
+	}
+
+	p.retrieved = true
+	p.expires = output.Expiration != nil
+	if p.expires {
+		// Refresh the credentials a little before they expire
+		p.SetExpiration(*output.Expiration, time.Minute)
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     output.AccessKeyId,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.SessionToken,
+		ProviderName:    processCredentialsProviderName,
+	}, nil
+}
+
+func (p *processCredentialsProvider) IsExpired() bool {
+	if !p.expires {
+		return !p.retrieved
+	}
+	return p.Expiry.IsExpired()
+}
+
+// runSharedConfigCommand runs a command of the shared config files or of
+// mfa_token_command with the shell, returning its standard output.
+func runSharedConfigCommand(command string) ([]byte, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Stderr = os.Stderr
+
+	return cmd.Output()
+}
@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// testSharedConfigFiles writes the given shared credentials and config files
+// to a temporary directory, returning their paths and a function removing
+// them.
+func testSharedConfigFiles(t *testing.T, credentials, config string) (string, string, func()) {
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credsFilename := filepath.Join(dir, "credentials")
+	configFilename := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(credsFilename, []byte(credentials), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(configFilename, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return credsFilename, configFilename, func() { os.RemoveAll(dir) }
+}
+
+func TestAWSSharedConfigProvider_static(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	credsFilename, configFilename, remove := testSharedConfigFiles(t, `
+[default]
+aws_access_key_id = default_key
+aws_secret_access_key = default_secret
+
+[both]
+aws_access_key_id = creds_key
+aws_secret_access_key = creds_secret
+`, `
+[profile both]
+aws_access_key_id = config_key
+aws_secret_access_key = config_secret
+
+[profile config_only]
+aws_access_key_id = config_only_key
+aws_secret_access_key = config_only_secret
+aws_session_token = config_only_token
+`)
+	defer remove()
+
+	cases := []struct {
+		Profile, AccessKey, SessionToken string
+	}{
+		{"", "default_key", ""},
+		{"both", "creds_key", ""},
+		{"config_only", "config_only_key", "config_only_token"},
+	}
+
+	for _, tc := range cases {
+		p := &sharedConfigProvider{config: &Config{
+			CredsFilename:  credsFilename,
+			ConfigFilename: configFilename,
+			Profile:        tc.Profile,
+		}}
+		v, err := p.Retrieve()
+		if err != nil {
+			t.Fatalf("Error retrieving credentials of profile %q: %s", tc.Profile, err)
+		}
+		if v.AccessKeyID != tc.AccessKey || v.SessionToken != tc.SessionToken {
+			t.Fatalf("Expected credentials %q/%q for profile %q, got %q/%q",
+				tc.AccessKey, tc.SessionToken, tc.Profile, v.AccessKeyID, v.SessionToken)
+		}
+	}
+
+	os.Setenv("AWS_PROFILE", "both")
+	p := &sharedConfigProvider{config: &Config{CredsFilename: credsFilename, ConfigFilename: configFilename}}
+	if v, err := p.Retrieve(); err != nil || v.AccessKeyID != "creds_key" {
+		t.Fatalf("Expected AWS_PROFILE to be used, got %q (%v)", v.AccessKeyID, err)
+	}
+}
+
+func TestAWSSharedConfigProvider_sourceProfile(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	api := newAwsMockApi(t)
+	defer api.Close()
+
+	var calls []string
+	api.Handle("sts", "AssumeRole", func(r *awsMockApiRequest) *awsMockResponse {
+		calls = append(calls, strings.Join([]string{testAwsMockAccessKey(r), r.Params.Get("RoleArn"),
+			r.Params.Get("RoleSessionName"), r.Params.Get("ExternalId"), r.Params.Get("DurationSeconds"),
+			r.Params.Get("SerialNumber"), r.Params.Get("TokenCode")}, " "))
+		return testAwsMockAssumeRoleResponse("AssumeRole", fmt.Sprintf("role%d", len(calls)))
+	})
+
+	credsFilename, configFilename, remove := testSharedConfigFiles(t, `
+[base]
+aws_access_key_id = base_key
+aws_secret_access_key = base_secret
+`, `
+[profile tooling]
+role_arn = arn:aws:iam::111111111111:role/tooling
+source_profile = base
+role_session_name = tooling-session
+external_id = tooling-external-id
+
+[profile workload]
+role_arn = arn:aws:iam::222222222222:role/workload
+source_profile = tooling
+duration_seconds = 3600
+mfa_serial = arn:aws:iam::111111111111:mfa/user
+`)
+	defer remove()
+
+	config := &Config{
+		CredsFilename:   credsFilename,
+		ConfigFilename:  configFilename,
+		Profile:         "workload",
+		MFATokenCommand: "echo 123456",
+		Region:          "us-east-1",
+		Endpoints:       map[string]string{"sts": api.URL},
+	}
+
+	v, err := (&sharedConfigProvider{config: config}).Retrieve()
+	if err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if v.AccessKeyID != "role2" {
+		t.Fatalf("Expected credentials of the workload role, got %q", v.AccessKeyID)
+	}
+
+	expected := "base_key arn:aws:iam::111111111111:role/tooling tooling-session tooling-external-id 900  "
+	if len(calls) != 2 || calls[0] != expected {
+		t.Fatalf("Expected tooling role to be assumed with base credentials, got %q", calls)
+	}
+	if !strings.HasPrefix(calls[1], "role1 arn:aws:iam::222222222222:role/workload ") ||
+		!strings.HasSuffix(calls[1], " 3600 arn:aws:iam::111111111111:mfa/user 123456") {
+		t.Fatalf("Expected workload role to be assumed with tooling credentials and MFA, got %q", calls[1])
+	}
+
+	config.MFATokenCommand = ""
+	if _, err := (&sharedConfigProvider{config: config}).Retrieve(); err == nil ||
+		!strings.Contains(err.Error(), "mfa_token_command") {
+		t.Fatalf("Expected error without mfa_token_command, got %v", err)
+	}
+}
+
+func TestAWSSharedConfigProvider_credentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test commands require a POSIX shell")
+	}
+
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	credsFilename, configFilename, remove := testSharedConfigFiles(t, "", `
+[profile process]
+credential_process = printf '{"Version": 1, "AccessKeyId": "process_key", "SecretAccessKey": "process_secret", "SessionToken": "process_token", "Expiration": "2100-01-01T00:00:00Z"}'
+
+[profile bad_version]
+credential_process = printf '{"Version": 2, "AccessKeyId": "process_key", "SecretAccessKey": "process_secret"}'
+
+[profile failing]
+credential_process = exit 1
+`)
+	defer remove()
+
+	p := &sharedConfigProvider{config: &Config{
+		CredsFilename:  credsFilename,
+		ConfigFilename: configFilename,
+		Profile:        "process",
+	}}
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if v.AccessKeyID != "process_key" || v.SecretAccessKey != "process_secret" || v.SessionToken != "process_token" {
+		t.Fatalf("Unexpected credentials: %#v", v)
+	}
+	if p.IsExpired() {
+		t.Fatalf("Expected credentials not to be expired")
+	}
+
+	for _, profile := range []string{"bad_version", "failing"} {
+		p := &sharedConfigProvider{config: &Config{
+			CredsFilename:  credsFilename,
+			ConfigFilename: configFilename,
+			Profile:        profile,
+		}}
+		if _, err := p.Retrieve(); err == nil {
+			t.Fatalf("Expected error for profile %q", profile)
+		}
+	}
+}
+
+func TestAWSSharedConfigProvider_errors(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	credsFilename, configFilename, remove := testSharedConfigFiles(t, "", `
+[profile loop_a]
+role_arn = arn:aws:iam::111111111111:role/a
+source_profile = loop_b
+
+[profile loop_b]
+role_arn = arn:aws:iam::111111111111:role/b
+source_profile = loop_a
+
+[profile no_source]
+role_arn = arn:aws:iam::111111111111:role/a
+
+[profile both_sources]
+role_arn = arn:aws:iam::111111111111:role/a
+source_profile = loop_a
+credential_source = Environment
+
+[profile bad_source]
+role_arn = arn:aws:iam::111111111111:role/a
+credential_source = Somewhere
+
+[profile empty]
+region = us-west-2
+`)
+	defer remove()
+
+	cases := map[string]string{
+		"loop_a":       "source_profile loop",
+		"no_source":    "without source_profile or credential_source",
+		"both_sources": "both source_profile and credential_source",
+		"bad_source":   "unsupported credential_source",
+		"empty":        "has no credentials",
+		"missing":      "not found",
+	}
+
+	results := make(map[string]string)
+	for profile, expected := range cases {
+		p := &sharedConfigProvider{config: &Config{
+			CredsFilename:  credsFilename,
+			ConfigFilename: configFilename,
+			Profile:        profile,
+		}}
+		_, err := p.Retrieve()
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			results[profile] = fmt.Sprintf("%v", err)
+		}
+	}
+	if !reflect.DeepEqual(results, map[string]string{}) {
+		t.Fatalf("Unexpected errors: %q", results)
+	}
+}
+
+func TestAWSGetCredentials_sharedConfigProfile(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	credsFilename, configFilename, remove := testSharedConfigFiles(t, "", `
+[profile env_source]
+role_arn = arn:aws:iam::111111111111:role/a
+credential_source = Somewhere
+`)
+	defer remove()
+
+	// Errors resolving the profile are reported rather than only the lack
+	// of any credentials.
+	creds, err := GetCredentials(&Config{
+		CredsFilename:        credsFilename,
+		ConfigFilename:       configFilename,
+		Profile:              "env_source",
+		SkipMetadataApiCheck: true,
+	})
+	if err != nil {
+		t.Fatalf("Error getting credentials: %s", err)
+	}
+	if _, err := creds.Get(); err == nil || !strings.Contains(err.Error(), "unsupported credential_source") {
+		t.Fatalf("Expected profile error, got %v", err)
+	}
+}
@@ -0,0 +1,831 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const awsMockAccountId = "123456789012"
+
+// awsMockApi is an in-process stand-in for the AWS APIs. Unlike
+// getMockedAwsApiSession, which serves a fixed list of requests for a single
+// service, requests are routed by the signing name of the calling client and
+// the API operation, so one server can back every endpoint of a provider.
+//
+// Operations are resolved from the Action parameter for query protocol
+// services, from the X-Amz-Target header for JSON protocol services and from
+// "<METHOD> <path>" for REST services.
+type awsMockApi struct {
+	*httptest.Server
+
+	t        *testing.T
+	mu       sync.Mutex
+	handlers map[string]awsMockApiHandler
+}
+
+// awsMockApiHandler returns the response for a single mocked API call. It is
+// always invoked with the awsMockApi lock held, so handlers sharing state do
+// not need any further synchronisation.
+type awsMockApiHandler func(*awsMockApiRequest) *awsMockResponse
+
+type awsMockApiRequest struct {
+	Service   string
+	Region    string
+	Operation string
+	Params    url.Values
+	Body      []byte
+	Header    http.Header
+}
+
+var awsMockCredentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/([^/]+)/([^/]+)/aws4_request`)
+
+func newAwsMockApi(t *testing.T) *awsMockApi {
+	api := &awsMockApi{
+		t:        t,
+		handlers: make(map[string]awsMockApiHandler),
+	}
+	api.Server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	return api
+}
+
+// Handle registers h for the given service signing name (e.g. "sqs") and
+// operation (e.g. "CreateQueue"). Unsigned requests have an empty service
+// name.
+func (api *awsMockApi) Handle(service, operation string, h awsMockApiHandler) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.handlers[service+"/"+operation] = h
+}
+
+// HandleCanned registers a fixed response for the given service and operation.
+func (api *awsMockApi) HandleCanned(service, operation string, resp *awsMockResponse) {
+	api.Handle(service, operation, func(*awsMockApiRequest) *awsMockResponse {
+		return resp
+	})
+}
+
+func (api *awsMockApi) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(r.Body)
+
+	req := &awsMockApiRequest{
+		Body:   buf.Bytes(),
+		Header: r.Header,
+	}
+	if m := awsMockCredentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		req.Region = m[1]
+		req.Service = m[2]
+	}
+
+	switch {
+	case r.Header.Get("X-Amz-Target") != "":
+		target := r.Header.Get("X-Amz-Target")
+		req.Operation = target[strings.LastIndex(target, ".")+1:]
+	case strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"):
+		req.Params, _ = url.ParseQuery(buf.String())
+		req.Operation = req.Params.Get("Action")
+	default:
+		req.Params = r.URL.Query()
+		req.Operation = r.Method + " " + r.URL.Path
+	}
+
+	log.Printf("[DEBUG] Mock AWS API received %s %s request: %s", req.Service, req.Operation, buf.String())
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	h, ok := api.handlers[req.Service+"/"+req.Operation]
+	if !ok {
+		api.t.Errorf("Mock AWS API has no handler for %s %s", req.Service, req.Operation)
+		writeAwsMockResponse(w, awsMockQueryError(http.StatusBadRequest, "InvalidAction",
+			fmt.Sprintf("%s %s is not mocked", req.Service, req.Operation)))
+		return
+	}
+
+	resp := h(req)
+	log.Printf("[DEBUG] Mock AWS API responding with %d: %s", resp.StatusCode, resp.Body)
+	writeAwsMockResponse(w, resp)
+}
+
+// ProviderConfig returns a provider block pointing every configurable endpoint
+// at the mock server, with all startup calls to AWS disabled. Any extra
+// arguments are added to the provider block as is.
+func (api *awsMockApi) ProviderConfig(extra ...string) string {
+	endpoints := endpointsSchema().Elem.(*schema.Resource).Schema
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		buf.WriteString(fmt.Sprintf("    %s = %q\n", name, api.URL))
+	}
+
+	return fmt.Sprintf(`
+provider "aws" {
+  region                      = "us-east-1"
+  access_key                  = "mock-access-key"
+  secret_key                  = "mock-secret-key"
+  max_retries                 = 1
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+%s
+  endpoints {
+%s  }
+}
+`, strings.Join(extra, "\n"), buf.String())
+}
+
+// Providers returns a fresh provider set so that tests against different mock
+// servers don't share a configured provider.
+func (api *awsMockApi) Providers() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"aws": Provider(),
+	}
+}
+
+func writeAwsMockResponse(w http.ResponseWriter, resp *awsMockResponse) {
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("X-Amzn-Requestid", "1b206dd1-f9a8-11e5-becf-051c60f11c4a")
+	w.Header().Set("Date", time.Now().Format(time.RFC1123))
+	w.WriteHeader(resp.StatusCode)
+
+	fmt.Fprintln(w, resp.Body)
+}
+
+// awsMockQueryResponse wraps result in the envelope used by query protocol
+// services such as IAM, SNS and SQS.
+func awsMockQueryResponse(operation, result string) *awsMockResponse {
+	return &awsMockResponse{
+		StatusCode: http.StatusOK,
+		Body: fmt.Sprintf(`<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult>`+
+			`<ResponseMetadata><RequestId>1b206dd1-f9a8-11e5-becf-051c60f11c4a</RequestId></ResponseMetadata>`+
+			`</%[1]sResponse>`, operation, result),
+		ContentType: "text/xml",
+	}
+}
+
+func awsMockQueryError(statusCode int, code, message string) *awsMockResponse {
+	return &awsMockResponse{
+		StatusCode: statusCode,
+		Body: fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error>`+
+			`<RequestId>1b206dd1-f9a8-11e5-becf-051c60f11c4a</RequestId></ErrorResponse>`, code, awsMockXmlEscape(message)),
+		ContentType: "text/xml",
+	}
+}
+
+// awsMockJsonResponse marshals result as the body of a JSON or REST-JSON
+// protocol response.
+func awsMockJsonResponse(result interface{}) *awsMockResponse {
+	b, _ := json.Marshal(result)
+	return &awsMockResponse{
+		StatusCode:  http.StatusOK,
+		Body:        string(b),
+		ContentType: "application/json",
+	}
+}
+
+// awsMockQueryMap reads a flattened query protocol map such as
+// Attribute.1.Name=x&Attribute.1.Value=y.
+func awsMockQueryMap(params url.Values, prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.%d.%s", prefix, i, keyName)
+		if _, ok := params[k]; !ok {
+			return m
+		}
+		m[params.Get(k)] = params.Get(fmt.Sprintf("%s.%d.%s", prefix, i, valueName))
+	}
+}
+
+// awsMockQueryList reads a flattened query protocol list such as
+// TagKey.1=x&TagKey.2=y.
+func awsMockQueryList(params url.Values, prefix string) []string {
+	var l []string
+	for i := 1; ; i++ {
+		k := prefix + "." + strconv.Itoa(i)
+		if _, ok := params[k]; !ok {
+			return l
+		}
+		l = append(l, params.Get(k))
+	}
+}
+
+func awsMockXmlEscape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// awsMockSqs is a stateful fake of the SQS queue and tagging operations.
+type awsMockSqs struct {
+	api    *awsMockApi
+	queues map[string]*awsMockSqsQueue
+}
+
+type awsMockSqsQueue struct {
+	region     string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+func newAwsMockSqs(api *awsMockApi) *awsMockSqs {
+	m := &awsMockSqs{
+		api:    api,
+		queues: make(map[string]*awsMockSqsQueue),
+	}
+
+	api.Handle("sqs", "CreateQueue", m.createQueue)
+	api.Handle("sqs", "GetQueueAttributes", m.withQueue(m.getQueueAttributes))
+	api.Handle("sqs", "SetQueueAttributes", m.withQueue(m.setQueueAttributes))
+	api.Handle("sqs", "ListQueueTags", m.withQueue(m.listQueueTags))
+	api.Handle("sqs", "TagQueue", m.withQueue(m.tagQueue))
+	api.Handle("sqs", "UntagQueue", m.withQueue(m.untagQueue))
+	api.Handle("sqs", "DeleteQueue", m.withQueue(m.deleteQueue))
+
+	return m
+}
+
+// Count returns the number of queues currently held by the fake.
+func (m *awsMockSqs) Count() int {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	return len(m.queues)
+}
+
+// Tag adds a tag to the named queue out of band, as another tool would.
+func (m *awsMockSqs) Tag(name, key, value string) {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	m.queues[fmt.Sprintf("%s/%s/%s", m.api.URL, awsMockAccountId, name)].tags[key] = value
+}
+
+// Tags returns a copy of the tags of the named queue.
+func (m *awsMockSqs) Tags(name string) map[string]string {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	tags := make(map[string]string)
+	for k, v := range m.queues[fmt.Sprintf("%s/%s/%s", m.api.URL, awsMockAccountId, name)].tags {
+		tags[k] = v
+	}
+	return tags
+}
+
+// Region returns the region the named queue was created in.
+func (m *awsMockSqs) Region(name string) string {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	return m.queues[fmt.Sprintf("%s/%s/%s", m.api.URL, awsMockAccountId, name)].region
+}
+
+func (m *awsMockSqs) createQueue(r *awsMockApiRequest) *awsMockResponse {
+	name := r.Params.Get("QueueName")
+	queueUrl := fmt.Sprintf("%s/%s/%s", m.api.URL, awsMockAccountId, name)
+
+	if _, ok := m.queues[queueUrl]; !ok {
+		q := &awsMockSqsQueue{
+			region: r.Region,
+			attributes: map[string]string{
+				"DelaySeconds":                  "0",
+				"MaximumMessageSize":            "262144",
+				"MessageRetentionPeriod":        "345600",
+				"ReceiveMessageWaitTimeSeconds": "0",
+				"VisibilityTimeout":             "30",
+				"QueueArn":                      fmt.Sprintf("arn:aws:sqs:%s:%s:%s", r.Region, awsMockAccountId, name),
+			},
+			tags: make(map[string]string),
+		}
+		for k, v := range awsMockQueryMap(r.Params, "Attribute", "Name", "Value") {
+			q.attributes[k] = v
+		}
+		m.queues[queueUrl] = q
+	}
+
+	return awsMockQueryResponse("CreateQueue", fmt.Sprintf("<QueueUrl>%s</QueueUrl>", awsMockXmlEscape(queueUrl)))
+}
+
+func (m *awsMockSqs) withQueue(f func(*awsMockApiRequest, string, *awsMockSqsQueue) *awsMockResponse) awsMockApiHandler {
+	return func(r *awsMockApiRequest) *awsMockResponse {
+		queueUrl := r.Params.Get("QueueUrl")
+		// Queues are only visible to clients of the region they were created in
+		q, ok := m.queues[queueUrl]
+		if !ok || q.region != r.Region {
+			return awsMockQueryError(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue",
+				"The specified queue does not exist for this wsdl version.")
+		}
+		return f(r, queueUrl, q)
+	}
+}
+
+func (m *awsMockSqs) getQueueAttributes(r *awsMockApiRequest, _ string, q *awsMockSqsQueue) *awsMockResponse {
+	var buf bytes.Buffer
+	for k, v := range q.attributes {
+		buf.WriteString(fmt.Sprintf("<Attribute><Name>%s</Name><Value>%s</Value></Attribute>", k, awsMockXmlEscape(v)))
+	}
+	return awsMockQueryResponse("GetQueueAttributes", buf.String())
+}
+
+func (m *awsMockSqs) setQueueAttributes(r *awsMockApiRequest, _ string, q *awsMockSqsQueue) *awsMockResponse {
+	for k, v := range awsMockQueryMap(r.Params, "Attribute", "Name", "Value") {
+		q.attributes[k] = v
+	}
+	return awsMockQueryResponse("SetQueueAttributes", "")
+}
+
+func (m *awsMockSqs) listQueueTags(r *awsMockApiRequest, _ string, q *awsMockSqsQueue) *awsMockResponse {
+	var buf bytes.Buffer
+	for k, v := range q.tags {
+		buf.WriteString(fmt.Sprintf("<Tag><Key>%s</Key><Value>%s</Value></Tag>", awsMockXmlEscape(k), awsMockXmlEscape(v)))
+	}
+	return awsMockQueryResponse("ListQueueTags", buf.String())
+}
+
+func (m *awsMockSqs) tagQueue(r *awsMockApiRequest, _ string, q *awsMockSqsQueue) *awsMockResponse {
+	for k, v := range awsMockQueryMap(r.Params, "Tag", "Key", "Value") {
+		q.tags[k] = v
+	}
+	return awsMockQueryResponse("TagQueue", "")
+}
+
+func (m *awsMockSqs) untagQueue(r *awsMockApiRequest, _ string, q *awsMockSqsQueue) *awsMockResponse {
+	for _, k := range awsMockQueryList(r.Params, "TagKey") {
+		delete(q.tags, k)
+	}
+	return awsMockQueryResponse("UntagQueue", "")
+}
+
+func (m *awsMockSqs) deleteQueue(r *awsMockApiRequest, queueUrl string, _ *awsMockSqsQueue) *awsMockResponse {
+	delete(m.queues, queueUrl)
+	return awsMockQueryResponse("DeleteQueue", "")
+}
+
+// awsMockSns is a stateful fake of the SNS topic operations.
+type awsMockSns struct {
+	api    *awsMockApi
+	topics map[string]map[string]string
+}
+
+func newAwsMockSns(api *awsMockApi) *awsMockSns {
+	m := &awsMockSns{
+		api:    api,
+		topics: make(map[string]map[string]string),
+	}
+
+	api.Handle("sns", "CreateTopic", m.createTopic)
+	api.Handle("sns", "GetTopicAttributes", m.withTopic(m.getTopicAttributes))
+	api.Handle("sns", "SetTopicAttributes", m.withTopic(m.setTopicAttributes))
+	api.Handle("sns", "DeleteTopic", m.withTopic(m.deleteTopic))
+
+	return m
+}
+
+// Count returns the number of topics currently held by the fake.
+func (m *awsMockSns) Count() int {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	return len(m.topics)
+}
+
+func (m *awsMockSns) createTopic(r *awsMockApiRequest) *awsMockResponse {
+	arn := fmt.Sprintf("arn:aws:sns:us-east-1:%s:%s", awsMockAccountId, r.Params.Get("Name"))
+
+	if _, ok := m.topics[arn]; !ok {
+		m.topics[arn] = map[string]string{
+			"TopicArn":    arn,
+			"Owner":       awsMockAccountId,
+			"DisplayName": "",
+		}
+	}
+
+	return awsMockQueryResponse("CreateTopic", fmt.Sprintf("<TopicArn>%s</TopicArn>", arn))
+}
+
+func (m *awsMockSns) withTopic(f func(*awsMockApiRequest, string, map[string]string) *awsMockResponse) awsMockApiHandler {
+	return func(r *awsMockApiRequest) *awsMockResponse {
+		arn := r.Params.Get("TopicArn")
+		attributes, ok := m.topics[arn]
+		if !ok {
+			return awsMockQueryError(http.StatusNotFound, "NotFound", "Topic does not exist")
+		}
+		return f(r, arn, attributes)
+	}
+}
+
+func (m *awsMockSns) getTopicAttributes(r *awsMockApiRequest, _ string, attributes map[string]string) *awsMockResponse {
+	var buf bytes.Buffer
+	buf.WriteString("<Attributes>")
+	for k, v := range attributes {
+		buf.WriteString(fmt.Sprintf("<entry><key>%s</key><value>%s</value></entry>", k, awsMockXmlEscape(v)))
+	}
+	buf.WriteString("</Attributes>")
+	return awsMockQueryResponse("GetTopicAttributes", buf.String())
+}
+
+func (m *awsMockSns) setTopicAttributes(r *awsMockApiRequest, _ string, attributes map[string]string) *awsMockResponse {
+	attributes[r.Params.Get("AttributeName")] = r.Params.Get("AttributeValue")
+	return awsMockQueryResponse("SetTopicAttributes", "")
+}
+
+func (m *awsMockSns) deleteTopic(r *awsMockApiRequest, arn string, _ map[string]string) *awsMockResponse {
+	delete(m.topics, arn)
+	return awsMockQueryResponse("DeleteTopic", "")
+}
+
+// awsMockIamRoles is a stateful fake of the IAM role operations. Roles are
+// never attached to instance profiles or policies.
+type awsMockIamRoles struct {
+	api   *awsMockApi
+	roles map[string]*awsMockIamRole
+}
+
+type awsMockIamRole struct {
+	name             string
+	path             string
+	description      string
+	assumeRolePolicy string
+	createDate       time.Time
+}
+
+func newAwsMockIamRoles(api *awsMockApi) *awsMockIamRoles {
+	m := &awsMockIamRoles{
+		api:   api,
+		roles: make(map[string]*awsMockIamRole),
+	}
+
+	api.Handle("iam", "CreateRole", m.createRole)
+	api.Handle("iam", "GetRole", m.withRole(m.getRole))
+	api.Handle("iam", "UpdateAssumeRolePolicy", m.withRole(m.updateAssumeRolePolicy))
+	api.Handle("iam", "UpdateRoleDescription", m.withRole(m.updateRoleDescription))
+	api.Handle("iam", "ListInstanceProfilesForRole", m.withRole(m.listInstanceProfilesForRole))
+	api.Handle("iam", "ListAttachedRolePolicies", m.withRole(m.listAttachedRolePolicies))
+	api.Handle("iam", "ListRolePolicies", m.withRole(m.listRolePolicies))
+	api.Handle("iam", "DeleteRole", m.withRole(m.deleteRole))
+
+	return m
+}
+
+// Count returns the number of roles currently held by the fake.
+func (m *awsMockIamRoles) Count() int {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	return len(m.roles)
+}
+
+func (m *awsMockIamRoles) createRole(r *awsMockApiRequest) *awsMockResponse {
+	name := r.Params.Get("RoleName")
+	if _, ok := m.roles[name]; ok {
+		return awsMockQueryError(http.StatusConflict, "EntityAlreadyExists",
+			fmt.Sprintf("Role with name %s already exists.", name))
+	}
+
+	role := &awsMockIamRole{
+		name:             name,
+		path:             r.Params.Get("Path"),
+		description:      r.Params.Get("Description"),
+		assumeRolePolicy: r.Params.Get("AssumeRolePolicyDocument"),
+		createDate:       time.Now().UTC().Truncate(time.Second),
+	}
+	if role.path == "" {
+		role.path = "/"
+	}
+	m.roles[name] = role
+
+	return awsMockQueryResponse("CreateRole", m.roleXml(role))
+}
+
+func (m *awsMockIamRoles) withRole(f func(*awsMockApiRequest, *awsMockIamRole) *awsMockResponse) awsMockApiHandler {
+	return func(r *awsMockApiRequest) *awsMockResponse {
+		name := r.Params.Get("RoleName")
+		role, ok := m.roles[name]
+		if !ok {
+			return awsMockQueryError(http.StatusNotFound, "NoSuchEntity",
+				fmt.Sprintf("The role with name %s cannot be found.", name))
+		}
+		return f(r, role)
+	}
+}
+
+func (m *awsMockIamRoles) roleXml(role *awsMockIamRole) string {
+	return fmt.Sprintf("<Role><Path>%s</Path><RoleName>%s</RoleName><RoleId>AROA%s</RoleId>"+
+		"<Arn>arn:aws:iam::%s:role%s%s</Arn><CreateDate>%s</CreateDate>"+
+		"<AssumeRolePolicyDocument>%s</AssumeRolePolicyDocument><Description>%s</Description></Role>",
+		role.path, role.name, strings.ToUpper(role.name), awsMockAccountId, role.path, role.name,
+		role.createDate.Format(time.RFC3339), url.QueryEscape(role.assumeRolePolicy),
+		awsMockXmlEscape(role.description))
+}
+
+func (m *awsMockIamRoles) getRole(r *awsMockApiRequest, role *awsMockIamRole) *awsMockResponse {
+	return awsMockQueryResponse("GetRole", m.roleXml(role))
+}
+
+func (m *awsMockIamRoles) updateAssumeRolePolicy(r *awsMockApiRequest, role *awsMockIamRole) *awsMockResponse {
+	role.assumeRolePolicy = r.Params.Get("PolicyDocument")
+	return awsMockQueryResponse("UpdateAssumeRolePolicy", "")
+}
+
+func (m *awsMockIamRoles) updateRoleDescription(r *awsMockApiRequest, role *awsMockIamRole) *awsMockResponse {
+	role.description = r.Params.Get("Description")
+	return awsMockQueryResponse("UpdateRoleDescription", m.roleXml(role))
+}
+
+func (m *awsMockIamRoles) listInstanceProfilesForRole(r *awsMockApiRequest, _ *awsMockIamRole) *awsMockResponse {
+	return awsMockQueryResponse("ListInstanceProfilesForRole", "<InstanceProfiles/><IsTruncated>false</IsTruncated>")
+}
+
+func (m *awsMockIamRoles) listAttachedRolePolicies(r *awsMockApiRequest, _ *awsMockIamRole) *awsMockResponse {
+	return awsMockQueryResponse("ListAttachedRolePolicies", "<AttachedPolicies/><IsTruncated>false</IsTruncated>")
+}
+
+func (m *awsMockIamRoles) listRolePolicies(r *awsMockApiRequest, _ *awsMockIamRole) *awsMockResponse {
+	return awsMockQueryResponse("ListRolePolicies", "<PolicyNames/><IsTruncated>false</IsTruncated>")
+}
+
+func (m *awsMockIamRoles) deleteRole(r *awsMockApiRequest, role *awsMockIamRole) *awsMockResponse {
+	delete(m.roles, role.name)
+	return awsMockQueryResponse("DeleteRole", "")
+}
+
+// awsMockGuardDuty is a stateful fake of a GuardDuty master detector and of
+// the detector of its single member account. An invitation stays in email
+// verification until the member account accepts it, so the master never sees
+// the member as invited, only as enabled once the invitation is accepted.
+type awsMockGuardDuty struct {
+	api      *awsMockApi
+	members  map[string]*awsMockGuardDutyMember
+	accepted bool
+}
+
+type awsMockGuardDutyMember struct {
+	email  string
+	status string
+}
+
+const (
+	awsMockGuardDutyMasterDetectorId = "00000000000000000000000000000001"
+	awsMockGuardDutyMemberDetectorId = "00000000000000000000000000000002"
+	awsMockGuardDutyInvitationId     = "00000000000000000000000000000003"
+)
+
+func newAwsMockGuardDuty(api *awsMockApi) *awsMockGuardDuty {
+	m := &awsMockGuardDuty{
+		api:     api,
+		members: make(map[string]*awsMockGuardDutyMember),
+	}
+
+	master := "/detector/" + awsMockGuardDutyMasterDetectorId
+	member := "/detector/" + awsMockGuardDutyMemberDetectorId
+	api.Handle("guardduty", "POST "+master+"/member", m.createMembers)
+	api.Handle("guardduty", "POST "+master+"/member/get", m.getMembers)
+	api.Handle("guardduty", "POST "+master+"/member/invite", m.inviteMembers)
+	api.Handle("guardduty", "POST "+master+"/member/delete", m.deleteMembers)
+	api.Handle("guardduty", "GET /invitation", m.listInvitations)
+	api.Handle("guardduty", "POST "+member+"/master", m.acceptInvitation)
+	api.Handle("guardduty", "GET "+member+"/master", m.getMasterAccount)
+	api.Handle("guardduty", "POST "+member+"/master/disassociate", m.disassociateFromMasterAccount)
+	return m
+}
+
+// Count returns the number of members currently held by the fake.
+func (m *awsMockGuardDuty) Count() int {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	return len(m.members)
+}
+
+func (m *awsMockGuardDuty) accountIds(r *awsMockApiRequest) []string {
+	var body struct {
+		AccountIds []string `json:"accountIds"`
+	}
+	json.Unmarshal(r.Body, &body)
+	return body.AccountIds
+}
+
+func (m *awsMockGuardDuty) createMembers(r *awsMockApiRequest) *awsMockResponse {
+	var body struct {
+		AccountDetails []struct {
+			AccountId string `json:"accountId"`
+			Email     string `json:"email"`
+		} `json:"accountDetails"`
+	}
+	json.Unmarshal(r.Body, &body)
+
+	for _, a := range body.AccountDetails {
+		m.members[a.AccountId] = &awsMockGuardDutyMember{email: a.Email, status: "Created"}
+	}
+	return awsMockJsonResponse(map[string]interface{}{"unprocessedAccounts": []interface{}{}})
+}
+
+func (m *awsMockGuardDuty) getMembers(r *awsMockApiRequest) *awsMockResponse {
+	members := make([]map[string]string, 0)
+	for _, id := range m.accountIds(r) {
+		if member, ok := m.members[id]; ok {
+			members = append(members, map[string]string{
+				"accountId":          id,
+				"detectorId":         awsMockGuardDutyMemberDetectorId,
+				"email":              member.email,
+				"masterId":           awsMockAccountId,
+				"relationshipStatus": member.status,
+			})
+		}
+	}
+	return awsMockJsonResponse(map[string]interface{}{"members": members})
+}
+
+func (m *awsMockGuardDuty) inviteMembers(r *awsMockApiRequest) *awsMockResponse {
+	for _, id := range m.accountIds(r) {
+		if member, ok := m.members[id]; ok {
+			member.status = "EmailVerificationInProgress"
+		}
+	}
+	return awsMockJsonResponse(map[string]interface{}{"unprocessedAccounts": []interface{}{}})
+}
+
+func (m *awsMockGuardDuty) deleteMembers(r *awsMockApiRequest) *awsMockResponse {
+	for _, id := range m.accountIds(r) {
+		delete(m.members, id)
+	}
+	return awsMockJsonResponse(map[string]interface{}{"unprocessedAccounts": []interface{}{}})
+}
+
+func (m *awsMockGuardDuty) listInvitations(r *awsMockApiRequest) *awsMockResponse {
+	invitations := make([]map[string]string, 0)
+	for _, member := range m.members {
+		if member.status == "EmailVerificationInProgress" {
+			invitations = append(invitations, map[string]string{
+				"accountId":          awsMockAccountId,
+				"invitationId":       awsMockGuardDutyInvitationId,
+				"relationshipStatus": "Invited",
+			})
+			break
+		}
+	}
+	return awsMockJsonResponse(map[string]interface{}{"invitations": invitations})
+}
+
+func (m *awsMockGuardDuty) acceptInvitation(r *awsMockApiRequest) *awsMockResponse {
+	m.accepted = true
+	for _, member := range m.members {
+		if member.status == "EmailVerificationInProgress" {
+			member.status = "Enabled"
+		}
+	}
+	return awsMockJsonResponse(map[string]interface{}{})
+}
+
+func (m *awsMockGuardDuty) getMasterAccount(r *awsMockApiRequest) *awsMockResponse {
+	if !m.accepted {
+		return awsMockJsonResponse(map[string]interface{}{})
+	}
+	return awsMockJsonResponse(map[string]interface{}{
+		"master": map[string]string{
+			"accountId":          awsMockAccountId,
+			"invitationId":       awsMockGuardDutyInvitationId,
+			"relationshipStatus": "Enabled",
+		},
+	})
+}
+
+func (m *awsMockGuardDuty) disassociateFromMasterAccount(r *awsMockApiRequest) *awsMockResponse {
+	m.accepted = false
+	for _, member := range m.members {
+		member.status = "Removed"
+	}
+	return awsMockJsonResponse(map[string]interface{}{})
+}
+
+// awsMockCloudFormationStackSet is a stateful fake of the instances of a
+// single CloudFormation stack set. Like the real API, the stack set is only
+// visible to clients of the region it was created in, while its instances may
+// target any region. Operations complete immediately.
+type awsMockCloudFormationStackSet struct {
+	api       *awsMockApi
+	name      string
+	region    string
+	instances map[string]map[string]string
+}
+
+func newAwsMockCloudFormationStackSet(api *awsMockApi, name, region string) *awsMockCloudFormationStackSet {
+	m := &awsMockCloudFormationStackSet{
+		api:       api,
+		name:      name,
+		region:    region,
+		instances: make(map[string]map[string]string),
+	}
+
+	api.Handle("cloudformation", "CreateStackInstances", m.withStackSet(m.createStackInstances))
+	api.Handle("cloudformation", "DescribeStackSetOperation", m.withStackSet(m.describeStackSetOperation))
+	api.Handle("cloudformation", "DescribeStackInstance", m.withStackSet(m.describeStackInstance))
+	api.Handle("cloudformation", "DeleteStackInstances", m.withStackSet(m.deleteStackInstances))
+
+	return m
+}
+
+// Count returns the number of stack instances currently held by the fake.
+func (m *awsMockCloudFormationStackSet) Count() int {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	return len(m.instances)
+}
+
+// Regions returns the sorted target regions of the stack instances.
+func (m *awsMockCloudFormationStackSet) Regions() []string {
+	m.api.mu.Lock()
+	defer m.api.mu.Unlock()
+	regions := make([]string, 0, len(m.instances))
+	for _, instance := range m.instances {
+		regions = append(regions, instance["Region"])
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+func (m *awsMockCloudFormationStackSet) withStackSet(f func(*awsMockApiRequest) *awsMockResponse) awsMockApiHandler {
+	return func(r *awsMockApiRequest) *awsMockResponse {
+		if r.Params.Get("StackSetName") != m.name || r.Region != m.region {
+			return awsMockQueryError(http.StatusBadRequest, "StackSetNotFoundException",
+				fmt.Sprintf("StackSet %s not found", r.Params.Get("StackSetName")))
+		}
+		return f(r)
+	}
+}
+
+func (m *awsMockCloudFormationStackSet) createStackInstances(r *awsMockApiRequest) *awsMockResponse {
+	for _, account := range awsMockQueryList(r.Params, "Accounts.member") {
+		for _, region := range awsMockQueryList(r.Params, "Regions.member") {
+			m.instances[account+"/"+region] = map[string]string{
+				"Account":      account,
+				"Region":       region,
+				"StackSetId":   m.name + ":00000000-0000-0000-0000-000000000000",
+				"StackId":      fmt.Sprintf("arn:aws:cloudformation:%s:%s:stack/StackSet-%s/00000000-0000-0000-0000-000000000000", region, account, m.name),
+				"Status":       "CURRENT",
+				"StatusReason": "",
+			}
+		}
+	}
+
+	return awsMockQueryResponse("CreateStackInstances",
+		fmt.Sprintf("<OperationId>%s</OperationId>", awsMockXmlEscape(r.Params.Get("OperationId"))))
+}
+
+func (m *awsMockCloudFormationStackSet) describeStackSetOperation(r *awsMockApiRequest) *awsMockResponse {
+	return awsMockQueryResponse("DescribeStackSetOperation", fmt.Sprintf(
+		"<StackSetOperation><OperationId>%s</OperationId><Status>SUCCEEDED</Status></StackSetOperation>",
+		awsMockXmlEscape(r.Params.Get("OperationId"))))
+}
+
+func (m *awsMockCloudFormationStackSet) describeStackInstance(r *awsMockApiRequest) *awsMockResponse {
+	instance, ok := m.instances[r.Params.Get("StackInstanceAccount")+"/"+r.Params.Get("StackInstanceRegion")]
+	if !ok {
+		return awsMockQueryError(http.StatusBadRequest, "StackInstanceNotFoundException", "Stack instance not found")
+	}
+
+	var buf bytes.Buffer
+	for _, k := range []string{"Account", "Region", "StackId", "StackSetId", "Status", "StatusReason"} {
+		buf.WriteString(fmt.Sprintf("<%[1]s>%[2]s</%[1]s>", k, awsMockXmlEscape(instance[k])))
+	}
+	return awsMockQueryResponse("DescribeStackInstance",
+		fmt.Sprintf("<StackInstance>%s<ParameterOverrides/></StackInstance>", buf.String()))
+}
+
+func (m *awsMockCloudFormationStackSet) deleteStackInstances(r *awsMockApiRequest) *awsMockResponse {
+	for _, account := range awsMockQueryList(r.Params, "Accounts.member") {
+		for _, region := range awsMockQueryList(r.Params, "Regions.member") {
+			delete(m.instances, account+"/"+region)
+		}
+	}
+
+	return awsMockQueryResponse("DeleteStackInstances",
+		fmt.Sprintf("<OperationId>%s</OperationId>", awsMockXmlEscape(r.Params.Get("OperationId"))))
+}
+
+// testAccCheckAwsMockDestroyed verifies that a stateful fake no longer holds
+// any of the objects created during the test.
+func testAccCheckAwsMockDestroyed(kind string, count func() int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if n := count(); n != 0 {
+			return fmt.Errorf("%d mocked %s still exist", n, kind)
+		}
+		return nil
+	}
+}
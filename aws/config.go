@@ -8,18 +8,22 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
@@ -34,6 +38,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -51,6 +56,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/inspector"
@@ -70,6 +76,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/simpledb"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -85,45 +92,36 @@ import (
 )
 
 type Config struct {
-	AccessKey     string
-	SecretKey     string
-	CredsFilename string
-	Profile       string
-	Token         string
-	Region        string
-	MaxRetries    int
-
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AccessKey       string
+	SecretKey       string
+	CredsFilename   string
+	ConfigFilename  string
+	Profile         string
+	MFATokenCommand string
+	Token           string
+	Region          string
+	MaxRetries      int
+
+	// AssumeRoles are assumed in order, each with the credentials of the
+	// previous one, to get the credentials used by the provider.
+	AssumeRoles []*AssumeRole
+
+	// AssumeRoleWithWebIdentity replaces the other sources of credentials
+	// when set.
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	AcmEndpoint              string
-	ApigatewayEndpoint       string
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	EcsEndpoint              string
-	EcrEndpoint              string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	LambdaEndpoint           string
-	RdsEndpoint              string
-	R53Endpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	StsEndpoint              string
-	Insecure                 bool
+	DefaultTags map[string]string
+	IgnoreTags  *IgnoreTagsConfig
+
+	// Endpoints holds the per-service endpoint overrides keyed by the names
+	// in endpointServiceNames. Missing or empty entries use the default
+	// endpoint for the region.
+	Endpoints map[string]string
+
+	Insecure bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -133,96 +131,540 @@ type Config struct {
 	S3ForcePathStyle        bool
 }
 
+// AssumeRole holds the settings of an assume_role block.
+type AssumeRole struct {
+	RoleARN         string
+	SessionName     string
+	ExternalID      string
+	Policy          string
+	DurationSeconds int
+}
+
+// AssumeRoleWithWebIdentity holds the settings of the
+// assume_role_with_web_identity block.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	SessionName          string
+	WebIdentityTokenFile string
+	Policy               string
+	DurationSeconds      int
+}
+
+// endpointServiceNames lists the services whose endpoint can be overridden in
+// the provider `endpoints` block. Every service client in AWSClient is built
+// from a session using the endpoint configured under its name.
+var endpointServiceNames = []string{
+	"acm",
+	"apigateway",
+	"applicationautoscaling",
+	"appsync",
+	"athena",
+	"autoscaling",
+	"batch",
+	"budgets",
+	"cloudformation",
+	"cloudfront",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidp",
+	"configservice",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"dms",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"elasticache",
+	"elasticbeanstalk",
+	"elastictranscoder",
+	"elb",
+	"emr",
+	"es",
+	"firehose",
+	"glacier",
+	"glue",
+	"guardduty",
+	"iam",
+	"inspector",
+	"iot",
+	"kinesis",
+	"kms",
+	"lambda",
+	"lightsail",
+	"mediastore",
+	"mq",
+	"opsworks",
+	"r53",
+	"rds",
+	"redshift",
+	"s3",
+	"sdb",
+	"servicecatalog",
+	"servicediscovery",
+	"ses",
+	"shield",
+	"sns",
+	"sqs",
+	"ssm",
+	"stepfunctions",
+	"sts",
+	"waf",
+	"wafregional",
+}
+
 type AWSClient struct {
-	cfconn                *cloudformation.CloudFormation
-	cloudfrontconn        *cloudfront.CloudFront
-	cloudtrailconn        *cloudtrail.CloudTrail
-	cloudwatchconn        *cloudwatch.CloudWatch
-	cloudwatchlogsconn    *cloudwatchlogs.CloudWatchLogs
-	cloudwatcheventsconn  *cloudwatchevents.CloudWatchEvents
-	cognitoconn           *cognitoidentity.CognitoIdentity
-	cognitoidpconn        *cognitoidentityprovider.CognitoIdentityProvider
-	configconn            *configservice.ConfigService
-	devicefarmconn        *devicefarm.DeviceFarm
-	dmsconn               *databasemigrationservice.DatabaseMigrationService
-	dsconn                *directoryservice.DirectoryService
-	dynamodbconn          *dynamodb.DynamoDB
-	ec2conn               *ec2.EC2
-	ecrconn               *ecr.ECR
-	ecsconn               *ecs.ECS
-	efsconn               *efs.EFS
-	elbconn               *elb.ELB
-	elbv2conn             *elbv2.ELBV2
-	emrconn               *emr.EMR
-	esconn                *elasticsearch.ElasticsearchService
-	acmconn               *acm.ACM
-	apigateway            *apigateway.APIGateway
-	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
-	autoscalingconn       *autoscaling.AutoScaling
-	s3conn                *s3.S3
-	scconn                *servicecatalog.ServiceCatalog
-	sesConn               *ses.SES
-	simpledbconn          *simpledb.SimpleDB
-	sqsconn               *sqs.SQS
-	snsconn               *sns.SNS
-	stsconn               *sts.STS
-	redshiftconn          *redshift.Redshift
-	r53conn               *route53.Route53
-	partition             string
-	accountid             string
-	supportedplatforms    []string
-	region                string
-	rdsconn               *rds.RDS
-	iamconn               *iam.IAM
-	kinesisconn           *kinesis.Kinesis
-	kmsconn               *kms.KMS
-	firehoseconn          *firehose.Firehose
-	inspectorconn         *inspector.Inspector
-	elasticacheconn       *elasticache.ElastiCache
-	elasticbeanstalkconn  *elasticbeanstalk.ElasticBeanstalk
-	elastictranscoderconn *elastictranscoder.ElasticTranscoder
-	lambdaconn            *lambda.Lambda
-	lightsailconn         *lightsail.Lightsail
-	mqconn                *mq.MQ
-	opsworksconn          *opsworks.OpsWorks
-	glacierconn           *glacier.Glacier
-	guarddutyconn         *guardduty.GuardDuty
-	codebuildconn         *codebuild.CodeBuild
-	codedeployconn        *codedeploy.CodeDeploy
-	codecommitconn        *codecommit.CodeCommit
-	codepipelineconn      *codepipeline.CodePipeline
-	sdconn                *servicediscovery.ServiceDiscovery
-	sfnconn               *sfn.SFN
-	ssmconn               *ssm.SSM
-	wafconn               *waf.WAF
-	wafregionalconn       *wafregional.WAFRegional
-	iotconn               *iot.IoT
-	batchconn             *batch.Batch
-	athenaconn            *athena.Athena
-	dxconn                *directconnect.DirectConnect
-	mediastoreconn        *mediastore.MediaStore
+	region           string
+	defaultTags      map[string]string
+	ignoreTagsConfig *IgnoreTagsConfig
+
+	// account is looked up on first use and shared with the clients of
+	// other regions.
+	account *awsAccount
+
+	skipGetEC2Platforms    bool
+	supportedPlatformsOnce sync.Once
+	supportedplatforms     []string
+
+	// session and endpoints are used to build the service clients on first
+	// access, which are cached in clients. They are also used to build the
+	// clients of other regions, which are cached in regionalClients.
+	session              *session.Session
+	endpoints            map[string]string
+	clientsMu            sync.Mutex
+	clients              map[string]interface{}
+	skipRegionValidation bool
+	regionalClientsMu    sync.Mutex
+	regionalClients      map[string]*AWSClient
 }
 
-func (c *AWSClient) S3() *s3.S3 {
-	return c.s3conn
+// awsAccount holds the partition and ID of the account of the provider.
+type awsAccount struct {
+	once      sync.Once
+	lookup    func() (string, string, error)
+	partition string
+	id        string
+}
+
+func (a *awsAccount) get() (string, string) {
+	if a == nil {
+		return "", ""
+	}
+
+	a.once.Do(func() {
+		if a.lookup == nil {
+			return
+		}
+		partition, id, err := a.lookup()
+		if err != nil {
+			log.Printf("[WARN] Unable to get the AWS account ID: %s", err)
+			return
+		}
+		a.partition = partition
+		a.id = id
+	})
+	return a.partition, a.id
+}
+
+// AccountID returns the ID of the account of the provider, looking it up
+// on first use. It is empty if the lookup is skipped or fails.
+func (c *AWSClient) AccountID() string {
+	_, id := c.account.get()
+	return id
+}
+
+// Partition returns the partition of the account of the provider, looking
+// it up on first use. If the lookup is skipped or fails, it is the partition
+// of the region of the client.
+func (c *AWSClient) Partition() string {
+	if partition, _ := c.account.get(); partition != "" {
+		return partition
+	}
+	if p, ok := partitionForRegion(c.region); ok {
+		return p.ID()
+	}
+	return ""
+}
+
+// SupportedPlatforms returns the EC2 platforms supported by the account in
+// the region of the client, looking them up on first use.
+func (c *AWSClient) SupportedPlatforms() []string {
+	c.supportedPlatformsOnce.Do(func() {
+		if c.skipGetEC2Platforms || c.session == nil {
+			return
+		}
+		supportedPlatforms, err := GetSupportedEC2Platforms(c.EC2())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+			return
+		}
+		c.supportedplatforms = supportedPlatforms
+	})
+	return c.supportedplatforms
+}
+
+// lazyClient returns the service client cached under name, calling build
+// to create it on first use.
+func (c *AWSClient) lazyClient(name string, build func() interface{}) interface{} {
+	c.clientsMu.Lock()
+	defer c.clientsMu.Unlock()
+
+	if conn, ok := c.clients[name]; ok {
+		return conn
+	}
+
+	conn := build()
+	if c.clients == nil {
+		c.clients = make(map[string]interface{})
+	}
+	c.clients[name] = conn
+	return conn
+}
+
+// endpointSession returns the session for a service, using its
+// user-configurable endpoint.
+func (c *AWSClient) endpointSession(endpointServiceName string) *session.Session {
+	return c.session.Copy(&aws.Config{Endpoint: aws.String(c.endpoints[endpointServiceName])})
+}
+
+func (c *AWSClient) ACM() *acm.ACM {
+	return c.lazyClient("acm", func() interface{} { return acm.New(c.endpointSession("acm")) }).(*acm.ACM)
+}
+
+func (c *AWSClient) APIGateway() *apigateway.APIGateway {
+	return c.lazyClient("apigateway", func() interface{} { return apigateway.New(c.endpointSession("apigateway")) }).(*apigateway.APIGateway)
+}
+
+func (c *AWSClient) ApplicationAutoScaling() *applicationautoscaling.ApplicationAutoScaling {
+	return c.lazyClient("applicationautoscaling", func() interface{} {
+		conn := applicationautoscaling.New(c.endpointSession("applicationautoscaling"))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			err, ok := r.Error.(awserr.Error)
+			if !ok || err == nil {
+				return
+			}
+			if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (c *AWSClient) AppSync() *appsync.AppSync {
+	return c.lazyClient("appsync", func() interface{} { return appsync.New(c.endpointSession("appsync")) }).(*appsync.AppSync)
+}
+
+func (c *AWSClient) Athena() *athena.Athena {
+	return c.lazyClient("athena", func() interface{} { return athena.New(c.endpointSession("athena")) }).(*athena.Athena)
+}
+
+func (c *AWSClient) AutoScaling() *autoscaling.AutoScaling {
+	return c.lazyClient("autoscaling", func() interface{} { return autoscaling.New(c.endpointSession("autoscaling")) }).(*autoscaling.AutoScaling)
+}
+
+func (c *AWSClient) Batch() *batch.Batch {
+	return c.lazyClient("batch", func() interface{} { return batch.New(c.endpointSession("batch")) }).(*batch.Batch)
+}
+
+func (c *AWSClient) Budgets() *budgets.Budgets {
+	return c.lazyClient("budgets", func() interface{} { return budgets.New(c.endpointSession("budgets")) }).(*budgets.Budgets)
+}
+
+func (c *AWSClient) CloudFormation() *cloudformation.CloudFormation {
+	return c.lazyClient("cloudformation", func() interface{} { return cloudformation.New(c.endpointSession("cloudformation")) }).(*cloudformation.CloudFormation)
+}
+
+func (c *AWSClient) CloudFront() *cloudfront.CloudFront {
+	return c.lazyClient("cloudfront", func() interface{} { return cloudfront.New(c.endpointSession("cloudfront")) }).(*cloudfront.CloudFront)
+}
+
+func (c *AWSClient) CloudTrail() *cloudtrail.CloudTrail {
+	return c.lazyClient("cloudtrail", func() interface{} { return cloudtrail.New(c.endpointSession("cloudtrail")) }).(*cloudtrail.CloudTrail)
+}
+
+func (c *AWSClient) CloudWatch() *cloudwatch.CloudWatch {
+	return c.lazyClient("cloudwatch", func() interface{} { return cloudwatch.New(c.endpointSession("cloudwatch")) }).(*cloudwatch.CloudWatch)
+}
+
+func (c *AWSClient) CloudWatchEvents() *cloudwatchevents.CloudWatchEvents {
+	return c.lazyClient("cloudwatchevents", func() interface{} { return cloudwatchevents.New(c.endpointSession("cloudwatchevents")) }).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (c *AWSClient) CloudWatchLogs() *cloudwatchlogs.CloudWatchLogs {
+	return c.lazyClient("cloudwatchlogs", func() interface{} { return cloudwatchlogs.New(c.endpointSession("cloudwatchlogs")) }).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (c *AWSClient) CodeBuild() *codebuild.CodeBuild {
+	return c.lazyClient("codebuild", func() interface{} { return codebuild.New(c.endpointSession("codebuild")) }).(*codebuild.CodeBuild)
+}
+
+func (c *AWSClient) CodeCommit() *codecommit.CodeCommit {
+	return c.lazyClient("codecommit", func() interface{} { return codecommit.New(c.endpointSession("codecommit")) }).(*codecommit.CodeCommit)
+}
+
+func (c *AWSClient) CodeDeploy() *codedeploy.CodeDeploy {
+	return c.lazyClient("codedeploy", func() interface{} { return codedeploy.New(c.endpointSession("codedeploy")) }).(*codedeploy.CodeDeploy)
+}
+
+func (c *AWSClient) CodePipeline() *codepipeline.CodePipeline {
+	return c.lazyClient("codepipeline", func() interface{} { return codepipeline.New(c.endpointSession("codepipeline")) }).(*codepipeline.CodePipeline)
+}
+
+func (c *AWSClient) CognitoIdentity() *cognitoidentity.CognitoIdentity {
+	return c.lazyClient("cognitoidentity", func() interface{} { return cognitoidentity.New(c.endpointSession("cognitoidentity")) }).(*cognitoidentity.CognitoIdentity)
+}
+
+func (c *AWSClient) CognitoIdentityProvider() *cognitoidentityprovider.CognitoIdentityProvider {
+	return c.lazyClient("cognitoidentityprovider", func() interface{} { return cognitoidentityprovider.New(c.endpointSession("cognitoidp")) }).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (c *AWSClient) ConfigService() *configservice.ConfigService {
+	return c.lazyClient("configservice", func() interface{} { return configservice.New(c.endpointSession("configservice")) }).(*configservice.ConfigService)
+}
+
+func (c *AWSClient) DatabaseMigrationService() *databasemigrationservice.DatabaseMigrationService {
+	return c.lazyClient("databasemigrationservice", func() interface{} { return databasemigrationservice.New(c.endpointSession("dms")) }).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (c *AWSClient) DAX() *dax.DAX {
+	return c.lazyClient("dax", func() interface{} { return dax.New(c.endpointSession("dax")) }).(*dax.DAX)
+}
+
+func (c *AWSClient) DeviceFarm() *devicefarm.DeviceFarm {
+	return c.lazyClient("devicefarm", func() interface{} { return devicefarm.New(c.endpointSession("devicefarm")) }).(*devicefarm.DeviceFarm)
+}
+
+func (c *AWSClient) DirectConnect() *directconnect.DirectConnect {
+	return c.lazyClient("directconnect", func() interface{} { return directconnect.New(c.endpointSession("directconnect")) }).(*directconnect.DirectConnect)
+}
+
+func (c *AWSClient) DirectoryService() *directoryservice.DirectoryService {
+	return c.lazyClient("directoryservice", func() interface{} { return directoryservice.New(c.endpointSession("ds")) }).(*directoryservice.DirectoryService)
 }
 
 func (c *AWSClient) DynamoDB() *dynamodb.DynamoDB {
-	return c.dynamodbconn
+	return c.lazyClient("dynamodb", func() interface{} { return dynamodb.New(c.endpointSession("dynamodb")) }).(*dynamodb.DynamoDB)
+}
+
+func (c *AWSClient) EC2() *ec2.EC2 {
+	return c.lazyClient("ec2", func() interface{} { return ec2.New(c.endpointSession("ec2")) }).(*ec2.EC2)
+}
+
+func (c *AWSClient) ECR() *ecr.ECR {
+	return c.lazyClient("ecr", func() interface{} { return ecr.New(c.endpointSession("ecr")) }).(*ecr.ECR)
+}
+
+func (c *AWSClient) ECS() *ecs.ECS {
+	return c.lazyClient("ecs", func() interface{} { return ecs.New(c.endpointSession("ecs")) }).(*ecs.ECS)
+}
+
+func (c *AWSClient) EFS() *efs.EFS {
+	return c.lazyClient("efs", func() interface{} { return efs.New(c.endpointSession("efs")) }).(*efs.EFS)
+}
+
+func (c *AWSClient) ElastiCache() *elasticache.ElastiCache {
+	return c.lazyClient("elasticache", func() interface{} { return elasticache.New(c.endpointSession("elasticache")) }).(*elasticache.ElastiCache)
+}
+
+func (c *AWSClient) ElasticBeanstalk() *elasticbeanstalk.ElasticBeanstalk {
+	return c.lazyClient("elasticbeanstalk", func() interface{} { return elasticbeanstalk.New(c.endpointSession("elasticbeanstalk")) }).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (c *AWSClient) ElasticsearchService() *elasticsearch.ElasticsearchService {
+	return c.lazyClient("elasticsearchservice", func() interface{} { return elasticsearch.New(c.endpointSession("es")) }).(*elasticsearch.ElasticsearchService)
+}
+
+func (c *AWSClient) ElasticTranscoder() *elastictranscoder.ElasticTranscoder {
+	return c.lazyClient("elastictranscoder", func() interface{} { return elastictranscoder.New(c.endpointSession("elastictranscoder")) }).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (c *AWSClient) ELB() *elb.ELB {
+	return c.lazyClient("elb", func() interface{} { return elb.New(c.endpointSession("elb")) }).(*elb.ELB)
+}
+
+func (c *AWSClient) ELBV2() *elbv2.ELBV2 {
+	return c.lazyClient("elbv2", func() interface{} { return elbv2.New(c.endpointSession("elb")) }).(*elbv2.ELBV2)
+}
+
+func (c *AWSClient) EMR() *emr.EMR {
+	return c.lazyClient("emr", func() interface{} { return emr.New(c.endpointSession("emr")) }).(*emr.EMR)
+}
+
+func (c *AWSClient) Firehose() *firehose.Firehose {
+	return c.lazyClient("firehose", func() interface{} { return firehose.New(c.endpointSession("firehose")) }).(*firehose.Firehose)
+}
+
+func (c *AWSClient) Glacier() *glacier.Glacier {
+	return c.lazyClient("glacier", func() interface{} { return glacier.New(c.endpointSession("glacier")) }).(*glacier.Glacier)
+}
+
+func (c *AWSClient) Glue() *glue.Glue {
+	return c.lazyClient("glue", func() interface{} { return glue.New(c.endpointSession("glue")) }).(*glue.Glue)
+}
+
+func (c *AWSClient) GuardDuty() *guardduty.GuardDuty {
+	return c.lazyClient("guardduty", func() interface{} { return guardduty.New(c.endpointSession("guardduty")) }).(*guardduty.GuardDuty)
+}
+
+func (c *AWSClient) IAM() *iam.IAM {
+	return c.lazyClient("iam", func() interface{} { return iam.New(c.endpointSession("iam")) }).(*iam.IAM)
+}
+
+func (c *AWSClient) Inspector() *inspector.Inspector {
+	return c.lazyClient("inspector", func() interface{} { return inspector.New(c.endpointSession("inspector")) }).(*inspector.Inspector)
+}
+
+func (c *AWSClient) IoT() *iot.IoT {
+	return c.lazyClient("iot", func() interface{} { return iot.New(c.endpointSession("iot")) }).(*iot.IoT)
+}
+
+func (c *AWSClient) Kinesis() *kinesis.Kinesis {
+	return c.lazyClient("kinesis", func() interface{} {
+		conn := kinesis.New(c.endpointSession("kinesis"))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			err, ok := r.Error.(awserr.Error)
+			if !ok || err == nil {
+				return
+			}
+			if err.Code() == kinesis.ErrCodeLimitExceededException {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (c *AWSClient) KMS() *kms.KMS {
+	return c.lazyClient("kms", func() interface{} { return kms.New(c.endpointSession("kms")) }).(*kms.KMS)
+}
+
+func (c *AWSClient) Lambda() *lambda.Lambda {
+	return c.lazyClient("lambda", func() interface{} { return lambda.New(c.endpointSession("lambda")) }).(*lambda.Lambda)
+}
+
+func (c *AWSClient) Lightsail() *lightsail.Lightsail {
+	return c.lazyClient("lightsail", func() interface{} { return lightsail.New(c.endpointSession("lightsail")) }).(*lightsail.Lightsail)
+}
+
+func (c *AWSClient) MediaStore() *mediastore.MediaStore {
+	return c.lazyClient("mediastore", func() interface{} { return mediastore.New(c.endpointSession("mediastore")) }).(*mediastore.MediaStore)
+}
+
+func (c *AWSClient) MQ() *mq.MQ {
+	return c.lazyClient("mq", func() interface{} { return mq.New(c.endpointSession("mq")) }).(*mq.MQ)
+}
+
+func (c *AWSClient) OpsWorks() *opsworks.OpsWorks {
+	return c.lazyClient("opsworks", func() interface{} { return opsworks.New(c.endpointSession("opsworks")) }).(*opsworks.OpsWorks)
+}
+
+func (c *AWSClient) RDS() *rds.RDS {
+	return c.lazyClient("rds", func() interface{} { return rds.New(c.endpointSession("rds")) }).(*rds.RDS)
+}
+
+func (c *AWSClient) Redshift() *redshift.Redshift {
+	return c.lazyClient("redshift", func() interface{} { return redshift.New(c.endpointSession("redshift")) }).(*redshift.Redshift)
+}
+
+func (c *AWSClient) Route53() *route53.Route53 {
+	return c.lazyClient("route53", func() interface{} {
+		// This restriction should only be used for Route53 sessions.
+		// Other resources that have restrictions should allow the API to fail, rather
+		// than Terraform abstracting the region for the user. This can lead to breaking
+		// changes if that resource is ever opened up to more regions.
+		return route53.New(c.session.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.endpoints["r53"])}))
+	}).(*route53.Route53)
+}
+
+func (c *AWSClient) S3() *s3.S3 {
+	return c.lazyClient("s3", func() interface{} { return s3.New(c.endpointSession("s3")) }).(*s3.S3)
+}
+
+func (c *AWSClient) ServiceCatalog() *servicecatalog.ServiceCatalog {
+	return c.lazyClient("servicecatalog", func() interface{} { return servicecatalog.New(c.endpointSession("servicecatalog")) }).(*servicecatalog.ServiceCatalog)
+}
+
+func (c *AWSClient) ServiceDiscovery() *servicediscovery.ServiceDiscovery {
+	return c.lazyClient("servicediscovery", func() interface{} { return servicediscovery.New(c.endpointSession("servicediscovery")) }).(*servicediscovery.ServiceDiscovery)
+}
+
+func (c *AWSClient) SES() *ses.SES {
+	return c.lazyClient("ses", func() interface{} { return ses.New(c.endpointSession("ses")) }).(*ses.SES)
+}
+
+func (c *AWSClient) SFN() *sfn.SFN {
+	return c.lazyClient("sfn", func() interface{} { return sfn.New(c.endpointSession("stepfunctions")) }).(*sfn.SFN)
+}
+
+func (c *AWSClient) Shield() *shield.Shield {
+	return c.lazyClient("shield", func() interface{} {
+		// Shield Advanced is only served from us-east-1, whatever region the
+		// protected resources are in.
+		return shield.New(c.session.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.endpoints["shield"])}))
+	}).(*shield.Shield)
+}
+
+func (c *AWSClient) SimpleDB() *simpledb.SimpleDB {
+	return c.lazyClient("simpledb", func() interface{} { return simpledb.New(c.endpointSession("sdb")) }).(*simpledb.SimpleDB)
+}
+
+func (c *AWSClient) SNS() *sns.SNS {
+	return c.lazyClient("sns", func() interface{} { return sns.New(c.endpointSession("sns")) }).(*sns.SNS)
+}
+
+func (c *AWSClient) SQS() *sqs.SQS {
+	return c.lazyClient("sqs", func() interface{} { return sqs.New(c.endpointSession("sqs")) }).(*sqs.SQS)
+}
+
+func (c *AWSClient) SSM() *ssm.SSM {
+	return c.lazyClient("ssm", func() interface{} { return ssm.New(c.endpointSession("ssm")) }).(*ssm.SSM)
+}
+
+func (c *AWSClient) STS() *sts.STS {
+	return c.lazyClient("sts", func() interface{} { return sts.New(c.endpointSession("sts")) }).(*sts.STS)
+}
+
+func (c *AWSClient) WAF() *waf.WAF {
+	return c.lazyClient("waf", func() interface{} { return waf.New(c.endpointSession("waf")) }).(*waf.WAF)
+}
+
+func (c *AWSClient) WAFRegional() *wafregional.WAFRegional {
+	return c.lazyClient("wafregional", func() interface{} { return wafregional.New(c.endpointSession("wafregional")) }).(*wafregional.WAFRegional)
 }
 
 func (c *AWSClient) IsGovCloud() bool {
-	if c.region == "us-gov-west-1" {
-		return true
-	}
-	return false
+	p, ok := partitionForRegion(c.region)
+	return ok && p.ID() == endpoints.AwsUsGovPartitionID
 }
 
 func (c *AWSClient) IsChinaCloud() bool {
-	if c.region == "cn-north-1" {
-		return true
-	}
-	return false
+	p, ok := partitionForRegion(c.region)
+	return ok && p.ID() == endpoints.AwsCnPartitionID
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -243,6 +685,8 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagsConfig = c.IgnoreTags
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -267,25 +711,20 @@ func (c *Config) Client() (interface{}, error) {
 	cp, err := creds.Get()
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			// If a profile wasn't specified then error out
-			if c.Profile == "" {
-				return nil, errors.New(`No valid credential sources found for AWS Provider.
+			if c.Profile != "" {
+				return nil, fmt.Errorf("No valid credentials found for AWS Provider profile %q: %s", c.Profile, err)
+			}
+			return nil, errors.New(`No valid credential sources found for AWS Provider.
   Please see https://terraform.io/docs/providers/aws/index.html for more information on
   providing credentials for the AWS Provider`)
-			}
-			// add the profile and enable share config file usage
-			log.Printf("[INFO] AWS Auth using Profile: %q", c.Profile)
-			opt.Profile = c.Profile
-			opt.SharedConfigState = session.SharedConfigEnable
-		} else {
-			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 		}
-	} else {
-		// add the validated credentials to the session options
-		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
-		opt.Config.Credentials = creds
+		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 	}
 
+	// add the validated credentials to the session options
+	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	opt.Config.Credentials = creds
+
 	if logging.IsDebugOrHigher() {
 		opt.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
 		opt.Config.Logger = awsLogger{}
@@ -320,164 +759,93 @@ func (c *Config) Client() (interface{}, error) {
 		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
 	}
 
-	// This restriction should only be used for Route53 sessions.
-	// Other resources that have restrictions should allow the API to fail, rather
-	// than Terraform abstracting the region for the user. This can lead to breaking
-	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.R53Endpoint)})
-
-	// Some services have user-configurable endpoints
-	awsAcmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AcmEndpoint)})
-	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsEcrSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcrEndpoint)})
-	awsEcsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcsEndpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsLambdaSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.LambdaEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsStsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.StsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-
-	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
-
-	// These two services need to be set up early so we can check on AccountID
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(awsStsSess)
-
-	if !c.SkipCredsValidation {
-		err = c.ValidateCredentials(client.stsconn)
-		if err != nil {
-			return nil, err
-		}
-	}
+	// The account is looked up and validated with clients built before the
+	// validation handler is added to the session, so that they don't run it.
+	probeSess := sess.Copy()
+	iamconn := iam.New(probeSess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])}))
+	stsconn := sts.New(probeSess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
 
+	client.account = &awsAccount{}
 	if !c.SkipRequestingAccountId {
-		partition, accountId, err := GetAccountInfo(client.iamconn, client.stsconn, cp.ProviderName)
-		if err == nil {
-			client.partition = partition
-			client.accountid = accountId
+		client.account.lookup = func() (string, string, error) {
+			return GetAccountInfo(iamconn, stsconn, cp.ProviderName)
 		}
 	}
 
-	authErr := c.ValidateAccountId(client.accountid)
-	if authErr != nil {
-		return nil, authErr
+	// Credentials and the account ID are validated before the first request
+	// rather than here, so that no request is made unless a client is used.
+	if !c.SkipCredsValidation || c.AllowedAccountIds != nil || c.ForbiddenAccountIds != nil {
+		var validateOnce sync.Once
+		var validateErr error
+		sess.Handlers.Validate.PushBackNamed(request.NamedHandler{
+			Name: "terraform.ValidateAccountHandler",
+			Fn: func(r *request.Request) {
+				validateOnce.Do(func() {
+					if !c.SkipCredsValidation {
+						if validateErr = c.ValidateCredentials(stsconn); validateErr != nil {
+							return
+						}
+					}
+					validateErr = c.ValidateAccountId(client.AccountID())
+				})
+				if validateErr != nil {
+					r.Error = validateErr
+				}
+			},
+		})
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
+	client.session = sess
+	client.endpoints = c.Endpoints
+	client.skipRegionValidation = c.SkipRegionValidation
+	client.skipGetEC2Platforms = c.SkipGetEC2Platforms
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
-		}
+	return &client, nil
+}
+
+// regionalClient returns the client to use for resources in the given region.
+// It is the client itself for an empty region or the provider region;
+// clients for other regions are created from the provider session on first
+// use and cached, and build their service clients lazily in turn.
+func (c *AWSClient) regionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == c.region {
+		return c, nil
 	}
 
-	client.acmconn = acm.New(awsAcmSess)
-	client.apigateway = apigateway.New(awsApigatewaySess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(awsEcrSess)
-	client.ecsconn = ecs.New(awsEcsSess)
-	client.efsconn = efs.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(sess)
-	client.firehoseconn = firehose.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.guarddutyconn = guardduty.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(awsLambdaSess)
-	client.lightsailconn = lightsail.New(sess)
-	client.mqconn = mq.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sdconn = servicediscovery.New(sess)
-	client.sesConn = ses.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
-	client.mediastoreconn = mediastore.New(sess)
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == kinesis.ErrCodeLimitExceededException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	c.regionalClientsMu.Lock()
+	defer c.regionalClientsMu.Unlock()
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
+	if client, ok := c.regionalClients[region]; ok {
+		return client, nil
+	}
+
+	if c.session == nil {
+		return nil, fmt.Errorf("Unable to create AWS clients for region %s", region)
+	}
+	if !c.skipRegionValidation {
+		if err := (&Config{Region: region}).ValidateRegion(); err != nil {
+			return nil, err
 		}
-	})
+	}
 
-	return &client, nil
+	log.Printf("[INFO] Building AWS clients for region %s", region)
+	client := &AWSClient{
+		region:               region,
+		account:              c.account,
+		skipGetEC2Platforms:  c.skipGetEC2Platforms,
+		defaultTags:          c.defaultTags,
+		ignoreTagsConfig:     c.ignoreTagsConfig,
+		session:              c.session.Copy(&aws.Config{Region: aws.String(region)}),
+		endpoints:            c.endpoints,
+		skipRegionValidation: c.skipRegionValidation,
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	return client, nil
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -490,36 +858,22 @@ func hasEc2Classic(platforms []string) bool {
 }
 
 // ValidateRegion returns an error if the configured region is not a
-// valid aws region and nil otherwise.
+// region of any partition known to the SDK and nil otherwise.
 func (c *Config) ValidateRegion() error {
-	var regions = []string{
-		"ap-northeast-1",
-		"ap-northeast-2",
-		"ap-south-1",
-		"ap-southeast-1",
-		"ap-southeast-2",
-		"ca-central-1",
-		"cn-north-1",
-		"eu-central-1",
-		"eu-west-1",
-		"eu-west-2",
-		"eu-west-3",
-		"sa-east-1",
-		"us-east-1",
-		"us-east-2",
-		"us-gov-west-1",
-		"us-west-1",
-		"us-west-2",
-	}
-
-	for _, valid := range regions {
-		if c.Region == valid {
+	for _, p := range endpoints.DefaultPartitions() {
+		if _, ok := p.Regions()[c.Region]; ok {
 			return nil
 		}
 	}
 	return fmt.Errorf("Not a valid region: %s", c.Region)
 }
 
+// partitionForRegion returns the partition of the SDK endpoints metadata the
+// region belongs to.
+func partitionForRegion(region string) (endpoints.Partition, bool) {
+	return endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+}
+
 // Validate credentials early and fail before we do any graph walking.
 func (c *Config) ValidateCredentials(stsconn *sts.STS) error {
 	_, err := stsconn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
@@ -7,13 +7,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sqs"
 )
 
 func TestGetSupportedEC2Platforms(t *testing.T) {
@@ -41,6 +42,226 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfig_ValidateRegion(t *testing.T) {
+	for _, region := range []string{"us-east-1", "eu-west-3", "cn-northwest-1", "us-gov-west-1"} {
+		if err := (&Config{Region: region}).ValidateRegion(); err != nil {
+			t.Errorf("Expected %s to be valid: %s", region, err)
+		}
+	}
+
+	for _, region := range []string{"", "not-a-region", "us-east-9", "US-EAST-1"} {
+		if err := (&Config{Region: region}).ValidateRegion(); err == nil {
+			t.Errorf("Expected %q to be invalid", region)
+		}
+	}
+}
+
+func TestAWSClient_partitions(t *testing.T) {
+	cases := []struct {
+		Region     string
+		Partition  string
+		GovCloud   bool
+		ChinaCloud bool
+	}{
+		{"us-east-1", "aws", false, false},
+		{"us-gov-west-1", "aws-us-gov", true, false},
+		{"cn-north-1", "aws-cn", false, true},
+		{"cn-northwest-1", "aws-cn", false, true},
+	}
+
+	for _, tc := range cases {
+		client := &AWSClient{region: tc.Region}
+		if p := client.Partition(); p != tc.Partition {
+			t.Errorf("Expected partition %q for %s, got %q", tc.Partition, tc.Region, p)
+		}
+		if client.IsGovCloud() != tc.GovCloud || client.IsChinaCloud() != tc.ChinaCloud {
+			t.Errorf("Expected GovCloud %t and China %t for %s, got %t and %t",
+				tc.GovCloud, tc.ChinaCloud, tc.Region, client.IsGovCloud(), client.IsChinaCloud())
+		}
+	}
+}
+
+func TestAWSClient_endpoints(t *testing.T) {
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		Endpoints:               make(map[string]string),
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+	}
+	for _, endpointServiceName := range endpointServiceNames {
+		c.Endpoints[endpointServiceName] = fmt.Sprintf("https://%s.example.com", endpointServiceName)
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	cases := []struct {
+		Name     string
+		Endpoint string
+	}{
+		{"cloudfront", client.CloudFront().Endpoint},
+		{"efs", client.EFS().Endpoint},
+		{"elasticache", client.ElastiCache().Endpoint},
+		{"elb", client.ELBV2().Endpoint},
+		{"r53", client.Route53().Endpoint},
+		{"redshift", client.Redshift().Endpoint},
+		{"ses", client.SES().Endpoint},
+		{"shield", client.Shield().Endpoint},
+		{"ssm", client.SSM().Endpoint},
+		{"stepfunctions", client.SFN().Endpoint},
+	}
+
+	for _, tc := range cases {
+		expected := fmt.Sprintf("https://%s.example.com", tc.Name)
+		if tc.Endpoint != expected {
+			t.Errorf("Expected %q endpoint to be %q, got %q", tc.Name, expected, tc.Endpoint)
+		}
+	}
+}
+
+func TestAWSClient_regionalClient(t *testing.T) {
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		Endpoints:               map[string]string{"s3": "https://s3.example.com"},
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	for _, region := range []string{"", "us-west-2"} {
+		if regional, err := client.regionalClient(region); err != nil || regional != client {
+			t.Fatalf("Expected provider client for region %q, got %p (%s)", region, regional, err)
+		}
+	}
+
+	regional, err := client.regionalClient("eu-west-1")
+	if err != nil {
+		t.Fatalf("Error creating regional client: %s", err)
+	}
+	if regional.region != "eu-west-1" || aws.StringValue(regional.SQS().Config.Region) != "eu-west-1" {
+		t.Fatalf("Expected client for eu-west-1, got %q", aws.StringValue(regional.SQS().Config.Region))
+	}
+	if regional.S3().Endpoint != "https://s3.example.com" {
+		t.Fatalf("Expected endpoint override to be kept, got %q", regional.S3().Endpoint)
+	}
+	if aws.StringValue(regional.Route53().Config.Region) != "us-east-1" {
+		t.Fatalf("Expected Route 53 client in us-east-1, got %q", aws.StringValue(regional.Route53().Config.Region))
+	}
+
+	if cached, _ := client.regionalClient("eu-west-1"); cached != regional {
+		t.Fatalf("Expected regional client to be cached")
+	}
+
+	if _, err := client.regionalClient("not-a-region"); err == nil {
+		t.Fatalf("Expected error for invalid region")
+	}
+}
+
+func TestAWSClient_lazyClients(t *testing.T) {
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	if len(client.clients) != 0 {
+		t.Fatalf("Expected no service clients before first use, got %d", len(client.clients))
+	}
+
+	conn := client.SQS()
+	if len(client.clients) != 1 {
+		t.Fatalf("Expected only the SQS client to be built, got %d clients", len(client.clients))
+	}
+	if client.SQS() != conn {
+		t.Fatalf("Expected SQS client to be cached")
+	}
+	if client.AccountID() != "" || client.SupportedPlatforms() != nil {
+		t.Fatalf("Expected skipped lookups to be empty, got %q, %v", client.AccountID(), client.SupportedPlatforms())
+	}
+}
+
+func TestAWSClient_lazyValidation(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+
+	var stsCalls, iamCalls int
+	api.Handle("sts", "GetCallerIdentity", func(*awsMockApiRequest) *awsMockResponse {
+		stsCalls++
+		return awsMockQueryResponse("GetCallerIdentity", fmt.Sprintf(
+			`<Arn>arn:aws:iam::%[1]s:user/mock</Arn><UserId>AIDAMOCK</UserId><Account>%[1]s</Account>`, awsMockAccountId))
+	})
+	api.Handle("iam", "GetUser", func(*awsMockApiRequest) *awsMockResponse {
+		iamCalls++
+		return awsMockQueryResponse("GetUser", fmt.Sprintf(
+			`<User><Arn>arn:aws:iam::%s:user/mock</Arn><UserName>mock</UserName><UserId>AIDAMOCK</UserId>`+
+				`<Path>/</Path><CreateDate>2018-01-01T00:00:00Z</CreateDate></User>`, awsMockAccountId))
+	})
+
+	c := &Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-west-2",
+		Endpoints:            make(map[string]string),
+		ForbiddenAccountIds:  []interface{}{awsMockAccountId},
+		SkipGetEC2Platforms:  true,
+		SkipMetadataApiCheck: true,
+	}
+	for _, endpointServiceName := range endpointServiceNames {
+		c.Endpoints[endpointServiceName] = api.URL
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	if stsCalls != 0 || iamCalls != 0 {
+		t.Fatalf("Expected no startup calls, got %d STS and %d IAM calls", stsCalls, iamCalls)
+	}
+
+	// The request is rejected before it is sent, so no SQS handler is needed.
+	for i := 0; i < 2; i++ {
+		_, err = client.SQS().ListQueues(&sqs.ListQueuesInput{})
+		if err == nil || !strings.Contains(err.Error(), "Forbidden account ID") {
+			t.Fatalf("Expected forbidden account ID error, got %v", err)
+		}
+	}
+
+	if stsCalls != 1 || iamCalls != 1 {
+		t.Fatalf("Expected a single validation, got %d STS and %d IAM calls", stsCalls, iamCalls)
+	}
+	if client.AccountID() != awsMockAccountId || client.Partition() != "aws" {
+		t.Fatalf("Expected account %s in aws partition, got %q in %q", awsMockAccountId, client.AccountID(), client.Partition())
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
@@ -57,12 +278,7 @@ func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(
 				log.Printf("[DEBUG] Mocked %s API responding with %d: %s",
 					svcName, e.Response.StatusCode, e.Response.Body)
 
-				w.WriteHeader(e.Response.StatusCode)
-				w.Header().Set("Content-Type", e.Response.ContentType)
-				w.Header().Set("X-Amzn-Requestid", "1b206dd1-f9a8-11e5-becf-051c60f11c4a")
-				w.Header().Set("Date", time.Now().Format(time.RFC1123))
-
-				fmt.Fprintln(w, e.Response.Body)
+				writeAwsMockResponse(w, e.Response)
 				return
 			}
 		}
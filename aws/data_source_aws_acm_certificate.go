@@ -38,7 +38,7 @@ func dataSourceAwsAcmCertificate() *schema.Resource {
 }
 
 func dataSourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmconn
+	conn := meta.(*AWSClient).ACM()
 
 	params := &acm.ListCertificatesInput{}
 	target := d.Get("domain")
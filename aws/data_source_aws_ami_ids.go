@@ -44,7 +44,7 @@ func dataSourceAwsAmiIds() *schema.Resource {
 }
 
 func dataSourceAwsAmiIdsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	executableUsers, executableUsersOk := d.GetOk("executable_users")
 	filters, filtersOk := d.GetOk("filter")
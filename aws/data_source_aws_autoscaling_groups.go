@@ -44,7 +44,7 @@ func dataSourceAwsAutoscalingGroups() *schema.Resource {
 }
 
 func dataSourceAwsAutoscalingGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 
 	log.Printf("[DEBUG] Reading Autoscaling Groups.")
 	d.SetId(time.Now().UTC().String())
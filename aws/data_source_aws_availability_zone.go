@@ -40,7 +40,7 @@ func dataSourceAwsAvailabilityZone() *schema.Resource {
 }
 
 func dataSourceAwsAvailabilityZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeAvailabilityZonesInput{}
 
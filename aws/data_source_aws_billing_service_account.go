@@ -24,7 +24,7 @@ func dataSourceAwsBillingServiceAccount() *schema.Resource {
 
 func dataSourceAwsBillingServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(billingAccountId)
-	d.Set("arn", fmt.Sprintf("arn:%s:iam::%s:root", meta.(*AWSClient).partition, billingAccountId))
+	d.Set("arn", fmt.Sprintf("arn:%s:iam::%s:root", meta.(*AWSClient).Partition(), billingAccountId))
 
 	return nil
 }
@@ -51,7 +51,7 @@ func testAccCheckAwsCallerIdentityAccountId(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Account Id resource ID not set.")
 		}
 
-		expected := testAccProvider.Meta().(*AWSClient).accountid
+		expected := testAccProvider.Meta().(*AWSClient).AccountID()
 		if rs.Primary.Attributes["account_id"] != expected {
 			return fmt.Errorf("Incorrect Account ID: expected %q, got %q", expected, rs.Primary.Attributes["account_id"])
 		}
@@ -23,7 +23,7 @@ func dataSourceAwsCanonicalUserId() *schema.Resource {
 }
 
 func dataSourceAwsCanonicalUserIdRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).S3()
 
 	log.Printf("[DEBUG] Reading S3 Buckets")
 
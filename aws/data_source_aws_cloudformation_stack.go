@@ -72,7 +72,7 @@ func dataSourceAwsCloudFormationStack() *schema.Resource {
 }
 
 func dataSourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).CloudFormation()
 	name := d.Get("name").(string)
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(name),
@@ -50,7 +50,7 @@ func dataSourceAwsCloudTrailServiceAccountRead(d *schema.ResourceData, meta inte
 
 	if accid, ok := cloudTrailServiceAccountPerRegionMap[region]; ok {
 		d.SetId(accid)
-		d.Set("arn", iamArnString(meta.(*AWSClient).partition, accid, "root"))
+		d.Set("arn", iamArnString(meta.(*AWSClient).Partition(), accid, "root"))
 		return nil
 	}
 
@@ -174,7 +174,7 @@ func dataSourceAwsDynamoDbTable() *schema.Resource {
 }
 
 func dataSourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 
 	name := d.Get("name").(string)
 	req := &dynamodb.DescribeTableInput{
@@ -190,5 +190,16 @@ func dataSourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(*result.Table.TableName)
 
-	return flattenAwsDynamoDbTableResource(d, meta, result.Table)
+	if err := flattenAwsDynamoDbTableResource(d, meta, result.Table); err != nil {
+		return err
+	}
+
+	tags, err := readTableTags(d, meta)
+	if err != nil {
+		return err
+	}
+	if len(tags) != 0 {
+		d.Set("tags", tags)
+	}
+	return nil
 }
@@ -37,7 +37,7 @@ func dataSourceAwsEbsSnapshotIds() *schema.Resource {
 }
 
 func dataSourceAwsEbsSnapshotIdsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	restorableUsers, restorableUsersOk := d.GetOk("restorable_by_user_ids")
 	filters, filtersOk := d.GetOk("filter")
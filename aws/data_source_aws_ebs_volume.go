@@ -65,7 +65,7 @@ func dataSourceAwsEbsVolume() *schema.Resource {
 }
 
 func dataSourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	filters, filtersOk := d.GetOk("filter")
 
@@ -128,10 +128,10 @@ func volumeDescriptionAttributes(d *schema.ResourceData, client *AWSClient, volu
 	d.Set("volume_id", volume.VolumeId)
 
 	arn := arn.ARN{
-		Partition: client.partition,
+		Partition: client.Partition(),
 		Region:    client.region,
 		Service:   "ec2",
-		AccountID: client.accountid,
+		AccountID: client.AccountID(),
 		Resource:  fmt.Sprintf("volume/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
@@ -36,7 +36,7 @@ func dataSourceAwsEcrRepository() *schema.Resource {
 }
 
 func dataSourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ECR()
 
 	repositoryName := d.Get("name").(string)
 	params := &ecr.DescribeRepositoriesInput{
@@ -50,7 +50,7 @@ func dataSourceAwsEfsFileSystem() *schema.Resource {
 }
 
 func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
-	efsconn := meta.(*AWSClient).efsconn
+	efsconn := meta.(*AWSClient).EFS()
 
 	describeEfsOpts := &efs.DescribeFileSystemsInput{}
 
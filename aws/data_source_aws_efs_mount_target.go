@@ -52,7 +52,7 @@ func dataSourceAwsEfsMountTarget() *schema.Resource {
 }
 
 func dataSourceAwsEfsMountTargetRead(d *schema.ResourceData, meta interface{}) error {
-	efsconn := meta.(*AWSClient).efsconn
+	efsconn := meta.(*AWSClient).EFS()
 
 	describeEfsOpts := &efs.DescribeMountTargetsInput{
 		MountTargetId: aws.String(d.Get("mount_target_id").(string)),
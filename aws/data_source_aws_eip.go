@@ -29,7 +29,7 @@ func dataSourceAwsEip() *schema.Resource {
 }
 
 func dataSourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeAddressesInput{}
 
@@ -150,7 +150,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 }
 
 func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Get("cluster_id").(string)),
@@ -212,7 +212,7 @@ func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	arn, err := buildECARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+	arn, err := buildECARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region)
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for ElastiCache Cluster %s", *cluster.CacheClusterId)
 	}
@@ -62,7 +62,7 @@ func dataSourceAwsElasticacheReplicationGroup() *schema.Resource {
 }
 
 func dataSourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 	input := &elasticache.DescribeReplicationGroupsInput{
 		ReplicationGroupId: aws.String(d.Get("replication_group_id").(string)),
 	}
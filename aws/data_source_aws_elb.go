@@ -191,7 +191,7 @@ func dataSourceAwsElb() *schema.Resource {
 }
 
 func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 	lbName := d.Get("name").(string)
 
 	input := &elb.DescribeLoadBalancersInput{
@@ -208,5 +208,13 @@ func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(*resp.LoadBalancerDescriptions[0].LoadBalancerName)
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, resp.LoadBalancerDescriptions[0])
+	if err := flattenAwsELbResource(d, meta.(*AWSClient).EC2(), elbconn, resp.LoadBalancerDescriptions[0]); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsElbTags(elbconn, d.Id())
+	if err != nil {
+		return err
+	}
+	return d.Set("tags", tags)
 }
@@ -24,7 +24,7 @@ func dataSourceAwsIamAccountAlias() *schema.Resource {
 }
 
 func dataSourceAwsIamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 
 	log.Printf("[DEBUG] Reading IAM Account Aliases.")
 	d.SetId(time.Now().UTC().String())
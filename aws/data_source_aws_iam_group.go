@@ -36,7 +36,7 @@ func dataSourceAwsIAMGroup() *schema.Resource {
 }
 
 func dataSourceAwsIAMGroupRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	groupName := d.Get("group_name").(string)
 
@@ -40,7 +40,7 @@ func dataSourceAwsIAMInstanceProfile() *schema.Resource {
 }
 
 func dataSourceAwsIAMInstanceProfileRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	name := d.Get("name").(string)
 
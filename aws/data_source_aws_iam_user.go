@@ -35,7 +35,7 @@ func dataSourceAwsIAMUser() *schema.Resource {
 }
 
 func dataSourceAwsIAMUserRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 	userName := d.Get("user_name").(string)
 	req := &iam.GetUserInput{
 		UserName: aws.String(userName),
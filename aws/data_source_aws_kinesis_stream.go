@@ -66,7 +66,7 @@ func dataSourceAwsKinesisStream() *schema.Resource {
 }
 
 func dataSourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).Kinesis()
 	sn := d.Get("name").(string)
 
 	state, err := readKinesisStreamState(conn, sn)
@@ -18,7 +18,7 @@ func TestAccAWSKinesisStreamDataSource(t *testing.T) {
 	config := fmt.Sprintf(testAccCheckAwsKinesisStreamDataSourceConfig, sn)
 
 	updateShardCount := func() {
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).Kinesis()
 		_, err := conn.UpdateShardCount(&kinesis.UpdateShardCountInput{
 			ScalingType:      aws.String(kinesis.ScalingTypeUniformScaling),
 			StreamName:       aws.String(sn),
@@ -53,7 +53,7 @@ func dataSourceAwsKmsSecret() *schema.Resource {
 
 // dataSourceAwsKmsSecretRead decrypts the specified secrets
 func dataSourceAwsKmsSecretRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 	secrets := d.Get("secret").(*schema.Set)
 
 	d.SetId(time.Now().UTC().String())
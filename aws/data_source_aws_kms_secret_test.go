@@ -67,7 +67,7 @@ func testAccCheckAwsKmsSecretDataSourceCheckKeySetup(s *terraform.State) (string
 		},
 	}
 
-	kmsconn := testAccProvider.Meta().(*AWSClient).kmsconn
+	kmsconn := testAccProvider.Meta().(*AWSClient).KMS()
 	resp, err := kmsconn.Encrypt(params)
 	if err != nil {
 		return "", fmt.Errorf("Failed encrypting string with KMS for data source testing: %s", err)
@@ -125,7 +125,7 @@ func dataSourceAwsLb() *schema.Resource {
 }
 
 func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 	lbArn := d.Get("arn").(string)
 	lbName := d.Get("name").(string)
 
@@ -147,5 +147,13 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(*describeResp.LoadBalancers[0].LoadBalancerArn)
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	if err := flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0]); err != nil {
+		return err
+	}
+
+	tags, err := describeElbV2Tags(elbconn, d.Id())
+	if err != nil {
+		return errwrap.Wrapf("Error retrieving LB Tags: {{err}}", err)
+	}
+	return d.Set("tags", tags)
 }
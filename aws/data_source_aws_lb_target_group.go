@@ -127,7 +127,7 @@ func dataSourceAwsLbTargetGroup() *schema.Resource {
 }
 
 func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 	tgArn := d.Get("arn").(string)
 	tgName := d.Get("name").(string)
 
@@ -151,5 +151,13 @@ func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) er
 	targetGroup := describeResp.TargetGroups[0]
 
 	d.SetId(*targetGroup.TargetGroupArn)
-	return flattenAwsLbTargetGroupResource(d, meta, targetGroup)
+	if err := flattenAwsLbTargetGroupResource(d, meta, targetGroup); err != nil {
+		return err
+	}
+
+	tags, err := describeElbV2Tags(elbconn, d.Id())
+	if err != nil {
+		return errwrap.Wrapf("Error retrieving Target Group Tags: {{err}}", err)
+	}
+	return d.Set("tags", tags)
 }
@@ -130,7 +130,7 @@ func dataSourceAwsNetworkInterface() *schema.Resource {
 }
 
 func dataSourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	input := &ec2.DescribeNetworkInterfacesInput{
 		NetworkInterfaceIds: []*string{aws.String(d.Get("id").(string))},
@@ -26,8 +26,8 @@ func dataSourceAwsPartitionRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading Partition.")
 	d.SetId(time.Now().UTC().String())
 
-	log.Printf("[DEBUG] Setting AWS Partition to %s.", client.partition)
-	d.Set("partition", meta.(*AWSClient).partition)
+	log.Printf("[DEBUG] Setting AWS Partition to %s.", client.Partition())
+	d.Set("partition", meta.(*AWSClient).Partition())
 
 	return nil
 }
@@ -30,7 +30,7 @@ func testAccCheckAwsPartition(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Can't find resource: %s", n)
 		}
 
-		expected := testAccProvider.Meta().(*AWSClient).partition
+		expected := testAccProvider.Meta().(*AWSClient).Partition()
 		if rs.Primary.Attributes["partition"] != expected {
 			return fmt.Errorf("Incorrect Partition: expected %q, got %q", expected, rs.Primary.Attributes["partition"])
 		}
@@ -149,7 +149,7 @@ func dataSourceAwsRdsCluster() *schema.Resource {
 }
 
 func dataSourceAwsRdsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).RDS()
 
 	params := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(d.Get("cluster_identifier").(string)),
@@ -50,7 +50,7 @@ func dataSourceAwsRedshiftServiceAccountRead(d *schema.ResourceData, meta interf
 
 	if accid, ok := redshiftServiceAccountPerRegionMap[region]; ok {
 		d.SetId(accid)
-		d.Set("arn", iamArnString(meta.(*AWSClient).partition, accid, "user/logs"))
+		d.Set("arn", iamArnString(meta.(*AWSClient).Partition(), accid, "user/logs"))
 		return nil
 	}
 
@@ -36,7 +36,7 @@ func dataSourceAwsRegion() *schema.Resource {
 }
 
 func dataSourceAwsRegionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	currentRegion := meta.(*AWSClient).region
 
 	req := &ec2.DescribeRegionsInput{}
@@ -56,7 +56,7 @@ func dataSourceAwsRoute53Zone() *schema.Resource {
 }
 
 func dataSourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).Route53()
 	name, nameExists := d.GetOk("name")
 	name = hostedZoneName(name.(string))
 	id, idExists := d.GetOk("zone_id")
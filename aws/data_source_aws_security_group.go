@@ -48,7 +48,7 @@ func dataSourceAwsSecurityGroup() *schema.Resource {
 }
 
 func dataSourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	req := &ec2.DescribeSecurityGroupsInput{}
 
 	if id, ok := d.GetOk("id"); ok {
@@ -92,7 +92,7 @@ func dataSourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("vpc_id", sg.VpcId)
 	d.Set("tags", tagsToMap(sg.Tags))
 	d.Set("arn", fmt.Sprintf("arn:%s:ec2:%s:%s:security-group/%s",
-		meta.(*AWSClient).partition, meta.(*AWSClient).region, *sg.OwnerId, *sg.GroupId))
+		meta.(*AWSClient).Partition(), meta.(*AWSClient).region, *sg.OwnerId, *sg.GroupId))
 
 	return nil
 }
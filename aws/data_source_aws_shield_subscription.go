@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsShieldSubscription() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsShieldSubscriptionRead,
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_commitment_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsShieldSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Shield()
+
+	log.Printf("[DEBUG] Reading Shield Subscription state")
+	stateResp, err := conn.GetSubscriptionState(&shield.GetSubscriptionStateInput{})
+	if err != nil {
+		return fmt.Errorf("Error reading Shield Subscription state: %s", err)
+	}
+
+	state := aws.StringValue(stateResp.SubscriptionState)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("state", state)
+	d.Set("start_time", "")
+	d.Set("time_commitment_in_seconds", 0)
+
+	// Accounts without a subscription have nothing more to describe.
+	if state != shield.SubscriptionStateActive {
+		return nil
+	}
+
+	resp, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+	if err != nil {
+		return fmt.Errorf("Error reading Shield Subscription: %s", err)
+	}
+
+	if resp.Subscription.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(resp.Subscription.StartTime).Format(time.RFC3339))
+	}
+	d.Set("time_commitment_in_seconds", resp.Subscription.TimeCommitmentInSeconds)
+
+	return nil
+}
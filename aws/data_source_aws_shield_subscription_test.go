@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsShieldSubscription_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsShieldSubscriptionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_shield_subscription.current", "state", regexp.MustCompile(`^(ACTIVE|INACTIVE)$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsShieldSubscriptionConfig = `
+data "aws_shield_subscription" "current" {}
+`
@@ -38,7 +38,7 @@ func dataSourceAwsSnsTopic() *schema.Resource {
 }
 
 func dataSourceAwsSnsTopicsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).SNS()
 	params := &sns.ListTopicsInput{}
 
 	target := d.Get("name")
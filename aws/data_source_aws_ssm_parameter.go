@@ -37,7 +37,7 @@ func dataSourceAwsSsmParameter() *schema.Resource {
 }
 
 func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	name := d.Get("name").(string)
 
@@ -63,10 +63,10 @@ func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*param.Name)
 
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
+		Partition: meta.(*AWSClient).Partition(),
 		Region:    meta.(*AWSClient).region,
 		Service:   "ssm",
-		AccountID: meta.(*AWSClient).accountid,
+		AccountID: meta.(*AWSClient).AccountID(),
 		Resource:  fmt.Sprintf("parameter/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
@@ -79,7 +79,7 @@ func dataSourceAwsSubnet() *schema.Resource {
 }
 
 func dataSourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeSubnetsInput{}
 
@@ -31,7 +31,7 @@ func dataSourceAwsSubnetIDs() *schema.Resource {
 }
 
 func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeSubnetsInput{}
 
@@ -77,7 +77,7 @@ func dataSourceAwsVpc() *schema.Resource {
 }
 
 func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeVpcsInput{}
 
@@ -30,7 +30,7 @@ func dataSourceAwsVpcEndpointService() *schema.Resource {
 }
 
 func dataSourceAwsVpcEndpointServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	service := d.Get("service").(string)
 	request := &ec2.DescribeVpcEndpointServicesInput{}
@@ -41,7 +41,7 @@ func dataSourceAwsVpnGateway() *schema.Resource {
 }
 
 func dataSourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeVpnGatewaysInput{}
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -85,3 +86,17 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 
 	return false
 }
+
+// The Budgets API returns limit amounts with a decimal part, e.g. "100.0"
+// for a configured "100".
+func suppressEquivalentBudgetLimitAmount(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
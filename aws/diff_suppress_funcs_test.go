@@ -29,3 +29,19 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected suppressEquivalentJsonDiffs to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestSuppressEquivalentBudgetLimitAmount(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	if !suppressEquivalentBudgetLimitAmount("", "100.0", "100", d) {
+		t.Errorf("Expected suppressEquivalentBudgetLimitAmount to return true for 100.0 == 100")
+	}
+
+	if suppressEquivalentBudgetLimitAmount("", "100.0", "100.5", d) {
+		t.Errorf("Expected suppressEquivalentBudgetLimitAmount to return false for 100.0 == 100.5")
+	}
+
+	if suppressEquivalentBudgetLimitAmount("", "", "100", d) {
+		t.Errorf("Expected suppressEquivalentBudgetLimitAmount to return false for empty old value")
+	}
+}
@@ -12,6 +12,13 @@ func TestHostedZoneIDForRegion(t *testing.T) {
 		t.Fatalf("bad: %s", r)
 	}
 
+	if r := HostedZoneIDForRegion("cn-north-1"); r != "Z5CN8UMXT92WN" {
+		t.Fatalf("bad: %s", r)
+	}
+	if r := HostedZoneIDForRegion("us-gov-west-1"); r != "Z31GFT0UA1I2HV" {
+		t.Fatalf("bad: %s", r)
+	}
+
 	// Bad input should be empty string
 	if r := HostedZoneIDForRegion("not-a-region"); r != "" {
 		t.Fatalf("bad: %s", r)
@@ -11,7 +11,7 @@ func resourceAwsCloudFrontDistributionImport(d *schema.ResourceData, meta interf
 	// We are merely setting this to the same value as the Default setting in the schema
 	d.Set("retain_on_delete", false)
 
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).CloudFront()
 	id := d.Id()
 	resp, err := conn.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{
 		Id: aws.String(id),
@@ -59,7 +59,7 @@ func TestAccAWSIAMPolicy_importBasic(t *testing.T) {
 }
 
 func testAccCheckAWSPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_policy" {
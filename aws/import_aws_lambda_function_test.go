@@ -24,7 +24,7 @@ func testSweepLambdaFunctions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	lambdaconn := client.(*AWSClient).lambdaconn
+	lambdaconn := client.(*AWSClient).Lambda()
 
 	resp, err := lambdaconn.ListFunctions(&lambda.ListFunctionsInput{})
 	if err != nil {
@@ -12,7 +12,7 @@ import (
 func resourceAwsNetworkAclImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// First query the resource itself
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
@@ -11,7 +11,7 @@ import (
 func resourceAwsRouteTableImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// First query the resource itself
 	id := d.Id()
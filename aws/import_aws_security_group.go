@@ -14,7 +14,7 @@ import (
 func resourceAwsSecurityGroupImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// First query the security group
 	sgRaw, _, err := SGStateRefreshFunc(conn, d.Id())()
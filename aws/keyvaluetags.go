@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// keyValueTags is the service independent representation of a set of
+// resource tags. Every service converts its own tag type to and from it, so
+// that diffing and ignore rules behave identically across resources.
+type keyValueTags map[string]string
+
+// newKeyValueTags returns the tags held in a schema map, such as the value of
+// a tags or tags_all attribute.
+func newKeyValueTags(m map[string]interface{}) keyValueTags {
+	tags := make(keyValueTags, len(m))
+	for k, v := range m {
+		tags[k] = v.(string)
+	}
+
+	return tags
+}
+
+// IgnoreAws returns the tags without the ones whose key has the reserved
+// "aws:" prefix, which can neither be created nor removed by users.
+func (tags keyValueTags) IgnoreAws() keyValueTags {
+	result := make(keyValueTags, len(tags))
+	for k, v := range tags {
+		if strings.HasPrefix(k, "aws:") {
+			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.", k, v)
+			continue
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns the tags whose key is not in newTags.
+func (tags keyValueTags) Removed(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tags of newTags which are missing from, or have a
+// different value in, the tags.
+func (tags keyValueTags) Updated(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range newTags {
+		if old, ok := tags[k]; !ok || old != v {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns the sorted tag keys.
+func (tags keyValueTags) Keys() []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Map returns the tags as a map suitable for schema.ResourceData.Set.
+func (tags keyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Chunks splits the tags into sets of at most size tags. A size of zero or
+// less returns the tags as a single set.
+func (tags keyValueTags) Chunks(size int) []keyValueTags {
+	if len(tags) == 0 {
+		return nil
+	}
+	if size <= 0 {
+		return []keyValueTags{tags}
+	}
+
+	var result []keyValueTags
+	chunk := make(keyValueTags)
+	for _, k := range tags.Keys() {
+		if len(chunk) == size {
+			result = append(result, chunk)
+			chunk = make(keyValueTags)
+		}
+		chunk[k] = tags[k]
+	}
+
+	return append(result, chunk)
+}
+
+// tagsAdapter is implemented once per service to list and update the tags of
+// a single resource through that service's API.
+type tagsAdapter interface {
+	// ListTags returns the current tags of the resource.
+	ListTags() (keyValueTags, error)
+
+	// TagResource creates the given tags, overwriting existing values.
+	TagResource(tags keyValueTags) error
+
+	// UntagResource removes the tags with the given keys.
+	UntagResource(keys []string) error
+}
+
+// tagsBatcher is implemented by adapters whose service limits the number of
+// tags handled in a single call.
+type tagsBatcher interface {
+	tagsBatchSize() int
+}
+
+// updateTags updates the tags of a resource from oldTags to newTags, both
+// schema maps, through the service adapter. Tags with the reserved "aws:"
+// prefix are never touched. Tags matching the provider ignore_tags
+// configuration are only in oldTags when the resource manages them, as they
+// are filtered out of state on read, so they are removed like any other tag.
+func updateTags(a tagsAdapter, oldTags, newTags map[string]interface{}) error {
+	o := newKeyValueTags(oldTags).IgnoreAws()
+	n := newKeyValueTags(newTags).IgnoreAws()
+
+	batchSize := 0
+	if b, ok := a.(tagsBatcher); ok {
+		batchSize = b.tagsBatchSize()
+	}
+
+	for _, removed := range o.Removed(n).Chunks(batchSize) {
+		log.Printf("[DEBUG] Removing tags: %s", removed.Keys())
+		if err := a.UntagResource(removed.Keys()); err != nil {
+			return err
+		}
+	}
+
+	for _, updated := range o.Updated(n).Chunks(batchSize) {
+		log.Printf("[DEBUG] Creating tags: %#v", updated)
+		if err := a.TagResource(updated); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateTagsAll is a helper to update the tags of a resource when its merged
+// tags field, named "tags_all", changes.
+func updateTagsAll(d *schema.ResourceData, a tagsAdapter) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, n := d.GetChange("tags_all")
+	return updateTags(a, o.(map[string]interface{}), n.(map[string]interface{}))
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeyValueTagsRemovedUpdated(t *testing.T) {
+	cases := []struct {
+		Old, New         map[string]interface{}
+		Updated, Removed keyValueTags
+	}{
+		// Basic add/remove
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"bar": "baz",
+			},
+			Updated: keyValueTags{
+				"bar": "baz",
+			},
+			Removed: keyValueTags{
+				"foo": "bar",
+			},
+		},
+
+		// Modify
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "baz",
+			},
+			Updated: keyValueTags{
+				"foo": "baz",
+			},
+			Removed: keyValueTags{},
+		},
+
+		// Unchanged
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "bar",
+			},
+			Updated: keyValueTags{},
+			Removed: keyValueTags{},
+		},
+	}
+
+	for i, tc := range cases {
+		o := newKeyValueTags(tc.Old)
+		n := newKeyValueTags(tc.New)
+		if u := o.Updated(n); !reflect.DeepEqual(u, tc.Updated) {
+			t.Fatalf("%d: bad updated: %#v", i, u)
+		}
+		if r := o.Removed(n); !reflect.DeepEqual(r, tc.Removed) {
+			t.Fatalf("%d: bad removed: %#v", i, r)
+		}
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	tags := keyValueTags{
+		"aws:cloudformation:logical-id": "foo",
+		"aws:foo:bar":                   "baz",
+		"Name":                          "test",
+		"kubernetes.io/cluster/foo":     "owned",
+		"Owner":                         "ops",
+	}
+
+	if m := tags.IgnoreAws(); !reflect.DeepEqual(m, keyValueTags{
+		"Name":                      "test",
+		"kubernetes.io/cluster/foo": "owned",
+		"Owner":                     "ops",
+	}) {
+		t.Fatalf("bad tags ignoring AWS: %#v", m)
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	tags := keyValueTags{"a": "1", "b": "2", "c": "3"}
+
+	cases := []struct {
+		Size     int
+		Expected []keyValueTags
+	}{
+		{
+			Size:     0,
+			Expected: []keyValueTags{tags},
+		},
+		{
+			Size: 2,
+			Expected: []keyValueTags{
+				{"a": "1", "b": "2"},
+				{"c": "3"},
+			},
+		},
+		{
+			Size:     3,
+			Expected: []keyValueTags{tags},
+		},
+	}
+
+	for i, tc := range cases {
+		if c := tags.Chunks(tc.Size); !reflect.DeepEqual(c, tc.Expected) {
+			t.Fatalf("%d: bad chunks: %#v", i, c)
+		}
+	}
+
+	if c := (keyValueTags{}).Chunks(2); c != nil {
+		t.Fatalf("bad chunks of no tags: %#v", c)
+	}
+}
+
+// testTagsAdapter records the calls made to update the tags of a resource.
+type testTagsAdapter struct {
+	batchSize int
+	tagged    []keyValueTags
+	untagged  [][]string
+}
+
+func (a *testTagsAdapter) ListTags() (keyValueTags, error) {
+	return keyValueTags{}, nil
+}
+
+func (a *testTagsAdapter) TagResource(tags keyValueTags) error {
+	a.tagged = append(a.tagged, tags)
+	return nil
+}
+
+func (a *testTagsAdapter) UntagResource(keys []string) error {
+	a.untagged = append(a.untagged, keys)
+	return nil
+}
+
+type testBatchTagsAdapter struct {
+	testTagsAdapter
+}
+
+func (a *testBatchTagsAdapter) tagsBatchSize() int {
+	return a.batchSize
+}
+
+func TestUpdateTags(t *testing.T) {
+	o := map[string]interface{}{
+		"aws:foo": "bar",
+		"a":       "1",
+		"b":       "2",
+		"c":       "3",
+	}
+	n := map[string]interface{}{
+		"a": "1",
+		"d": "4",
+		"e": "5",
+		"f": "6",
+	}
+
+	a := &testTagsAdapter{}
+	if err := updateTags(a, o, n); err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][]string{{"b", "c"}}; !reflect.DeepEqual(a.untagged, expected) {
+		t.Fatalf("bad untag calls: %#v", a.untagged)
+	}
+	if expected := []keyValueTags{{"d": "4", "e": "5", "f": "6"}}; !reflect.DeepEqual(a.tagged, expected) {
+		t.Fatalf("bad tag calls: %#v", a.tagged)
+	}
+
+	b := &testBatchTagsAdapter{testTagsAdapter{batchSize: 2}}
+	if err := updateTags(b, o, n); err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][]string{{"b", "c"}}; !reflect.DeepEqual(b.untagged, expected) {
+		t.Fatalf("bad batched untag calls: %#v", b.untagged)
+	}
+	if expected := []keyValueTags{{"d": "4", "e": "5"}, {"f": "6"}}; !reflect.DeepEqual(b.tagged, expected) {
+		t.Fatalf("bad batched tag calls: %#v", b.tagged)
+	}
+}
+
+// Tags matching ignore_tags are filtered out of state on read unless the
+// resource manages them, so one found in the old tags is removed like any
+// other tag.
+func TestUpdateTags_ignoreConfig(t *testing.T) {
+	o := map[string]interface{}{
+		"a":                       "1",
+		"kubernetes.io/role/elb":  "1",
+		"kubernetes.io/role/node": "1",
+	}
+	n := map[string]interface{}{
+		"a":                      "1",
+		"kubernetes.io/role/elb": "1",
+	}
+
+	a := &testTagsAdapter{}
+	if err := updateTags(a, o, n); err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][]string{{"kubernetes.io/role/node"}}; !reflect.DeepEqual(a.untagged, expected) {
+		t.Fatalf("bad untag calls: %#v", a.untagged)
+	}
+	if a.tagged != nil {
+		t.Fatalf("bad tag calls: %#v", a.tagged)
+	}
+}
@@ -231,19 +231,19 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 
 	return &schema.Resource{
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).OpsWorks()
 			return lt.Read(d, client)
 		},
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).OpsWorks()
 			return lt.Create(d, client)
 		},
 		Update: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).OpsWorks()
 			return lt.Update(d, client)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).OpsWorks()
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
@@ -18,7 +19,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +44,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -50,6 +53,20 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["shared_credentials_file"],
 			},
 
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["shared_config_file"],
+			},
+
+			"mfa_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["mfa_token_command"],
+			},
+
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -109,6 +126,10 @@ func Provider() terraform.ResourceProvider {
 
 			"endpoints": endpointsSchema(),
 
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -205,6 +226,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kms_alias":                        dataSourceAwsKmsAlias(),
 			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
 			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
+			"aws_launch_template":                  dataSourceAwsLaunchTemplate(),
 			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
 			"aws_network_interface":                dataSourceAwsNetworkInterface(),
 			"aws_partition":                        dataSourceAwsPartition(),
@@ -217,6 +239,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket":                        dataSourceAwsS3Bucket(),
 			"aws_s3_bucket_object":                 dataSourceAwsS3BucketObject(),
 			"aws_sns_topic":                        dataSourceAwsSnsTopic(),
+			"aws_shield_subscription":              dataSourceAwsShieldSubscription(),
 			"aws_ssm_parameter":                    dataSourceAwsSsmParameter(),
 			"aws_subnet":                           dataSourceAwsSubnet(),
 			"aws_subnet_ids":                       dataSourceAwsSubnetIDs(),
@@ -265,6 +288,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
 			"aws_appautoscaling_scheduled_action":          resourceAwsAppautoscalingScheduledAction(),
+			"aws_appsync_api_key":                          resourceAwsAppsyncApiKey(),
+			"aws_appsync_datasource":                       resourceAwsAppsyncDatasource(),
+			"aws_appsync_graphql_api":                      resourceAwsAppsyncGraphqlApi(),
+			"aws_appsync_resolver":                         resourceAwsAppsyncResolver(),
 			"aws_athena_database":                          resourceAwsAthenaDatabase(),
 			"aws_athena_named_query":                       resourceAwsAthenaNamedQuery(),
 			"aws_autoscaling_attachment":                   resourceAwsAutoscalingAttachment(),
@@ -272,7 +299,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
 			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
 			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
+			"aws_budgets_budget":                           resourceAwsBudgetsBudget(),
 			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
+			"aws_cloudformation_stack_set":                 resourceAwsCloudFormationStackSet(),
+			"aws_cloudformation_stack_set_instance":        resourceAwsCloudFormationStackSetInstance(),
 			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
 			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
 			"aws_cloudtrail":                               resourceAwsCloudTrail(),
@@ -291,7 +321,11 @@ func Provider() terraform.ResourceProvider {
 			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
 			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_identity_provider":                resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_resource_server":                  resourceAwsCognitoResourceServer(),
+			"aws_cognito_user_group":                       resourceAwsCognitoUserGroup(),
 			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
 			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
 			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
 			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
@@ -304,6 +338,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
 			"aws_codepipeline":                             resourceAwsCodePipeline(),
 			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
+			"aws_dax_cluster":                              resourceAwsDaxCluster(),
+			"aws_dax_parameter_group":                      resourceAwsDaxParameterGroup(),
+			"aws_dax_subnet_group":                         resourceAwsDaxSubnetGroup(),
 			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
 			"aws_db_instance":                              resourceAwsDbInstance(),
 			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
@@ -321,7 +358,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_dx_lag":                                   resourceAwsDxLag(),
 			"aws_dx_connection":                            resourceAwsDxConnection(),
 			"aws_dx_connection_association":                resourceAwsDxConnectionAssociation(),
+			"aws_dynamodb_global_table":                    resourceAwsDynamoDbGlobalTable(),
 			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_backup":                    resourceAwsDynamoDbTableBackup(),
+			"aws_dynamodb_table_item":                      resourceAwsDynamoDbTableItem(),
 			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
 			"aws_ebs_volume":                               resourceAwsEbsVolume(),
 			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
@@ -355,7 +395,17 @@ func Provider() terraform.ResourceProvider {
 			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                 resourceAwsFlowLog(),
 			"aws_glacier_vault":                            resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                       resourceAwsGlueCatalogTable(),
+			"aws_glue_connection":                          resourceAwsGlueConnection(),
+			"aws_glue_crawler":                             resourceAwsGlueCrawler(),
+			"aws_glue_job":                                 resourceAwsGlueJob(),
+			"aws_glue_trigger":                             resourceAwsGlueTrigger(),
 			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
+			"aws_guardduty_invite_accepter":                resourceAwsGuardDutyInviteAccepter(),
+			"aws_guardduty_ipset":                          resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
+			"aws_guardduty_threatintelset":                 resourceAwsGuardDutyThreatintelset(),
 			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
 			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
 			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
@@ -384,6 +434,12 @@ func Provider() terraform.ResourceProvider {
 			"aws_internet_gateway":                         resourceAwsInternetGateway(),
 			"aws_iot_certificate":                          resourceAwsIotCertificate(),
 			"aws_iot_policy":                               resourceAwsIotPolicy(),
+			"aws_iot_policy_attachment":                    resourceAwsIotPolicyAttachment(),
+			"aws_iot_thing":                                resourceAwsIotThing(),
+			"aws_iot_thing_principal_attachment":           resourceAwsIotThingPrincipalAttachment(),
+			"aws_iot_thing_type":                           resourceAwsIotThingType(),
+			"aws_iot_topic_rule":                           resourceAwsIotTopicRule(),
+			"aws_iot_role_alias":                           resourceAwsIotRoleAlias(),
 			"aws_key_pair":                                 resourceAwsKeyPair(),
 			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
@@ -394,6 +450,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
 			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
 			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                          resourceAwsLaunchTemplate(),
 			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
@@ -469,6 +526,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
 			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_shield_protection":                        resourceAwsShieldProtection(),
 			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
 			"aws_ssm_activation":                           resourceAwsSsmActivation(),
 			"aws_ssm_association":                          resourceAwsSsmAssociation(),
@@ -503,21 +561,36 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc":                                      resourceAwsVpc(),
 			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":   resourceAwsVpcEndpointServiceAllowedPrincipal(),
 			"aws_vpn_connection":                           resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
 			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
 			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
 			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
+			"aws_waf_geo_match_set":                        resourceAwsWafGeoMatchSet(),
 			"aws_waf_ipset":                                resourceAwsWafIPSet(),
 			"aws_waf_rule":                                 resourceAwsWafRule(),
+			"aws_waf_regex_match_set":                      resourceAwsWafRegexMatchSet(),
+			"aws_waf_regex_pattern_set":                    resourceAwsWafRegexPatternSet(),
 			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
 			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
 			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
 			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
 			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
 			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_geo_match_set":                resourceAwsWafRegionalGeoMatchSet(),
 			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_rate_based_rule":              resourceAwsWafRegionalRateBasedRule(),
+			"aws_wafregional_regex_match_set":              resourceAwsWafRegionalRegexMatchSet(),
+			"aws_wafregional_regex_pattern_set":            resourceAwsWafRegionalRegexPatternSet(),
+			"aws_wafregional_rule":                         resourceAwsWafRegionalRule(),
+			"aws_wafregional_size_constraint_set":          resourceAwsWafRegionalSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":      resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_web_acl":                      resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":          resourceAwsWafRegionalWebAclAssociation(),
+			"aws_wafregional_xss_match_set":                resourceAwsWafRegionalXssMatchSet(),
 			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
 			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
 			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
@@ -539,6 +612,12 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, r := range provider.ResourcesMap {
+		withRegionOverride(r)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -560,6 +639,12 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_file": "The path to the shared config file. If not set\n" +
+			"this defaults to ~/.aws/config.",
+
+		"mfa_token_command": "The command run to get an MFA token when the profile\n" +
+			"sets mfa_serial. It must print the token to standard output.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -567,17 +652,7 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"apigateway_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
@@ -585,24 +660,6 @@ func init() {
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"lambda_endpoint": "Use this to override the default endpoint URL constructed from the `region`\n",
-
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
@@ -612,7 +669,7 @@ func init() {
 		"skip_get_ec2_platforms": "Skip getting the supported EC2 platforms. " +
 			"Used by users that don't have ec2:DescribeAccountAttributes permissions.",
 
-		"skip_region_validation": "Skip static validation of region name. " +
+		"skip_region_validation": "Skip validation of region name against the regions of the AWS SDK partitions. " +
 			"Used by users of alternative AWS-like APIs or users w/ access to regions that are not public (yet).",
 
 		"skip_requesting_account_id": "Skip requesting the account ID. " +
@@ -628,12 +685,38 @@ func init() {
 
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
+		"assume_role": "Configuration blocks of the roles to assume, in order. Each role is assumed\n" +
+			"with the credentials of the previous one.",
+
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
-			" no session name is passed to the AssumeRole call.",
+			" no session name is passed to the AssumeRole call. It can be a template using" +
+			" {{.Index}}, {{.AccountID}}, {{.RoleName}}, {{.Timestamp}} and {{env \"NAME\"}}.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session." +
+			" Defaults to 15 minutes.",
+
+		"assume_role_with_web_identity": "Configuration block to assume a role with a web identity" +
+			" token read from a file, e.g. an OIDC token issued to a CI runner.",
+
+		"assume_role_web_identity_token_file": "The file holding the web identity token. It is" +
+			" read again whenever the credentials are refreshed.",
 
 		"assume_role_external_id": "The external ID to use when assuming the role. If omitted," +
 			" no external ID is passed to the AssumeRole call.",
 
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags set on a resource\n" +
+			"take precedence over these.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
+		"resource_region": "The region where the resource is managed. If omitted, the provider region is used.",
+
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
@@ -664,49 +747,88 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	configPath, err := homedir.Expand(d.Get("shared_config_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.ConfigFilename = configPath
+	config.MFATokenCommand = d.Get("mfa_token_command").(string)
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+	for _, v := range d.Get("assume_role").([]interface{}) {
+		if v == nil {
+			continue
 		}
+		assumeRole := v.(map[string]interface{})
+		// A block with an empty role_arn, e.g. one set from a variable
+		// without a value, doesn't assume a role.
+		if assumeRole["role_arn"].(string) == "" {
+			log.Printf("[INFO] Skipping assume_role block without role_arn")
+			continue
+		}
+		role := &AssumeRole{
+			RoleARN:         assumeRole["role_arn"].(string),
+			SessionName:     assumeRole["session_name"].(string),
+			ExternalID:      assumeRole["external_id"].(string),
+			Policy:          assumeRole["policy"].(string),
+			DurationSeconds: assumeRole["duration_seconds"].(int),
+		}
+		config.AssumeRoles = append(config.AssumeRoles, role)
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
-	} else {
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, DurationSeconds: %d)",
+			role.RoleARN, role.SessionName, role.ExternalID, role.Policy, role.DurationSeconds)
+	}
+	if len(config.AssumeRoles) == 0 {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	webIdentityList := d.Get("assume_role_with_web_identity").([]interface{})
+	if len(webIdentityList) == 1 && webIdentityList[0] != nil {
+		webIdentity := webIdentityList[0].(map[string]interface{})
+		tokenFile, err := homedir.Expand(webIdentity["web_identity_token_file"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoleWithWebIdentity = &AssumeRoleWithWebIdentity{
+			RoleARN:              webIdentity["role_arn"].(string),
+			SessionName:          webIdentity["session_name"].(string),
+			WebIdentityTokenFile: tokenFile,
+			Policy:               webIdentity["policy"].(string),
+			DurationSeconds:      webIdentity["duration_seconds"].(int),
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, WebIdentityTokenFile: %q)",
+			config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName, tokenFile)
+	}
+
+	config.Endpoints = make(map[string]string)
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.AcmEndpoint = endpoints["acm"].(string)
-		config.ApigatewayEndpoint = endpoints["apigateway"].(string)
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.EcrEndpoint = endpoints["ecr"].(string)
-		config.EcsEndpoint = endpoints["ecs"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.LambdaEndpoint = endpoints["lambda"].(string)
-		config.R53Endpoint = endpoints["r53"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
-		config.StsEndpoint = endpoints["sts"].(string)
+		for _, endpointServiceName := range endpointServiceNames {
+			config.Endpoints[endpointServiceName] = endpoints[endpointServiceName].(string)
+		}
+	}
+
+	defaultTagsList := d.Get("default_tags").([]interface{})
+	if len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+		defaultTags := defaultTagsList[0].(map[string]interface{})
+		config.DefaultTags = make(map[string]string)
+		for k, v := range defaultTags["tags"].(map[string]interface{}) {
+			config.DefaultTags[k] = v.(string)
+		}
+	}
+
+	ignoreTagsList := d.Get("ignore_tags").([]interface{})
+	if len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+		ignoreTags := ignoreTagsList[0].(map[string]interface{})
+		config.IgnoreTags = &IgnoreTagsConfig{}
+		for _, k := range ignoreTags["keys"].(*schema.Set).List() {
+			config.IgnoreTags.Keys = append(config.IgnoreTags.Keys, k.(string))
+		}
+		for _, p := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+			config.IgnoreTags.KeyPrefixes = append(config.IgnoreTags.KeyPrefixes, p.(string))
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -723,11 +845,123 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 // This is a global MutexKV for use within this plugin.
 var awsMutexKV = mutexkv.NewMutexKV()
 
+// withRegionOverride adds the optional region argument to a resource and
+// runs its CRUD functions with the clients of that region. A resource which
+// already has an optional region argument, such as aws_s3_bucket, uses it to
+// select its clients, while one with a required region argument of its own,
+// such as aws_opsworks_stack, is left unchanged.
+func withRegionOverride(r *schema.Resource) *schema.Resource {
+	if s, ok := r.Schema["region"]; ok {
+		if s.Required {
+			return r
+		}
+	} else {
+		r.Schema["region"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: descriptions["resource_region"],
+		}
+	}
+
+	regional := func(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+		return meta.(*AWSClient).regionalClient(d.Get("region").(string))
+	}
+
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regional(d, meta)
+			if err != nil {
+				return err
+			}
+			return create(d, client)
+		}
+	}
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regional(d, meta)
+			if err != nil {
+				return err
+			}
+			return read(d, client)
+		}
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regional(d, meta)
+			if err != nil {
+				return err
+			}
+			return update(d, client)
+		}
+	}
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regional(d, meta)
+			if err != nil {
+				return err
+			}
+			return del(d, client)
+		}
+	}
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, err := regional(d, meta)
+			if err != nil {
+				return false, err
+			}
+			return exists(d, client)
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, region := decodeRegionalImportID(d.Id())
+			if region != "" {
+				d.SetId(id)
+				d.Set("region", region)
+			}
+
+			client, err := regional(d, meta)
+			if err != nil {
+				return nil, err
+			}
+			results, err := state(d, client)
+			if err != nil {
+				return nil, err
+			}
+
+			if region != "" {
+				for _, result := range results {
+					result.Set("region", region)
+				}
+			}
+			return results, nil
+		}
+	}
+
+	return r
+}
+
+var regionalImportIDRegexp = regexp.MustCompile(`^(.+)@([a-z]{2}(?:-[a-z]+)+-\d)$`)
+
+// decodeRegionalImportID splits an import ID of the form ID@REGION, used to
+// import a resource from another region than the one of the provider. IDs
+// without a region suffix, including ones that contain an @, are returned
+// unchanged.
+func decodeRegionalImportID(id string) (string, string) {
+	m := regionalImportIDRegexp.FindStringSubmatch(id)
+	if m == nil {
+		return id, ""
+	}
+	return m[1], m[2]
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["assume_role"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -753,155 +987,124 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validateIntegerInRange(900, 43200),
+				},
 			},
 		},
 	}
 }
 
-func endpointsSchema() *schema.Schema {
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["assume_role_with_web_identity"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"acm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["acm_endpoint"],
-				},
-				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["apigateway_endpoint"],
-				},
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
-				},
-				"dynamodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
-				},
-				"iam": {
+				"role_arn": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_role_arn"],
 				},
 
-				"ec2": {
+				"session_name": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Description: descriptions["assume_role_session_name"],
 				},
 
-				"ecr": {
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecr_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_web_identity_token_file"],
 				},
 
-				"ecs": {
+				"policy": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecs_endpoint"],
+					Description: descriptions["assume_role_policy"],
 				},
 
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
-				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
-				},
-				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validateIntegerInRange(900, 43200),
 				},
-				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["lambda_endpoint"],
-				},
-				"r53": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["r53_endpoint"],
-				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
-				},
-				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
-				},
-				"sns": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
+					Description: descriptions["default_tags_tags"],
 				},
-				"sqs": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
 				},
-				"sts": {
-					Type:        schema.TypeString,
+				"key_prefixes": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sts_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
 				},
 			},
 		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, endpointServiceName := range endpointServiceNames {
+		endpointsAttributes[endpointServiceName] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions["endpoint"],
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -909,23 +1112,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["lambda"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, endpointServiceName := range endpointServiceNames {
+		buf.WriteString(fmt.Sprintf("%s-", m[endpointServiceName].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-template/template"
@@ -63,10 +65,96 @@ func testAccPreCheck(t *testing.T) {
 
 func testAccEC2ClassicPreCheck(t *testing.T) {
 	client := testAccProvider.Meta().(*AWSClient)
-	platforms := client.supportedplatforms
+	platforms := client.SupportedPlatforms()
 	region := client.region
 	if !hasEc2Classic(platforms) {
 		t.Skipf("This test can only run in EC2 Classic, platforms available in %s: %q",
 			region, platforms)
 	}
 }
+
+func TestProvider_endpointsToHash(t *testing.T) {
+	endpoints := make(map[string]interface{})
+	for _, endpointServiceName := range endpointServiceNames {
+		endpoints[endpointServiceName] = ""
+	}
+	emptyHash := endpointsToHash(endpoints)
+
+	for _, endpointServiceName := range endpointServiceNames {
+		endpoints[endpointServiceName] = "http://localhost:4567"
+		if endpointsToHash(endpoints) == emptyHash {
+			t.Errorf("Expected %q endpoint to change the endpoints hash", endpointServiceName)
+		}
+		endpoints[endpointServiceName] = ""
+	}
+}
+
+func TestDecodeRegionalImportID(t *testing.T) {
+	cases := []struct {
+		Input          string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{"sg-12345678", "sg-12345678", ""},
+		{"sg-12345678@eu-west-1", "sg-12345678", "eu-west-1"},
+		{"arn:aws:sns:us-gov-west-1:123456789012:topic@us-gov-west-1", "arn:aws:sns:us-gov-west-1:123456789012:topic", "us-gov-west-1"},
+		{"user@example.com", "user@example.com", ""},
+		{"user@example.com@ap-southeast-2", "user@example.com", "ap-southeast-2"},
+		{"@eu-west-1", "@eu-west-1", ""},
+	}
+
+	for _, tc := range cases {
+		id, region := decodeRegionalImportID(tc.Input)
+		if id != tc.ExpectedID || region != tc.ExpectedRegion {
+			t.Errorf("decodeRegionalImportID(%q) = %q, %q; expected %q, %q", tc.Input, id, region, tc.ExpectedID, tc.ExpectedRegion)
+		}
+	}
+}
+
+func TestProvider_assumeRoleEmptyRoleArn(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	queues := newAwsMockSqs(api)
+
+	var calls []string
+	api.Handle("sts", "AssumeRole", func(r *awsMockApiRequest) *awsMockResponse {
+		calls = append(calls, r.Params.Get("RoleArn"))
+		return awsMockQueryError(400, "ValidationError", "1 validation error detected")
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("SQS queues", queues.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigAssumeRoleEmptyRoleArn(api),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "name", "assume-role-empty-role-arn"),
+					func(*terraform.State) error {
+						if len(calls) > 0 {
+							return fmt.Errorf("expected no AssumeRole calls, got %q", calls)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderConfigAssumeRoleEmptyRoleArn(api *awsMockApi) string {
+	return `
+variable "role_arn" {
+  default = ""
+}
+` + api.ProviderConfig(`
+  assume_role {
+    role_arn     = "${var.role_arn}"
+    session_name = "terraform"
+  }
+`) + `
+resource "aws_sqs_queue" "queue" {
+  name = "assume-role-empty-role-arn"
+}
+`
+}
@@ -39,6 +39,8 @@ func resourceAwsAmi() *schema.Resource {
 
 		Schema: resourceSchema,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		// The Read, Update and Delete operations are shared with aws_ami_copy
 		// and aws_ami_from_instance, since they differ only in how the image
 		// is created.
@@ -49,7 +51,7 @@ func resourceAwsAmi() *schema.Resource {
 }
 
 func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).EC2()
 
 	req := &ec2.RegisterImageInput{
 		Name:               aws.String(d.Get("name").(string)),
@@ -130,7 +132,7 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).EC2()
 	id := d.Id()
 
 	req := &ec2.DescribeImagesInput{
@@ -233,13 +235,13 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	d.Set("tags", tagsToMap(image.Tags))
+	setTagsAll(d, meta, tagsToMap(image.Tags))
 
 	return nil
 }
 
 func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).EC2()
 
 	d.Partial(true)
 
@@ -247,6 +249,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.Get("description").(string) != "" {
@@ -268,7 +271,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).EC2()
 
 	req := &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -564,7 +567,8 @@ func resourceAwsAmiCommonSchema(computed bool) map[string]*schema.Schema {
 			},
 		},
 
-		"tags": tagsSchema(),
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaAll(),
 
 		// Not a public attribute; used to let the aws_ami_copy and aws_ami_from_instance
 		// resources record that they implicitly created new EBS snapshots that we should
@@ -50,6 +50,8 @@ func resourceAwsAmiCopy() *schema.Resource {
 
 		Schema: resourceSchema,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
 		Read:   resourceAwsAmiRead,
@@ -59,7 +61,7 @@ func resourceAwsAmiCopy() *schema.Resource {
 }
 
 func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).EC2()
 
 	req := &ec2.CopyImageInput{
 		Name:          aws.String(d.Get("name").(string)),
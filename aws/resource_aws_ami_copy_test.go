@@ -35,7 +35,7 @@ func TestAccAWSAMICopy(t *testing.T) {
 						return fmt.Errorf("AMI id is not set")
 					}
 
-					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+					conn := testAccProvider.Meta().(*AWSClient).EC2()
 					req := &ec2.DescribeImagesInput{
 						ImageIds: []*string{aws.String(amiId)},
 					}
@@ -78,7 +78,7 @@ func TestAccAWSAMICopy(t *testing.T) {
 			},
 		},
 		CheckDestroy: func(state *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).ec2conn
+			conn := testAccProvider.Meta().(*AWSClient).EC2()
 			diReq := &ec2.DescribeImagesInput{
 				ImageIds: []*string{aws.String(amiId)},
 			}
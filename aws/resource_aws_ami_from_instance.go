@@ -35,6 +35,8 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 
 		Schema: resourceSchema,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
 		Read:   resourceAwsAmiRead,
@@ -44,7 +46,7 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 }
 
 func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).EC2()
 
 	req := &ec2.CreateImageInput{
 		Name:        aws.String(d.Get("name").(string)),
@@ -80,7 +80,7 @@ func testCheckResourceGetAttr(name, key string, value *string) r.TestCheckFunc {
 
 func testAccAWSAMILaunchPermissionExists(accountID string, imageID *string) r.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		if has, err := hasLaunchPermission(conn, *imageID, accountID); err != nil {
 			return err
 		} else if !has {
@@ -92,7 +92,7 @@ func testAccAWSAMILaunchPermissionExists(accountID string, imageID *string) r.Te
 
 func testAccAWSAMILaunchPermissionDestroyed(accountID string, imageID *string) r.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		if has, err := hasLaunchPermission(conn, *imageID, accountID); err != nil {
 			return err
 		} else if has {
@@ -107,7 +107,7 @@ func testAccAWSAMILaunchPermissionDestroyed(accountID string, imageID *string) r
 // so we can test that Terraform will react properly
 func testAccAWSAMIDisappears(imageID *string) r.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		req := &ec2.DeregisterImageInput{
 			ImageId: aws.String(*imageID),
 		}
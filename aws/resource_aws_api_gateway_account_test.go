@@ -82,7 +82,7 @@ func testAccCheckAWSAPIGatewayAccountExists(n string, res *apigateway.Account) r
 			return fmt.Errorf("No API Gateway Account ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigateway
+		conn := testAccProvider.Meta().(*AWSClient).APIGateway()
 
 		req := &apigateway.GetAccountInput{}
 		describe, err := conn.GetAccount(req)
@@ -46,7 +46,7 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 }
 
 func resourceAwsApiGatewayBasePathMappingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).APIGateway()
 
 	err := resource.Retry(30*time.Second, func() *resource.RetryError {
 		_, err := conn.CreateBasePathMapping(&apigateway.CreateBasePathMappingInput{
@@ -80,7 +80,7 @@ func resourceAwsApiGatewayBasePathMappingCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsApiGatewayBasePathMappingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).APIGateway()
 
 	domainName := d.Get("domain_name").(string)
 	basePath := d.Get("base_path").(string)
@@ -121,7 +121,7 @@ func resourceAwsApiGatewayBasePathMappingRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsApiGatewayBasePathMappingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).APIGateway()
 
 	basePath := d.Get("base_path").(string)
 
@@ -65,7 +65,7 @@ func testAccCheckAWSAPIGatewayBasePathExists(n string, name string, res *apigate
 			return fmt.Errorf("No API Gateway ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigateway
+		conn := testAccProvider.Meta().(*AWSClient).APIGateway()
 
 		req := &apigateway.GetBasePathMappingInput{
 			DomainName: aws.String(name),
@@ -97,7 +97,7 @@ func testAccCheckAWSAPIGatewayEmptyBasePathExists(n string, name string, res *ap
 			return fmt.Errorf("No API Gateway ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigateway
+		conn := testAccProvider.Meta().(*AWSClient).APIGateway()
 
 		req := &apigateway.GetBasePathMappingInput{
 			DomainName: aws.String(name),
@@ -116,7 +116,7 @@ func testAccCheckAWSAPIGatewayEmptyBasePathExists(n string, name string, res *ap
 
 func testAccCheckAWSAPIGatewayBasePathDestroy(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigateway
+		conn := testAccProvider.Meta().(*AWSClient).APIGateway()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_api_gateway_rest_api" {
@@ -58,7 +58,7 @@ func resourceAwsAppCookieStickinessPolicy() *schema.Resource {
 }
 
 func resourceAwsAppCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	// Provision the AppStickinessPolicy
 	acspOpts := &elb.CreateAppCookieStickinessPolicyInput{
@@ -89,7 +89,7 @@ func resourceAwsAppCookieStickinessPolicyCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsAppCookieStickinessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	lbName, lbPort, policyName := resourceAwsAppCookieStickinessPolicyParseId(d.Id())
 
@@ -179,7 +179,7 @@ func resourceAwsELBSticknessPolicyAssigned(policyName, lbName, lbPort string, el
 }
 
 func resourceAwsAppCookieStickinessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	lbName, _, policyName := resourceAwsAppCookieStickinessPolicyParseId(d.Id())
 
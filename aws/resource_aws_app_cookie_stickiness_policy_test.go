@@ -48,7 +48,7 @@ func TestAccAWSAppCookieStickinessPolicy_missingLB(t *testing.T) {
 
 	// check that we can destroy the policy if the LB is missing
 	removeLB := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).ELB()
 		deleteElbOpts := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: aws.String(lbName),
 		}
@@ -80,7 +80,7 @@ func TestAccAWSAppCookieStickinessPolicy_missingLB(t *testing.T) {
 }
 
 func testAccCheckAppCookieStickinessPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).ELB()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_app_cookie_stickiness_policy" {
@@ -124,7 +124,7 @@ func testAccCheckAppCookieStickinessPolicy(elbResource string, policyResource st
 			return fmt.Errorf("Not found: %s", policyResource)
 		}
 
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).ELB()
 		elbName, _, policyName := resourceAwsAppCookieStickinessPolicyParseId(policy.Primary.ID)
 		_, err := elbconn.DescribeLoadBalancerPolicies(&elb.DescribeLoadBalancerPoliciesInput{
 			LoadBalancerName: aws.String(elbName),
@@ -146,7 +146,7 @@ func TestAccAWSAppCookieStickinessPolicy_drift(t *testing.T) {
 	// We only want to remove the reference to the policy from the listner,
 	// beacause that's all that can be done via the console.
 	removePolicy := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).ELB()
 
 		setLoadBalancerOpts := &elb.SetLoadBalancerPoliciesOfListenerInput{
 			LoadBalancerName: aws.String(lbName),
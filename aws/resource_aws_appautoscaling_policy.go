@@ -249,7 +249,7 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 }
 
 func resourceAwsAppautoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).ApplicationAutoScaling()
 
 	params, err := getAwsAppautoscalingPutScalingPolicyInput(d)
 	if err != nil {
@@ -314,7 +314,7 @@ func resourceAwsAppautoscalingPolicyRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsAppautoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).ApplicationAutoScaling()
 
 	params, inputErr := getAwsAppautoscalingPutScalingPolicyInput(d)
 	if inputErr != nil {
@@ -331,7 +331,7 @@ func resourceAwsAppautoscalingPolicyUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppautoscalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).ApplicationAutoScaling()
 	p, err := getAwsAppautoscalingPolicy(d, meta)
 	if err != nil {
 		return fmt.Errorf("Error getting policy: %s", err)
@@ -564,7 +564,7 @@ func getAwsAppautoscalingPutScalingPolicyInput(d *schema.ResourceData) (applicat
 }
 
 func getAwsAppautoscalingPolicy(d *schema.ResourceData, meta interface{}) (*applicationautoscaling.ScalingPolicy, error) {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).ApplicationAutoScaling()
 
 	params := applicationautoscaling.DescribeScalingPoliciesInput{
 		PolicyNames:      []*string{aws.String(d.Get("name").(string))},
@@ -159,7 +159,7 @@ func testAccCheckAWSAppautoscalingPolicyExists(n string, policy *applicationauto
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).ApplicationAutoScaling()
 		params := &applicationautoscaling.DescribeScalingPoliciesInput{
 			ServiceNamespace: aws.String(rs.Primary.Attributes["service_namespace"]),
 			PolicyNames:      []*string{aws.String(rs.Primary.ID)},
@@ -177,7 +177,7 @@ func testAccCheckAWSAppautoscalingPolicyExists(n string, policy *applicationauto
 }
 
 func testAccCheckAWSAppautoscalingPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).ApplicationAutoScaling()
 
 	for _, rs := range s.RootModule().Resources {
 		params := applicationautoscaling.DescribeScalingPoliciesInput{
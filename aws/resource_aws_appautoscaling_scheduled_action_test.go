@@ -81,7 +81,7 @@ func TestAccAwsAppautoscalingScheduledAction_SpotFleet(t *testing.T) {
 }
 
 func testAccCheckAwsAppautoscalingScheduledActionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).ApplicationAutoScaling()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appautoscaling_scheduled_action" {
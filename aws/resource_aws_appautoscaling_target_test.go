@@ -148,7 +148,7 @@ func TestAccAWSAppautoScalingTarget_optionalRoleArn(t *testing.T) {
 }
 
 func testAccCheckAWSAppautoscalingTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).ApplicationAutoScaling()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appautoscaling_target" {
@@ -194,7 +194,7 @@ func testAccCheckAWSAppautoscalingTargetExists(n string, target *applicationauto
 			return fmt.Errorf("No Application AutoScaling Target ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).ApplicationAutoScaling()
 
 		namespace := rs.Primary.Attributes["service_namespace"]
 		dimension := rs.Primary.Attributes["scalable_dimension"]
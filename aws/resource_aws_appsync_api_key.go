@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAppsyncApiKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncApiKeyCreate,
+		Read:   resourceAwsAppsyncApiKeyRead,
+		Delete: resourceAwsAppsyncApiKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// API keys cannot be updated, so changing the description
+			// recreates the key.
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncApiKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID := d.Get("api_id").(string)
+
+	input := &appsync.CreateApiKeyInput{
+		ApiId:       aws.String(apiID),
+		Description: aws.String(d.Get("description").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating AppSync API Key: %s", input)
+	resp, err := conn.CreateApiKey(input)
+	if err != nil {
+		return fmt.Errorf("Error creating AppSync API Key for GraphQL API %s: %s", apiID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", apiID, aws.StringValue(resp.ApiKey.Id)))
+
+	return resourceAwsAppsyncApiKeyRead(d, meta)
+}
+
+func resourceAwsAppsyncApiKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, keyID, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	key, err := getAppsyncApiKey(conn, apiID, keyID)
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] AppSync API Key (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AppSync API Key %s: %s", d.Id(), err)
+	}
+	if key == nil {
+		log.Printf("[WARN] AppSync API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("api_id", apiID)
+	d.Set("description", key.Description)
+	d.Set("expires", time.Unix(aws.Int64Value(key.Expires), 0).UTC().Format(time.RFC3339))
+	d.Set("key", key.Id)
+
+	return nil
+}
+
+func resourceAwsAppsyncApiKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, keyID, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppSync API Key: %s", d.Id())
+	_, err = conn.DeleteApiKey(&appsync.DeleteApiKeyInput{
+		ApiId: aws.String(apiID),
+		Id:    aws.String(keyID),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AppSync API Key %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAppsyncApiKeyID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected API_ID:API_KEY_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// getAppsyncApiKey returns the API key with the given ID, or nil if the
+// GraphQL API has no such key.
+func getAppsyncApiKey(conn *appsync.AppSync, apiID, keyID string) (*appsync.ApiKey, error) {
+	input := &appsync.ListApiKeysInput{
+		ApiId: aws.String(apiID),
+	}
+	for {
+		resp, err := conn.ListApiKeys(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range resp.ApiKeys {
+			if aws.StringValue(key.Id) == keyID {
+				return key, nil
+			}
+		}
+		if resp.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
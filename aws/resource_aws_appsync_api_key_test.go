@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncApiKey_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_api_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig(rName, "Managed by Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires"),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+				),
+			},
+			{
+				Config: testAccAppsyncApiKeyConfig(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncApiKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).AppSync()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_api_key" {
+			continue
+		}
+
+		apiID, keyID, err := decodeAppsyncApiKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		key, err := getAppsyncApiKey(conn, apiID, keyID)
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if key != nil {
+			return fmt.Errorf("AppSync API Key %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncApiKeyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiID, keyID, err := decodeAppsyncApiKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).AppSync()
+		key, err := getAppsyncApiKey(conn, apiID, keyID)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return fmt.Errorf("AppSync API Key %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppsyncApiKeyConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  description = "%s"
+}
+`, rName, description)
+}
@@ -0,0 +1,358 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAppsyncDatasource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncDatasourceCreate,
+		Read:   resourceAwsAppsyncDatasourceRead,
+		Update: resourceAwsAppsyncDatasourceUpdate,
+		Delete: resourceAwsAppsyncDatasourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppsyncName,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					appsync.DataSourceTypeAwsLambda,
+					appsync.DataSourceTypeAmazonDynamodb,
+					appsync.DataSourceTypeAmazonElasticsearch,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dynamodb_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"use_caller_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"elasticsearch_config", "lambda_config"},
+			},
+			"elasticsearch_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "lambda_config"},
+			},
+			"lambda_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config"},
+			},
+			"service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncDatasourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+	region := meta.(*AWSClient).region
+
+	apiID := d.Get("api_id").(string)
+	name := d.Get("name").(string)
+
+	input := &appsync.CreateDataSourceInput{
+		ApiId: aws.String(apiID),
+		Name:  aws.String(name),
+		Type:  aws.String(d.Get("type").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("dynamodb_config"); ok {
+		input.DynamodbConfig = expandAppsyncDynamodbDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("elasticsearch_config"); ok {
+		input.ElasticsearchConfig = expandAppsyncElasticsearchDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("lambda_config"); ok {
+		input.LambdaConfig = expandAppsyncLambdaDataSourceConfig(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating AppSync Datasource: %s", input)
+	if _, err := conn.CreateDataSource(input); err != nil {
+		return fmt.Errorf("Error creating AppSync Datasource %s: %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", apiID, name))
+
+	return resourceAwsAppsyncDatasourceRead(d, meta)
+}
+
+func resourceAwsAppsyncDatasourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, name, err := decodeAppsyncDatasourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetDataSource(&appsync.GetDataSourceInput{
+		ApiId: aws.String(apiID),
+		Name:  aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] AppSync Datasource (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AppSync Datasource %s: %s", d.Id(), err)
+	}
+
+	dataSource := resp.DataSource
+	d.Set("api_id", apiID)
+	d.Set("arn", dataSource.DataSourceArn)
+	d.Set("description", dataSource.Description)
+	d.Set("name", dataSource.Name)
+	d.Set("service_role_arn", dataSource.ServiceRoleArn)
+	d.Set("type", dataSource.Type)
+
+	if err := d.Set("dynamodb_config", flattenAppsyncDynamodbDataSourceConfig(dataSource.DynamodbConfig)); err != nil {
+		return fmt.Errorf("error setting dynamodb_config: %s", err)
+	}
+	if err := d.Set("elasticsearch_config", flattenAppsyncElasticsearchDataSourceConfig(dataSource.ElasticsearchConfig)); err != nil {
+		return fmt.Errorf("error setting elasticsearch_config: %s", err)
+	}
+	if err := d.Set("lambda_config", flattenAppsyncLambdaDataSourceConfig(dataSource.LambdaConfig)); err != nil {
+		return fmt.Errorf("error setting lambda_config: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+	region := meta.(*AWSClient).region
+
+	apiID, name, err := decodeAppsyncDatasourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateDataSourceInput{
+		ApiId: aws.String(apiID),
+		Name:  aws.String(name),
+		Type:  aws.String(d.Get("type").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("dynamodb_config"); ok {
+		input.DynamodbConfig = expandAppsyncDynamodbDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("elasticsearch_config"); ok {
+		input.ElasticsearchConfig = expandAppsyncElasticsearchDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("lambda_config"); ok {
+		input.LambdaConfig = expandAppsyncLambdaDataSourceConfig(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating AppSync Datasource: %s", input)
+	if _, err := conn.UpdateDataSource(input); err != nil {
+		return fmt.Errorf("Error updating AppSync Datasource %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncDatasourceRead(d, meta)
+}
+
+func resourceAwsAppsyncDatasourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, name, err := decodeAppsyncDatasourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppSync Datasource: %s", d.Id())
+	_, err = conn.DeleteDataSource(&appsync.DeleteDataSourceInput{
+		ApiId: aws.String(apiID),
+		Name:  aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AppSync Datasource %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAppsyncDatasourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected API_ID:DATASOURCE_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandAppsyncDynamodbDataSourceConfig(l []interface{}, currentRegion string) *appsync.DynamodbDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.DynamodbDataSourceConfig{
+		AwsRegion: aws.String(currentRegion),
+		TableName: aws.String(m["table_name"].(string)),
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		config.AwsRegion = aws.String(v)
+	}
+	if v, ok := m["use_caller_credentials"].(bool); ok {
+		config.UseCallerCredentials = aws.Bool(v)
+	}
+
+	return config
+}
+
+func flattenAppsyncDynamodbDataSourceConfig(config *appsync.DynamodbDataSourceConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"region":                 aws.StringValue(config.AwsRegion),
+		"table_name":             aws.StringValue(config.TableName),
+		"use_caller_credentials": aws.BoolValue(config.UseCallerCredentials),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandAppsyncElasticsearchDataSourceConfig(l []interface{}, currentRegion string) *appsync.ElasticsearchDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.ElasticsearchDataSourceConfig{
+		AwsRegion: aws.String(currentRegion),
+		Endpoint:  aws.String(m["endpoint"].(string)),
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		config.AwsRegion = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenAppsyncElasticsearchDataSourceConfig(config *appsync.ElasticsearchDataSourceConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"region":   aws.StringValue(config.AwsRegion),
+		"endpoint": aws.StringValue(config.Endpoint),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandAppsyncLambdaDataSourceConfig(l []interface{}) *appsync.LambdaDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &appsync.LambdaDataSourceConfig{
+		LambdaFunctionArn: aws.String(m["function_arn"].(string)),
+	}
+}
+
+func flattenAppsyncLambdaDataSourceConfig(config *appsync.LambdaDataSourceConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"function_arn": aws.StringValue(config.LambdaFunctionArn),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncDatasource_ddb(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_datasource.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncDatasourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncDatasourceConfig_ddb(rName, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:aws:appsync:[^:]+:[^:]+:apis/.+/datasources/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "first description"),
+					resource.TestCheckResourceAttr(resourceName, "dynamodb_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dynamodb_config.0.table_name", "aws_dynamodb_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AMAZON_DYNAMODB"),
+				),
+			},
+			{
+				Config: testAccAppsyncDatasourceConfig_ddb(rName, "second description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncDatasource_lambda(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_datasource.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncDatasourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncDatasourceConfig_lambda(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_config.0.function_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AWS_LAMBDA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncDatasourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).AppSync()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_datasource" {
+			continue
+		}
+
+		apiID, name, err := decodeAppsyncDatasourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetDataSource(&appsync.GetDataSourceInput{
+			ApiId: aws.String(apiID),
+			Name:  aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("AppSync Datasource %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncDatasourceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiID, name, err := decodeAppsyncDatasourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).AppSync()
+		_, err = conn.GetDataSource(&appsync.GetDataSourceInput{
+			ApiId: aws.String(apiID),
+			Name:  aws.String(name),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncDatasourceConfig_ddbBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "%s"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name           = "%s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "UserId"
+
+  attribute {
+    name = "UserId"
+    type = "S"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "%s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "dynamodb:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_dynamodb_table.test.arn}"
+      ]
+    }
+  ]
+}
+POLICY
+}
+`, rName, rName, rName, rName)
+}
+
+func testAccAppsyncDatasourceConfig_ddb(rName, description string) string {
+	return testAccAppsyncDatasourceConfig_ddbBase(rName) + fmt.Sprintf(`
+resource "aws_appsync_datasource" "test" {
+  api_id           = "${aws_appsync_graphql_api.test.id}"
+  name             = "%s"
+  description      = "%s"
+  service_role_arn = "${aws_iam_role.test.arn}"
+  type             = "AMAZON_DYNAMODB"
+
+  dynamodb_config {
+    table_name = "${aws_dynamodb_table.test.name}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, description)
+}
+
+func testAccAppsyncDatasourceConfig_lambda(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "%s"
+}
+
+resource "aws_iam_role" "lambda" {
+  name = "%s-lambda"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%s"
+  handler       = "exports.test"
+  role          = "${aws_iam_role.lambda.arn}"
+  runtime       = "nodejs6.10"
+}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "%s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "lambda:InvokeFunction"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_lambda_function.test.arn}"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id           = "${aws_appsync_graphql_api.test.id}"
+  name             = "%s"
+  service_role_arn = "${aws_iam_role.test.arn}"
+  type             = "AWS_LAMBDA"
+
+  lambda_config {
+    function_arn = "${aws_lambda_function.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, rName, rName, rName, rName, rName)
+}
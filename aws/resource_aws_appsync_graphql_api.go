@@ -0,0 +1,266 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAppsyncGraphqlApi() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncGraphqlApiCreate,
+		Read:   resourceAwsAppsyncGraphqlApiRead,
+		Update: resourceAwsAppsyncGraphqlApiUpdate,
+		Delete: resourceAwsAppsyncGraphqlApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					appsync.AuthenticationTypeApiKey,
+					appsync.AuthenticationTypeAwsIam,
+					appsync.AuthenticationTypeAmazonCognitoUserPools,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_pool_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_id_client_regex": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"aws_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"default_action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appsync.DefaultActionAllow,
+								appsync.DefaultActionDeny,
+							}, false),
+						},
+						"user_pool_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uris": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+	region := meta.(*AWSClient).region
+
+	input := &appsync.CreateGraphqlApiInput{
+		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
+		Name:               aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("user_pool_config"); ok {
+		input.UserPoolConfig = expandAppsyncGraphqlApiUserPoolConfig(v.([]interface{}), region)
+	}
+
+	log.Printf("[DEBUG] Creating AppSync GraphQL API: %s", input)
+	resp, err := conn.CreateGraphqlApi(input)
+	if err != nil {
+		return fmt.Errorf("Error creating AppSync GraphQL API %s: %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(resp.GraphqlApi.ApiId))
+
+	if v, ok := d.GetOk("schema"); ok {
+		if err := resourceAwsAppsyncSchemaPut(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppsyncGraphqlApiRead(d, meta)
+}
+
+func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	resp, err := conn.GetGraphqlApi(&appsync.GetGraphqlApiInput{
+		ApiId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] AppSync GraphQL API (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AppSync GraphQL API %s: %s", d.Id(), err)
+	}
+
+	api := resp.GraphqlApi
+	d.Set("arn", api.Arn)
+	d.Set("authentication_type", api.AuthenticationType)
+	d.Set("name", api.Name)
+
+	if err := d.Set("user_pool_config", flattenAppsyncGraphqlApiUserPoolConfig(api.UserPoolConfig)); err != nil {
+		return fmt.Errorf("error setting user_pool_config: %s", err)
+	}
+	if err := d.Set("uris", aws.StringValueMap(api.Uris)); err != nil {
+		return fmt.Errorf("error setting uris: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+	region := meta.(*AWSClient).region
+
+	input := &appsync.UpdateGraphqlApiInput{
+		ApiId:              aws.String(d.Id()),
+		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
+		Name:               aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("user_pool_config"); ok {
+		input.UserPoolConfig = expandAppsyncGraphqlApiUserPoolConfig(v.([]interface{}), region)
+	}
+
+	log.Printf("[DEBUG] Updating AppSync GraphQL API: %s", input)
+	if _, err := conn.UpdateGraphqlApi(input); err != nil {
+		return fmt.Errorf("Error updating AppSync GraphQL API %s: %s", d.Id(), err)
+	}
+
+	if d.HasChange("schema") {
+		if v, ok := d.GetOk("schema"); ok {
+			if err := resourceAwsAppsyncSchemaPut(conn, d.Id(), v.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsAppsyncGraphqlApiRead(d, meta)
+}
+
+func resourceAwsAppsyncGraphqlApiDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	log.Printf("[DEBUG] Deleting AppSync GraphQL API: %s", d.Id())
+	_, err := conn.DeleteGraphqlApi(&appsync.DeleteGraphqlApiInput{
+		ApiId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AppSync GraphQL API %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsAppsyncSchemaPut uploads a schema in SDL format and waits until
+// AppSync has finished processing it.
+func resourceAwsAppsyncSchemaPut(conn *appsync.AppSync, apiID, definition string) error {
+	log.Printf("[DEBUG] Starting AppSync GraphQL API (%s) schema creation", apiID)
+	_, err := conn.StartSchemaCreation(&appsync.StartSchemaCreationInput{
+		ApiId:      aws.String(apiID),
+		Definition: []byte(definition),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating schema of AppSync GraphQL API %s: %s", apiID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SchemaStatusProcessing},
+		Target:  []string{appsync.SchemaStatusActive},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.GetSchemaCreationStatus(&appsync.GetSchemaCreationStatusInput{
+				ApiId: aws.String(apiID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			status := aws.StringValue(resp.Status)
+			if status != appsync.SchemaStatusProcessing && status != appsync.SchemaStatusActive {
+				return nil, status, fmt.Errorf("%s", aws.StringValue(resp.Details))
+			}
+			return resp, status, nil
+		},
+		Timeout:    2 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for AppSync GraphQL API (%s) schema creation", apiID)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for schema of AppSync GraphQL API %s to be created: %s", apiID, err)
+	}
+
+	return nil
+}
+
+func expandAppsyncGraphqlApiUserPoolConfig(l []interface{}, currentRegion string) *appsync.UserPoolConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	userPoolConfig := &appsync.UserPoolConfig{
+		AwsRegion:     aws.String(currentRegion),
+		DefaultAction: aws.String(m["default_action"].(string)),
+		UserPoolId:    aws.String(m["user_pool_id"].(string)),
+	}
+
+	if v, ok := m["app_id_client_regex"].(string); ok && v != "" {
+		userPoolConfig.AppIdClientRegex = aws.String(v)
+	}
+	if v, ok := m["aws_region"].(string); ok && v != "" {
+		userPoolConfig.AwsRegion = aws.String(v)
+	}
+
+	return userPoolConfig
+}
+
+func flattenAppsyncGraphqlApiUserPoolConfig(userPoolConfig *appsync.UserPoolConfig) []map[string]interface{} {
+	if userPoolConfig == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"app_id_client_regex": aws.StringValue(userPoolConfig.AppIdClientRegex),
+		"aws_region":          aws.StringValue(userPoolConfig.AwsRegion),
+		"default_action":      aws.StringValue(userPoolConfig.DefaultAction),
+		"user_pool_id":        aws.StringValue(userPoolConfig.UserPoolId),
+	}
+
+	return []map[string]interface{}{m}
+}
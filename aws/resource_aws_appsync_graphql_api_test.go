@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncGraphqlApi_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_AuthenticationType(rName, "API_KEY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:aws:appsync:[^:]+:[^:]+:apis/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "API_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_config.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "uris.%"),
+					resource.TestCheckResourceAttrSet(resourceName, "uris.GRAPHQL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_AuthenticationType(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_AuthenticationType(rName, "API_KEY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "API_KEY"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_AuthenticationType(rName, "AWS_IAM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "AWS_IAM"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_UserPoolConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_UserPoolConfig(rName, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "AMAZON_COGNITO_USER_POOLS"),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_config.0.aws_region", "us-west-2"),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_config.0.default_action", "ALLOW"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_config.0.user_pool_id", "aws_cognito_user_pool.test", "id"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_UserPoolConfig(rName, "DENY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_config.0.default_action", "DENY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_Schema(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_Schema(rName, "Post"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_Schema(rName, "PostV2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncGraphqlApiDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).AppSync()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_graphql_api" {
+			continue
+		}
+
+		_, err := conn.GetGraphqlApi(&appsync.GetGraphqlApiInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("AppSync GraphQL API %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncGraphqlApiExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).AppSync()
+
+		_, err := conn.GetGraphqlApi(&appsync.GetGraphqlApiInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncGraphqlApiConfig_AuthenticationType(rName, authenticationType string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "%s"
+  name                = "%s"
+}
+`, authenticationType, rName)
+}
+
+func testAccAppsyncGraphqlApiConfig_UserPoolConfig(rName, defaultAction string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = "%s"
+}
+
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "AMAZON_COGNITO_USER_POOLS"
+  name                = "%s"
+
+  user_pool_config {
+    default_action = "%s"
+    user_pool_id   = "${aws_cognito_user_pool.test.id}"
+  }
+}
+`, rName, rName, defaultAction)
+}
+
+func testAccAppsyncGraphqlApiConfig_Schema(rName, typeName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "%s"
+
+  schema = <<SCHEMA
+type %s {
+  id: ID!
+  title: String
+}
+
+type Query {
+  getPost(id: ID!): %s
+}
+
+schema {
+  query: Query
+}
+SCHEMA
+}
+`, rName, typeName, typeName)
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAppsyncResolver() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncResolverCreate,
+		Read:   resourceAwsAppsyncResolverRead,
+		Update: resourceAwsAppsyncResolverUpdate,
+		Delete: resourceAwsAppsyncResolverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"request_template": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"response_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncResolverCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID := d.Get("api_id").(string)
+	typeName := d.Get("type").(string)
+	fieldName := d.Get("field").(string)
+
+	input := &appsync.CreateResolverInput{
+		ApiId:                  aws.String(apiID),
+		DataSourceName:         aws.String(d.Get("data_source").(string)),
+		FieldName:              aws.String(fieldName),
+		RequestMappingTemplate: aws.String(d.Get("request_template").(string)),
+		TypeName:               aws.String(typeName),
+	}
+	if v, ok := d.GetOk("response_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating AppSync Resolver: %s", input)
+	if _, err := conn.CreateResolver(input); err != nil {
+		return fmt.Errorf("Error creating AppSync Resolver %s.%s: %s", typeName, fieldName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", apiID, typeName, fieldName))
+
+	return resourceAwsAppsyncResolverRead(d, meta)
+}
+
+func resourceAwsAppsyncResolverRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetResolver(&appsync.GetResolverInput{
+		ApiId:     aws.String(apiID),
+		FieldName: aws.String(fieldName),
+		TypeName:  aws.String(typeName),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] AppSync Resolver (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AppSync Resolver %s: %s", d.Id(), err)
+	}
+
+	resolver := resp.Resolver
+	d.Set("api_id", apiID)
+	d.Set("arn", resolver.ResolverArn)
+	d.Set("data_source", resolver.DataSourceName)
+	d.Set("field", resolver.FieldName)
+	d.Set("request_template", resolver.RequestMappingTemplate)
+	d.Set("response_template", resolver.ResponseMappingTemplate)
+	d.Set("type", resolver.TypeName)
+
+	return nil
+}
+
+func resourceAwsAppsyncResolverUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateResolverInput{
+		ApiId:                  aws.String(apiID),
+		DataSourceName:         aws.String(d.Get("data_source").(string)),
+		FieldName:              aws.String(fieldName),
+		RequestMappingTemplate: aws.String(d.Get("request_template").(string)),
+		TypeName:               aws.String(typeName),
+	}
+	if v, ok := d.GetOk("response_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating AppSync Resolver: %s", input)
+	if _, err := conn.UpdateResolver(input); err != nil {
+		return fmt.Errorf("Error updating AppSync Resolver %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncResolverRead(d, meta)
+}
+
+func resourceAwsAppsyncResolverDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).AppSync()
+
+	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppSync Resolver: %s", d.Id())
+	_, err = conn.DeleteResolver(&appsync.DeleteResolverInput{
+		ApiId:     aws.String(apiID),
+		FieldName: aws.String(fieldName),
+		TypeName:  aws.String(typeName),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AppSync Resolver %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAppsyncResolverID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected API_ID:TYPE:FIELD", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncResolver_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_appsync_resolver.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig(rName, "2017-02-28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:aws:appsync:[^:]+:[^:]+:apis/.+/types/Query/fields/singlePost$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source", "aws_appsync_datasource.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "field", "singlePost"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Query"),
+					resource.TestMatchResourceAttr(resourceName, "request_template", regexp.MustCompile(`"version": "2017-02-28"`)),
+				),
+			},
+			{
+				Config: testAccAppsyncResolverConfig(rName, "2018-05-29"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "request_template", regexp.MustCompile(`"version": "2018-05-29"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncResolverDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).AppSync()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_resolver" {
+			continue
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetResolver(&appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("AppSync Resolver %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncResolverExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).AppSync()
+		_, err = conn.GetResolver(&appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncResolverConfig(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "%s"
+
+  schema = <<SCHEMA
+type Post {
+  id: ID!
+  title: String
+}
+
+type Query {
+  singlePost(id: ID!): Post
+}
+
+schema {
+  query: Query
+}
+SCHEMA
+}
+
+resource "aws_dynamodb_table" "test" {
+  name           = "%s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "%s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "dynamodb:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_dynamodb_table.test.arn}"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id           = "${aws_appsync_graphql_api.test.id}"
+  name             = "%s"
+  service_role_arn = "${aws_iam_role.test.arn}"
+  type             = "AMAZON_DYNAMODB"
+
+  dynamodb_config {
+    table_name = "${aws_dynamodb_table.test.name}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+
+resource "aws_appsync_resolver" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  field       = "singlePost"
+  type        = "Query"
+  data_source = "${aws_appsync_datasource.test.name}"
+
+  request_template = <<EOF
+{
+  "version": "%s",
+  "operation": "GetItem",
+  "key": {
+    "id": $util.dynamodb.toDynamoDBJson($ctx.args.id)
+  }
+}
+EOF
+
+  response_template = "$util.toJson($ctx.result)"
+}
+`, rName, rName, rName, rName, rName, version)
+}
@@ -39,7 +39,7 @@ func resourceAwsAthenaDatabase() *schema.Resource {
 }
 
 func resourceAwsAthenaDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).athenaconn
+	conn := meta.(*AWSClient).Athena()
 
 	input := &athena.StartQueryExecutionInput{
 		QueryString: aws.String(fmt.Sprintf("create database %s;", d.Get("name").(string))),
@@ -61,7 +61,7 @@ func resourceAwsAthenaDatabaseCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsAthenaDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).athenaconn
+	conn := meta.(*AWSClient).Athena()
 
 	bucket := d.Get("bucket").(string)
 	input := &athena.StartQueryExecutionInput{
@@ -87,7 +87,7 @@ func resourceAwsAthenaDatabaseUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsAthenaDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).athenaconn
+	conn := meta.(*AWSClient).Athena()
 
 	name := d.Get("name").(string)
 	bucket := d.Get("bucket").(string)
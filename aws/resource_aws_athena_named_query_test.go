@@ -28,7 +28,7 @@ func TestAccAWSAthenaNamedQuery_basic(t *testing.T) {
 }
 
 func testAccCheckAWSAthenaNamedQueryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+	conn := testAccProvider.Meta().(*AWSClient).Athena()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_named_query" {
 			continue
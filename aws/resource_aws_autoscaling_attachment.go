@@ -40,7 +40,7 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 }
 
 func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).AutoScaling()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
@@ -75,7 +75,7 @@ func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAutoscalingAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).AutoScaling()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	// Retrieve the ASG properites to get list of associated ELBs
@@ -126,7 +126,7 @@ func resourceAwsAutoscalingAttachmentRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAutoscalingAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).AutoScaling()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
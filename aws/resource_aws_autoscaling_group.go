@@ -63,8 +63,35 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"launch_configuration": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"launch_template"},
+			},
+
+			"launch_template": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"launch_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "$Default",
+						},
+					},
+				},
 			},
 
 			"desired_capacity": {
@@ -303,7 +330,7 @@ func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autosc
 }
 
 func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 
 	var asgName string
 	if v, ok := d.GetOk("name"); ok {
@@ -319,13 +346,20 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
-		LaunchConfigurationName:          aws.String(d.Get("launch_configuration").(string)),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
 	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(asgName),
 	}
 
+	if v, ok := d.GetOk("launch_configuration"); ok {
+		createOpts.LaunchConfigurationName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("launch_template"); ok {
+		createOpts.LaunchTemplate = expandAutoScalingLaunchTemplateSpecification(v.([]interface{}))
+	} else {
+		return fmt.Errorf("One of launch_configuration or launch_template must be set for an autoscaling group")
+	}
+
 	initialLifecycleHooks := d.Get("initial_lifecycle_hook").(*schema.Set).List()
 	twoPhases := len(initialLifecycleHooks) > 0
 
@@ -452,7 +486,7 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 
 	g, err := getAwsAutoscalingGroup(d.Id(), conn)
 	if err != nil {
@@ -471,6 +505,9 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if err := d.Set("launch_template", flattenAutoScalingLaunchTemplateSpecification(d, g.LaunchTemplate)); err != nil {
+		return fmt.Errorf("Error setting launch_template: %s", err)
+	}
 	d.Set("load_balancers", flattenStringList(g.LoadBalancerNames))
 
 	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
@@ -523,7 +560,13 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		for _, t := range g.Tags {
+			if !ignoreTagsConfig.Ignored(*t.Key) {
+				tagList = append(tagList, t)
+			}
+		}
+		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList))
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -555,7 +598,7 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 	shouldWaitForCapacity := false
 
 	opts := autoscaling.UpdateAutoScalingGroupInput{
@@ -574,7 +617,15 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("launch_configuration") {
-		opts.LaunchConfigurationName = aws.String(d.Get("launch_configuration").(string))
+		if v, ok := d.GetOk("launch_configuration"); ok {
+			opts.LaunchConfigurationName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("launch_template") {
+		if v, ok := d.GetOk("launch_template"); ok {
+			opts.LaunchTemplate = expandAutoScalingLaunchTemplateSpecification(v.([]interface{}))
+		}
 	}
 
 	if d.HasChange("min_size") {
@@ -733,7 +784,7 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAutoscalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 
 	// Read the autoscaling group first. If it doesn't exist, we're done.
 	// We need the group in order to check if there are instances attached.
@@ -823,7 +874,7 @@ func getAwsAutoscalingGroup(
 }
 
 func resourceAwsAutoscalingGroupDrain(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 
 	if d.Get("force_delete").(bool) {
 		log.Printf("[DEBUG] Skipping ASG drain, force_delete was set.")
@@ -987,7 +1038,7 @@ func updateASGMetricsCollection(d *schema.ResourceData, conn *autoscaling.AutoSc
 // Nested like: lbName -> instanceId -> instanceState
 func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	lbInstanceStates := make(map[string]map[string]string)
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	for _, lbName := range g.LoadBalancerNames {
 		lbInstanceStates[*lbName] = make(map[string]string)
@@ -1016,7 +1067,7 @@ func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]ma
 // Nested like: targetGroupARN -> instanceId -> instanceState
 func getTargetGroupInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	targetInstanceStates := make(map[string]map[string]string)
-	elbv2conn := meta.(*AWSClient).elbv2conn
+	elbv2conn := meta.(*AWSClient).ELBV2()
 
 	for _, targetGroupARN := range g.TargetGroupARNs {
 		targetInstanceStates[*targetGroupARN] = make(map[string]string)
@@ -1043,3 +1094,43 @@ func expandVpcZoneIdentifiers(list []interface{}) *string {
 	}
 	return aws.String(strings.Join(strs, ","))
 }
+
+func expandAutoScalingLaunchTemplateSpecification(l []interface{}) *autoscaling.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &autoscaling.LaunchTemplateSpecification{
+		Version: aws.String(m["version"].(string)),
+	}
+	if v := m["id"].(string); v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	}
+	if v := m["name"].(string); v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+
+	return spec
+}
+
+// flattenAutoScalingLaunchTemplateSpecification returns the launch_template
+// block of an autoscaling group. AWS returns both the ID and the name of the
+// launch template; only the one already in use is kept so that switching
+// between them shows up in the plan.
+func flattenAutoScalingLaunchTemplateSpecification(d *schema.ResourceData, spec *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if spec == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"version": aws.StringValue(spec.Version),
+	}
+	if _, ok := d.GetOk("launch_template.0.name"); ok {
+		m["name"] = aws.StringValue(spec.LaunchTemplateName)
+	} else {
+		m["id"] = aws.StringValue(spec.LaunchTemplateId)
+	}
+
+	return []interface{}{m}
+}
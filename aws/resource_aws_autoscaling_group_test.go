@@ -32,7 +32,7 @@ func testSweepAutoscalingGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).autoscalingconn
+	conn := client.(*AWSClient).AutoScaling()
 
 	resp, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
 	if err != nil {
@@ -596,8 +596,40 @@ func TestAccAWSAutoScalingGroup_ALB_TargetGroups_ELBCapacity(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_launchTemplate(t *testing.T) {
+	var group autoscaling.Group
+
+	rName := acctest.RandomWithPrefix("tf-asg-lt")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_withLaunchTemplate(rName, "${aws_launch_template.foobar.id}", "$Latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_configuration", ""),
+					resource.TestCheckResourceAttrPair(
+						"aws_autoscaling_group.bar", "launch_template.0.id", "aws_launch_template.foobar", "id"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "$Latest"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_withLaunchTemplate(rName, "${aws_launch_template.foobar2.id}", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttrPair(
+						"aws_autoscaling_group.bar", "launch_template.0.id", "aws_launch_template.foobar2", "id"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAutoScalingGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).AutoScaling()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
@@ -704,7 +736,7 @@ func testAccCheckAWSAutoScalingGroupExists(n string, group *autoscaling.Group) r
 			return fmt.Errorf("No AutoScaling Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).AutoScaling()
 
 		describeGroups, err := conn.DescribeAutoScalingGroups(
 			&autoscaling.DescribeAutoScalingGroupsInput{
@@ -818,7 +850,7 @@ func testAccCheckAWSAutoScalingGroupAttributesVPCZoneIdentifer(group *autoscalin
 // sure that all instances in it are healthy.
 func testAccCheckAWSALBTargetGroupHealthy(res *elbv2.TargetGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).ELBV2()
 
 		resp, err := conn.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
 			TargetGroupArn: res.TargetGroupArn,
@@ -1873,3 +1905,32 @@ resource "aws_launch_configuration" "test" {
   instance_type = "t1.micro"
 }
 `
+
+func testAccAWSAutoScalingGroupConfig_withLaunchTemplate(name, templateID, version string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foobar" {
+  name_prefix   = "foobar"
+  image_id      = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_launch_template" "foobar2" {
+  name_prefix   = "foobar2"
+  image_id      = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  name               = "%s"
+  desired_capacity   = 0
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    id      = "%s"
+    version = "%s"
+  }
+}
+`, name, templateID, version)
+}
@@ -36,7 +36,7 @@ func waitForASGCapacity(
 	log.Printf("[DEBUG] Waiting on %s for capacity...", d.Id())
 
 	err = resource.Retry(wait, func() *resource.RetryError {
-		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn)
+		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).AutoScaling())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -106,7 +106,7 @@ func waitForASGCapacity(
 
 	recentStatus := ""
 
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).AutoScaling()
 	resp, aErr := conn.DescribeScalingActivities(&autoscaling.DescribeScalingActivitiesInput{
 		AutoScalingGroupName: aws.String(d.Id()),
 		MaxRecords:           aws.Int64(1),
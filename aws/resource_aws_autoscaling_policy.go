@@ -98,7 +98,7 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 }
 
 func resourceAwsAutoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
 
 	params, err := getAwsAutoscalingPutScalingPolicyInput(d)
 	if err != nil {
@@ -152,7 +152,7 @@ func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAutoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
 
 	params, inputErr := getAwsAutoscalingPutScalingPolicyInput(d)
 	if inputErr != nil {
@@ -169,7 +169,7 @@ func resourceAwsAutoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAutoscalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
 	p, err := getAwsAutoscalingPolicy(d, meta)
 	if err != nil {
 		return err
@@ -265,7 +265,7 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 }
 
 func getAwsAutoscalingPolicy(d *schema.ResourceData, meta interface{}) (*autoscaling.ScalingPolicy, error) {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
 
 	params := autoscaling.DescribePoliciesInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
@@ -143,7 +143,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 }
 
 func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -240,7 +240,7 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsBatchComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -302,7 +302,7 @@ func flattenComputeResources(computeResource *batch.ComputeResource) []map[strin
 }
 
 func resourceAwsBatchComputeEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -353,7 +353,7 @@ func resourceAwsBatchComputeEnvironmentDelete(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -392,7 +392,7 @@ func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta inter
 
 func resourceAwsBatchComputeEnvironmentStatusRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).batchconn
+		conn := meta.(*AWSClient).Batch()
 
 		computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -416,7 +416,7 @@ func resourceAwsBatchComputeEnvironmentStatusRefreshFunc(d *schema.ResourceData,
 
 func resourceAwsBatchComputeEnvironmentDeleteRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).batchconn
+		conn := meta.(*AWSClient).Batch()
 
 		computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -218,7 +218,7 @@ func TestAccAWSBatchComputeEnvironment_createSpotWithoutBidPercentage(t *testing
 }
 
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).Batch()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_batch_compute_environment" {
@@ -245,7 +245,7 @@ func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).Batch()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_batch_compute_environment" {
@@ -74,7 +74,7 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 }
 
 func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 	name := d.Get("name").(string)
 
 	input := &batch.RegisterJobDefinitionInput{
@@ -108,7 +108,7 @@ func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 	arn := d.Get("arn").(string)
 	job, err := getJobDefinition(conn, arn)
 	if err != nil {
@@ -128,7 +128,7 @@ func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsBatchJobDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 	arn := d.Get("arn").(string)
 	_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
 		JobDefinition: aws.String(arn),
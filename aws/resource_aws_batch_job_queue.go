@@ -49,7 +49,7 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 }
 
 func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 	input := batch.CreateJobQueueInput{
 		ComputeEnvironmentOrder: createComputeEnvironmentOrder(d.Get("compute_environments").([]interface{})),
 		JobQueueName:            aws.String(d.Get("name").(string)),
@@ -84,7 +84,7 @@ func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 
 	jq, err := getJobQueue(conn, d.Get("name").(string))
 	if err != nil {
@@ -102,7 +102,7 @@ func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsBatchJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 
 	name := d.Get("name").(string)
 	updateInput := &batch.UpdateJobQueueInput{
@@ -132,7 +132,7 @@ func resourceAwsBatchJobQueueUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBatchJobQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).Batch()
 	sn := d.Get("name").(string)
 	_, err := conn.UpdateJobQueue(&batch.UpdateJobQueueInput{
 		JobQueue: aws.String(sn),
@@ -73,7 +73,7 @@ func testAccCheckBatchJobQueueExists(n string, jq *batch.JobQueueDetail) resourc
 			return fmt.Errorf("No Batch Job Queue ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).Batch()
 		name := rs.Primary.Attributes["name"]
 		queue, err := getJobQueue(conn, name)
 		if err != nil {
@@ -120,7 +120,7 @@ func testAccCheckBatchJobQueueDestroy(s *terraform.State) error {
 		if rs.Type != "aws_batch_job_queue" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).Batch()
 		jq, err := getJobQueue(conn, rs.Primary.Attributes["name"])
 		if err == nil {
 			if jq != nil {
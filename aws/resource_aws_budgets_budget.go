@@ -0,0 +1,704 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// budgetsTimePeriodLayout is the layout used for the start and end of a
+// budget's time period.
+const budgetsTimePeriodLayout = "2006-01-02_15:04"
+
+func resourceAwsBudgetsBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetCreate,
+		Read:   resourceAwsBudgetsBudgetRead,
+		Update: resourceAwsBudgetsBudgetUpdate,
+		Delete: resourceAwsBudgetsBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"budget_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.BudgetTypeCost,
+					budgets.BudgetTypeRiUtilization,
+					budgets.BudgetTypeUsage,
+				}, false),
+			},
+			"limit_amount": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentBudgetLimitAmount,
+			},
+			"limit_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cost_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_credit": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_other_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_recurring": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_refund": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_tax": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_upfront": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"use_blended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"time_period_start": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBudgetTimePeriodTimestamp,
+			},
+			"time_period_end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2087-06-15_00:00",
+				ValidateFunc: validateBudgetTimePeriodTimestamp,
+			},
+			"time_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.TimeUnitDaily,
+					budgets.TimeUnitMonthly,
+					budgets.TimeUnitQuarterly,
+					budgets.TimeUnitAnnually,
+				}, false),
+			},
+			"cost_filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"notification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorEqualTo,
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  budgets.ThresholdTypePercentage,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+						"subscriber": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"subscription_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											budgets.SubscriptionTypeEmail,
+											budgets.SubscriptionTypeSns,
+										}, false),
+									},
+								},
+							},
+							Set: resourceAwsBudgetsBudgetSubscriberHash,
+						},
+					},
+				},
+				Set: resourceAwsBudgetsBudgetNotificationHash,
+			},
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Budgets()
+
+	accountID := meta.(*AWSClient).AccountID()
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	budget, err := expandBudgetsBudget(d, name)
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.CreateBudgetInput{
+		AccountId:                    aws.String(accountID),
+		Budget:                       budget,
+		NotificationsWithSubscribers: expandBudgetsNotificationsWithSubscribers(d.Get("notification").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating Budget: %s", input)
+	if _, err := conn.CreateBudget(input); err != nil {
+		return fmt.Errorf("Error creating Budget %s: %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accountID, name))
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Budgets()
+
+	accountID, name, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Budget (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Budget %s: %s", d.Id(), err)
+	}
+
+	budget := resp.Budget
+	if budget == nil {
+		log.Printf("[WARN] Budget (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("name", budget.BudgetName)
+	d.Set("budget_type", budget.BudgetType)
+	d.Set("time_unit", budget.TimeUnit)
+
+	if budget.BudgetLimit != nil {
+		d.Set("limit_amount", budget.BudgetLimit.Amount)
+		d.Set("limit_unit", budget.BudgetLimit.Unit)
+	}
+
+	if budget.TimePeriod != nil {
+		d.Set("time_period_start", aws.TimeValue(budget.TimePeriod.Start).UTC().Format(budgetsTimePeriodLayout))
+		d.Set("time_period_end", aws.TimeValue(budget.TimePeriod.End).UTC().Format(budgetsTimePeriodLayout))
+	}
+
+	if err := d.Set("cost_types", flattenBudgetsCostTypes(budget.CostTypes)); err != nil {
+		return fmt.Errorf("error setting cost_types: %s", err)
+	}
+	if err := d.Set("cost_filters", flattenBudgetsCostFilters(budget.CostFilters)); err != nil {
+		return fmt.Errorf("error setting cost_filters: %s", err)
+	}
+
+	notifications, err := readBudgetsNotificationsWithSubscribers(conn, accountID, name)
+	if err != nil {
+		return fmt.Errorf("Error reading notifications of Budget %s: %s", d.Id(), err)
+	}
+	if err := d.Set("notification", flattenBudgetsNotificationsWithSubscribers(notifications)); err != nil {
+		return fmt.Errorf("error setting notification: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Budgets()
+
+	accountID, name, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	budget, err := expandBudgetsBudget(d, name)
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.UpdateBudgetInput{
+		AccountId: aws.String(accountID),
+		NewBudget: budget,
+	}
+
+	log.Printf("[DEBUG] Updating Budget: %s", input)
+	if _, err := conn.UpdateBudget(input); err != nil {
+		return fmt.Errorf("Error updating Budget %s: %s", d.Id(), err)
+	}
+
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		if err := updateBudgetsNotifications(conn, accountID, name, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return fmt.Errorf("Error updating notifications of Budget %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Budgets()
+
+	accountID, name, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Budget: %s", d.Id())
+	_, err = conn.DeleteBudget(&budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Budget %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeBudgetsBudgetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected ACCOUNT_ID:BUDGET_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// updateBudgetsNotifications reconciles the notifications of a budget. Notifications
+// are keyed by their comparison operator, type and threshold; subscribers of
+// notifications present in both lists are added and removed individually.
+func updateBudgetsNotifications(conn *budgets.Budgets, accountID, name string, o, n []interface{}) error {
+	oldNotifications := make(map[string]*budgets.NotificationWithSubscribers)
+	for _, v := range expandBudgetsNotificationsWithSubscribers(o) {
+		oldNotifications[budgetsNotificationKey(v.Notification)] = v
+	}
+	newNotifications := make(map[string]*budgets.NotificationWithSubscribers)
+	for _, v := range expandBudgetsNotificationsWithSubscribers(n) {
+		newNotifications[budgetsNotificationKey(v.Notification)] = v
+	}
+
+	for k, v := range oldNotifications {
+		if _, ok := newNotifications[k]; ok {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting Budget %s notification: %s", name, v.Notification)
+		_, err := conn.DeleteNotification(&budgets.DeleteNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(name),
+			Notification: v.Notification,
+		})
+		if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	for k, v := range newNotifications {
+		old, ok := oldNotifications[k]
+		if !ok {
+			log.Printf("[DEBUG] Creating Budget %s notification: %s", name, v.Notification)
+			_, err := conn.CreateNotification(&budgets.CreateNotificationInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(name),
+				Notification: v.Notification,
+				Subscribers:  v.Subscribers,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		oldSubscribers := make(map[string]*budgets.Subscriber)
+		for _, s := range old.Subscribers {
+			oldSubscribers[budgetsSubscriberKey(s)] = s
+		}
+		newSubscribers := make(map[string]*budgets.Subscriber)
+		for _, s := range v.Subscribers {
+			newSubscribers[budgetsSubscriberKey(s)] = s
+		}
+
+		// Create before delete, a notification must always keep at least
+		// one subscriber.
+		for sk, s := range newSubscribers {
+			if _, ok := oldSubscribers[sk]; ok {
+				continue
+			}
+			log.Printf("[DEBUG] Creating Budget %s subscriber: %s", name, s)
+			_, err := conn.CreateSubscriber(&budgets.CreateSubscriberInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(name),
+				Notification: v.Notification,
+				Subscriber:   s,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		for sk, s := range oldSubscribers {
+			if _, ok := newSubscribers[sk]; ok {
+				continue
+			}
+			log.Printf("[DEBUG] Deleting Budget %s subscriber: %s", name, s)
+			_, err := conn.DeleteSubscriber(&budgets.DeleteSubscriberInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(name),
+				Notification: v.Notification,
+				Subscriber:   s,
+			})
+			if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func readBudgetsNotificationsWithSubscribers(conn *budgets.Budgets, accountID, name string) ([]*budgets.NotificationWithSubscribers, error) {
+	var notifications []*budgets.Notification
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(name),
+	}
+	for {
+		resp, err := conn.DescribeNotificationsForBudget(input)
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				break
+			}
+			return nil, err
+		}
+		notifications = append(notifications, resp.Notifications...)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	results := make([]*budgets.NotificationWithSubscribers, 0, len(notifications))
+	for _, notification := range notifications {
+		var subscribers []*budgets.Subscriber
+		input := &budgets.DescribeSubscribersForNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(name),
+			Notification: notification,
+		}
+		for {
+			resp, err := conn.DescribeSubscribersForNotification(input)
+			if err != nil {
+				if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+					break
+				}
+				return nil, err
+			}
+			subscribers = append(subscribers, resp.Subscribers...)
+			if resp.NextToken == nil {
+				break
+			}
+			input.NextToken = resp.NextToken
+		}
+
+		results = append(results, &budgets.NotificationWithSubscribers{
+			Notification: notification,
+			Subscribers:  subscribers,
+		})
+	}
+
+	return results, nil
+}
+
+func expandBudgetsBudget(d *schema.ResourceData, name string) (*budgets.Budget, error) {
+	start, err := time.Parse(budgetsTimePeriodLayout, d.Get("time_period_start").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing time_period_start: %s", err)
+	}
+	end, err := time.Parse(budgetsTimePeriodLayout, d.Get("time_period_end").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing time_period_end: %s", err)
+	}
+
+	budget := &budgets.Budget{
+		BudgetName: aws.String(name),
+		BudgetType: aws.String(d.Get("budget_type").(string)),
+		BudgetLimit: &budgets.Spend{
+			Amount: aws.String(d.Get("limit_amount").(string)),
+			Unit:   aws.String(d.Get("limit_unit").(string)),
+		},
+		TimePeriod: &budgets.TimePeriod{
+			Start: aws.Time(start),
+			End:   aws.Time(end),
+		},
+		TimeUnit: aws.String(d.Get("time_unit").(string)),
+	}
+
+	if v, ok := d.GetOk("cost_types"); ok {
+		budget.CostTypes = expandBudgetsCostTypes(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("cost_filters"); ok {
+		budget.CostFilters = expandBudgetsCostFilters(v.(map[string]interface{}))
+	}
+
+	return budget, nil
+}
+
+func expandBudgetsCostTypes(l []interface{}) *budgets.CostTypes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &budgets.CostTypes{
+		IncludeCredit:            aws.Bool(m["include_credit"].(bool)),
+		IncludeOtherSubscription: aws.Bool(m["include_other_subscription"].(bool)),
+		IncludeRecurring:         aws.Bool(m["include_recurring"].(bool)),
+		IncludeRefund:            aws.Bool(m["include_refund"].(bool)),
+		IncludeSubscription:      aws.Bool(m["include_subscription"].(bool)),
+		IncludeSupport:           aws.Bool(m["include_support"].(bool)),
+		IncludeTax:               aws.Bool(m["include_tax"].(bool)),
+		IncludeUpfront:           aws.Bool(m["include_upfront"].(bool)),
+		UseBlended:               aws.Bool(m["use_blended"].(bool)),
+	}
+}
+
+func flattenBudgetsCostTypes(costTypes *budgets.CostTypes) []map[string]interface{} {
+	if costTypes == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"include_credit":             aws.BoolValue(costTypes.IncludeCredit),
+		"include_other_subscription": aws.BoolValue(costTypes.IncludeOtherSubscription),
+		"include_recurring":          aws.BoolValue(costTypes.IncludeRecurring),
+		"include_refund":             aws.BoolValue(costTypes.IncludeRefund),
+		"include_subscription":       aws.BoolValue(costTypes.IncludeSubscription),
+		"include_support":            aws.BoolValue(costTypes.IncludeSupport),
+		"include_tax":                aws.BoolValue(costTypes.IncludeTax),
+		"include_upfront":            aws.BoolValue(costTypes.IncludeUpfront),
+		"use_blended":                aws.BoolValue(costTypes.UseBlended),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+// Each cost filter is configured with a single value.
+func expandBudgetsCostFilters(m map[string]interface{}) map[string][]*string {
+	filters := make(map[string][]*string, len(m))
+	for k, v := range m {
+		filters[k] = []*string{aws.String(v.(string))}
+	}
+	return filters
+}
+
+func flattenBudgetsCostFilters(filters map[string][]*string) map[string]string {
+	m := make(map[string]string, len(filters))
+	for k, v := range filters {
+		if len(v) > 0 {
+			m[k] = aws.StringValue(v[0])
+		}
+	}
+	return m
+}
+
+func expandBudgetsNotificationsWithSubscribers(l []interface{}) []*budgets.NotificationWithSubscribers {
+	results := make([]*budgets.NotificationWithSubscribers, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		var subscribers []*budgets.Subscriber
+		for _, s := range m["subscriber"].(*schema.Set).List() {
+			sm := s.(map[string]interface{})
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(sm["address"].(string)),
+				SubscriptionType: aws.String(sm["subscription_type"].(string)),
+			})
+		}
+
+		results = append(results, &budgets.NotificationWithSubscribers{
+			Notification: &budgets.Notification{
+				ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+				NotificationType:   aws.String(m["notification_type"].(string)),
+				Threshold:          aws.Float64(m["threshold"].(float64)),
+				ThresholdType:      aws.String(m["threshold_type"].(string)),
+			},
+			Subscribers: subscribers,
+		})
+	}
+	return results
+}
+
+func flattenBudgetsNotificationsWithSubscribers(notifications []*budgets.NotificationWithSubscribers) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(notifications))
+	for _, n := range notifications {
+		subscribers := make([]interface{}, 0, len(n.Subscribers))
+		for _, s := range n.Subscribers {
+			subscribers = append(subscribers, map[string]interface{}{
+				"address":           aws.StringValue(s.Address),
+				"subscription_type": aws.StringValue(s.SubscriptionType),
+			})
+		}
+
+		thresholdType := aws.StringValue(n.Notification.ThresholdType)
+		if thresholdType == "" {
+			thresholdType = budgets.ThresholdTypePercentage
+		}
+
+		results = append(results, map[string]interface{}{
+			"comparison_operator": aws.StringValue(n.Notification.ComparisonOperator),
+			"notification_type":   aws.StringValue(n.Notification.NotificationType),
+			"threshold":           aws.Float64Value(n.Notification.Threshold),
+			"threshold_type":      thresholdType,
+			"subscriber":          schema.NewSet(resourceAwsBudgetsBudgetSubscriberHash, subscribers),
+		})
+	}
+	return results
+}
+
+func budgetsNotificationKey(n *budgets.Notification) string {
+	thresholdType := aws.StringValue(n.ThresholdType)
+	if thresholdType == "" {
+		thresholdType = budgets.ThresholdTypePercentage
+	}
+	return fmt.Sprintf("%s:%s:%g:%s", aws.StringValue(n.ComparisonOperator), aws.StringValue(n.NotificationType), aws.Float64Value(n.Threshold), thresholdType)
+}
+
+func budgetsSubscriberKey(s *budgets.Subscriber) string {
+	return fmt.Sprintf("%s:%s", aws.StringValue(s.SubscriptionType), aws.StringValue(s.Address))
+}
+
+func resourceAwsBudgetsBudgetNotificationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["comparison_operator"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["notification_type"].(string)))
+	buf.WriteString(fmt.Sprintf("%g-", m["threshold"].(float64)))
+	buf.WriteString(fmt.Sprintf("%s-", m["threshold_type"].(string)))
+	if v, ok := m["subscriber"]; ok {
+		for _, s := range v.(*schema.Set).List() {
+			buf.WriteString(fmt.Sprintf("%d-", resourceAwsBudgetsBudgetSubscriberHash(s)))
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
+
+func resourceAwsBudgetsBudgetSubscriberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["subscription_type"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+
+	return hashcode.String(buf.String())
+}
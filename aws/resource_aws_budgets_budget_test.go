@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeBudgetsBudgetID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		AccountID   string
+		Name        string
+		ErrCount    int
+		Description string
+	}{
+		{
+			ID:          "123456789012:my-budget",
+			AccountID:   "123456789012",
+			Name:        "my-budget",
+			Description: "account ID and name",
+		},
+		{
+			ID:          "123456789012:my:budget",
+			AccountID:   "123456789012",
+			Name:        "my:budget",
+			Description: "name containing a colon",
+		},
+		{
+			ID:          "my-budget",
+			ErrCount:    1,
+			Description: "name only",
+		},
+		{
+			ID:          ":my-budget",
+			ErrCount:    1,
+			Description: "empty account ID",
+		},
+	}
+
+	for _, tc := range cases {
+		accountID, name, err := decodeBudgetsBudgetID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.Description, err)
+		}
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Fatalf("%s: expected an error", tc.Description)
+			}
+			continue
+		}
+		if accountID != tc.AccountID {
+			t.Fatalf("%s: expected account ID %q, got %q", tc.Description, tc.AccountID, accountID)
+		}
+		if name != tc.Name {
+			t.Fatalf("%s: expected name %q, got %q", tc.Description, tc.Name, name)
+		}
+	}
+}
+
+func TestAccAWSBudgetsBudget_basic(t *testing.T) {
+	var budget budgets.Budget
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "budget_type", "COST"),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "100.0"),
+					resource.TestCheckResourceAttr(resourceName, "limit_unit", "USD"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_start", "2017-01-01_00:00"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_end", "2087-06-15_00:00"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "MONTHLY"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.include_tax", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.use_blended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudget_notification(t *testing.T) {
+	var budget budgets.Budget
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_notification(rName, 80, "first@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_notification(rName, 80, "second@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_notification(rName, 90, "second@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSBudgetsBudgetExists(n string, v *budgets.Budget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Budget ID is set")
+		}
+
+		accountID, name, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).Budgets()
+		resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Budget == nil {
+			return fmt.Errorf("Budget %s not found", rs.Primary.ID)
+		}
+
+		*v = *resp.Budget
+		return nil
+	}
+}
+
+func testAccCheckAWSBudgetsBudgetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).Budgets()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_budgets_budget" {
+			continue
+		}
+
+		accountID, name, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Budget %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSBudgetsBudgetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filters {
+    Service = "Amazon Elastic Compute Cloud - Compute"
+  }
+}
+`, rName)
+}
+
+func testAccAWSBudgetsBudgetConfig_notification(rName string, threshold int, address string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filters {
+    Service = "Amazon Elastic Compute Cloud - Compute"
+  }
+
+  notification {
+    comparison_operator = "GREATER_THAN"
+    notification_type   = "ACTUAL"
+    threshold           = %d
+
+    subscriber {
+      subscription_type = "EMAIL"
+      address           = "%s"
+    }
+  }
+}
+`, rName, threshold, address)
+}
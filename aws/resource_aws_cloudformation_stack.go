@@ -31,6 +31,8 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -105,6 +107,7 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"iam_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -114,7 +117,7 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 }
 
 func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).CloudFormation()
 
 	input := cloudformation.CreateStackInput{
 		StackName: aws.String(d.Get("name").(string)),
@@ -154,7 +157,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
@@ -256,7 +259,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).CloudFormation()
 
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(d.Id()),
@@ -332,7 +335,7 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	err = d.Set("tags", flattenCloudFormationTags(stack.Tags))
+	err = setTagsAll(d, meta, flattenCloudFormationTags(stack.Tags))
 	if err != nil {
 		return err
 	}
@@ -353,7 +356,7 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).CloudFormation()
 
 	input := &cloudformation.UpdateStackInput{
 		StackName: aws.String(d.Id()),
@@ -385,7 +388,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 
@@ -479,7 +482,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).CloudFormation()
 
 	input := &cloudformation.DeleteStackInput{
 		StackName: aws.String(d.Id()),
@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudFormationStackSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetCreate,
+		Read:   resourceAwsCloudFormationStackSetRead,
+		Update: resourceAwsCloudFormationStackSetUpdate,
+		Delete: resourceAwsCloudFormationStackSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudFormationStackSetName,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"template_url"},
+				ValidateFunc:  validateCloudFormationTemplate,
+				StateFunc: func(v interface{}) string {
+					template, _ := normalizeCloudFormationTemplate(v)
+					return template
+				},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tags_all": tagsSchemaAll(),
+			"stack_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	name := d.Get("name").(string)
+	input := &cloudformation.CreateStackSetInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		StackSetName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation Stack Set: %s", input)
+	_, err := conn.CreateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("Creating CloudFormation Stack Set failed: %s", err.Error())
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	input := &cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading CloudFormation Stack Set: %s", input)
+	resp, err := conn.DescribeStackSet(input)
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation Stack Set %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading CloudFormation Stack Set '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	stackSet := resp.StackSet
+	if aws.StringValue(stackSet.Status) == cloudformation.StackSetStatusDeleted {
+		log.Printf("[WARN] CloudFormation Stack Set %q is deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", stackSet.StackSetName)
+	d.Set("description", stackSet.Description)
+	d.Set("stack_set_id", stackSet.StackSetId)
+
+	template, err := normalizeCloudFormationTemplate(aws.StringValue(stackSet.TemplateBody))
+	if err != nil {
+		return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+	}
+	d.Set("template_body", template)
+
+	if err := d.Set("capabilities", schema.NewSet(schema.HashString, flattenStringList(stackSet.Capabilities))); err != nil {
+		return err
+	}
+
+	originalParams := d.Get("parameters").(map[string]interface{})
+	if err := d.Set("parameters", flattenCloudFormationParameters(stackSet.Parameters, originalParams)); err != nil {
+		return err
+	}
+
+	if err := setTagsAll(d, meta, flattenCloudFormationTags(stackSet.Tags)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	input := &cloudformation.UpdateStackSetInput{
+		OperationId:  aws.String(resource.UniqueId()),
+		StackSetName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	// Capabilities and parameters must be present whether they are changed or not
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating CloudFormation Stack Set: %s", input)
+	resp, err := conn.UpdateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("Updating CloudFormation Stack Set '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, d.Id(), aws.StringValue(resp.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Waiting for CloudFormation Stack Set '%s' update failed: %s", d.Id(), err.Error())
+	}
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	input := &cloudformation.DeleteStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation Stack Set: %s", input)
+	_, err := conn.DeleteStackSet(input)
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting CloudFormation Stack Set '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func cloudFormationStackSetOperationPreferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failure_tolerance_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(0),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+				},
+				"failure_tolerance_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(0, 100),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+				},
+				"max_concurrent_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+				},
+				"max_concurrent_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(1, 100),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+				},
+				"region_order": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandCloudFormationStackSetOperationPreferences(l []interface{}) *cloudformation.StackSetOperationPreferences {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	preferences := &cloudformation.StackSetOperationPreferences{}
+
+	if v, ok := m["failure_tolerance_count"].(int); ok && v > 0 {
+		preferences.FailureToleranceCount = aws.Int64(int64(v))
+	}
+	if v, ok := m["failure_tolerance_percentage"].(int); ok && v > 0 {
+		preferences.FailureTolerancePercentage = aws.Int64(int64(v))
+	}
+	if v, ok := m["max_concurrent_count"].(int); ok && v > 0 {
+		preferences.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+	if v, ok := m["max_concurrent_percentage"].(int); ok && v > 0 {
+		preferences.MaxConcurrentPercentage = aws.Int64(int64(v))
+	}
+	if v, ok := m["region_order"].([]interface{}); ok && len(v) > 0 {
+		preferences.RegionOrder = expandStringList(v)
+	}
+
+	return preferences
+}
+
+// waitForCloudFormationStackSetOperation waits for a stack set operation to
+// finish and reports the per account and region failures if it did not
+// succeed.
+func waitForCloudFormationStackSetOperation(conn *cloudformation.CloudFormation, stackSetName, operationID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackSetOperationStatusRunning,
+			cloudformation.StackSetOperationStatusStopping,
+		},
+		Target: []string{
+			cloudformation.StackSetOperationStatusSucceeded,
+			cloudformation.StackSetOperationStatusFailed,
+			cloudformation.StackSetOperationStatusStopped,
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+				OperationId:  aws.String(operationID),
+				StackSetName: aws.String(stackSetName),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp.StackSetOperation, aws.StringValue(resp.StackSetOperation.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	operation := raw.(*cloudformation.StackSetOperation)
+	status := aws.StringValue(operation.Status)
+	if status == cloudformation.StackSetOperationStatusSucceeded {
+		return nil
+	}
+
+	reasons, err := getCloudFormationStackSetOperationFailures(conn, stackSetName, operationID)
+	if err != nil {
+		return fmt.Errorf("Operation %s is %s, failed getting failure reasons: %s", operationID, status, err.Error())
+	}
+
+	return fmt.Errorf("Operation %s is %s: %s", operationID, status, strings.Join(reasons, ", "))
+}
+
+func getCloudFormationStackSetOperationFailures(conn *cloudformation.CloudFormation, stackSetName, operationID string) ([]string, error) {
+	var reasons []string
+
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		OperationId:  aws.String(operationID),
+		StackSetName: aws.String(stackSetName),
+	}
+	for {
+		resp, err := conn.ListStackSetOperationResults(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range resp.Summaries {
+			if aws.StringValue(result.Status) == cloudformation.StackSetOperationResultStatusSucceeded {
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("%s/%s: %s (%s)",
+				aws.StringValue(result.Account),
+				aws.StringValue(result.Region),
+				aws.StringValue(result.Status),
+				aws.StringValue(result.StatusReason)))
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return reasons, nil
+}
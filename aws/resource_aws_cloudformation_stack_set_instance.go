@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetInstanceCreate,
+		Read:   resourceAwsCloudFormationStackSetInstanceRead,
+		Update: resourceAwsCloudFormationStackSetInstanceUpdate,
+		Delete: resourceAwsCloudFormationStackSetInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"stack_set_instance_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"parameter_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"retain_stack": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.CloudFormation()
+
+	stackSetName := d.Get("stack_set_name").(string)
+
+	accountID := client.AccountID()
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	// The region argument added to every resource selects the region of the
+	// stack set, which manages all of its instances, so the region of the
+	// instance itself has a name of its own.
+	region := client.region
+	if v, ok := d.GetOk("stack_set_instance_region"); ok {
+		region = v.(string)
+	}
+
+	input := &cloudformation.CreateStackInstancesInput{
+		Accounts:     []*string{aws.String(accountID)},
+		OperationId:  aws.String(resource.UniqueId()),
+		Regions:      []*string{aws.String(region)},
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+	}
+
+	// Only one operation can run on a stack set at a time, so instances of
+	// the same stack set created in parallel have to wait their turn.
+	var operationID string
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		log.Printf("[DEBUG] Creating CloudFormation Stack Set Instance: %s", input)
+		resp, err := conn.CreateStackInstances(input)
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		operationID = aws.StringValue(resp.OperationId)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Creating CloudFormation Stack Set Instance failed: %s", err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", stackSetName, accountID, region))
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, operationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Waiting for CloudFormation Stack Set Instance '%s' creation failed: %s", d.Id(), err.Error())
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DescribeStackInstanceInput{
+		StackInstanceAccount: aws.String(accountID),
+		StackInstanceRegion:  aws.String(region),
+		StackSetName:         aws.String(stackSetName),
+	}
+
+	log.Printf("[DEBUG] Reading CloudFormation Stack Set Instance: %s", input)
+	resp, err := conn.DescribeStackInstance(input)
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation Stack Set Instance %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading CloudFormation Stack Set Instance '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	instance := resp.StackInstance
+	d.Set("stack_set_name", stackSetName)
+	d.Set("account_id", instance.Account)
+	d.Set("stack_set_instance_region", instance.Region)
+	d.Set("stack_id", instance.StackId)
+	d.Set("status", instance.Status)
+
+	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(instance.ParameterOverrides)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	if d.HasChange("parameter_overrides") {
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		// An empty list removes all of the overrides.
+		input := &cloudformation.UpdateStackInstancesInput{
+			Accounts:           []*string{aws.String(accountID)},
+			OperationId:        aws.String(resource.UniqueId()),
+			ParameterOverrides: []*cloudformation.Parameter{},
+			Regions:            []*string{aws.String(region)},
+			StackSetName:       aws.String(stackSetName),
+		}
+
+		if v, ok := d.GetOk("parameter_overrides"); ok {
+			input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+		}
+		if v, ok := d.GetOk("operation_preferences"); ok {
+			input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+		}
+
+		var operationID string
+		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			log.Printf("[DEBUG] Updating CloudFormation Stack Set Instance: %s", input)
+			resp, err := conn.UpdateStackInstances(input)
+			if err != nil {
+				if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			operationID = aws.StringValue(resp.OperationId)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Updating CloudFormation Stack Set Instance '%s' failed: %s", d.Id(), err.Error())
+		}
+
+		if err := waitForCloudFormationStackSetOperation(conn, stackSetName, operationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Waiting for CloudFormation Stack Set Instance '%s' update failed: %s", d.Id(), err.Error())
+		}
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CloudFormation()
+
+	stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DeleteStackInstancesInput{
+		Accounts:     []*string{aws.String(accountID)},
+		OperationId:  aws.String(resource.UniqueId()),
+		Regions:      []*string{aws.String(region)},
+		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+	}
+
+	var operationID string
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		log.Printf("[DEBUG] Deleting CloudFormation Stack Set Instance: %s", input)
+		resp, err := conn.DeleteStackInstances(input)
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		operationID = aws.StringValue(resp.OperationId)
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting CloudFormation Stack Set Instance '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, operationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Waiting for CloudFormation Stack Set Instance '%s' deletion failed: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func decodeCloudFormationStackSetInstanceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected STACK_SET_NAME:ACCOUNT_ID:REGION", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
+	var instance cloudformation.StackInstance
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", cloudformation.StackInstanceStatusCurrent),
+					resource.TestCheckResourceAttrSet(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_set_instance_region"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_stack"},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName, "override-vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.VpcName", "override-vpc"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_stackSetInstanceRegion(t *testing.T) {
+	var instance cloudformation.StackInstance
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigStackSetInstanceRegion(rName, "us-east-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_instance_region", "us-east-1"),
+					resource.TestMatchResourceAttr(resourceName, "stack_id", regexp.MustCompile("^arn:aws:cloudformation:us-east-1:")),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_stack"},
+			},
+		},
+	})
+}
+
+func TestAWSCloudFormationStackSetInstance_mockApiStackSetInstanceRegion(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	stackSet := newAwsMockCloudFormationStackSet(api, "tf-mock-stack-set", "us-east-1")
+
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("CloudFormation stack instances", stackSet.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig() + testAccAWSCloudFormationStackSetInstanceConfig_mockApi("tf-mock-stack-set", "eu-west-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("tf-mock-stack-set:%s:eu-west-1", awsMockAccountId)),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_instance_region", "eu-west-1"),
+					resource.TestCheckNoResourceAttr(resourceName, "region"),
+					resource.TestCheckResourceAttr(resourceName, "status", cloudformation.StackInstanceStatusCurrent),
+					func(*terraform.State) error {
+						if regions := stackSet.Regions(); !reflect.DeepEqual(regions, []string{"eu-west-1"}) {
+							return fmt.Errorf("stack instances created in regions %q", regions)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeCloudFormationStackSetInstanceID(t *testing.T) {
+	validIDs := []string{
+		"my-stack-set:123456789012:us-west-2",
+	}
+	for _, id := range validIDs {
+		if _, _, _, err := decodeCloudFormationStackSetInstanceID(id); err != nil {
+			t.Fatalf("%q should be a valid ID: %s", id, err)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"my-stack-set",
+		"my-stack-set:123456789012",
+		"my-stack-set::us-west-2",
+		"my-stack-set:123456789012:us-west-2:extra",
+	}
+	for _, id := range invalidIDs {
+		if _, _, _, err := decodeCloudFormationStackSetInstanceID(id); err == nil {
+			t.Fatalf("%q should be an invalid ID", id)
+		}
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceExists(n string, instance *cloudformation.StackInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).CloudFormation()
+		resp, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if err != nil {
+			return err
+		}
+
+		*instance = *resp.StackInstance
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).CloudFormation()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set_instance" {
+			continue
+		}
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("CloudFormation Stack Set Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name = "%s"
+
+  parameters {
+    VpcName = "vpc"
+  }
+
+  template_body = <<TEMPLATE
+{
+  "Parameters": {
+    "VpcName": {
+      "Type": "String"
+    }
+  },
+  "Resources": {
+    "MyVPC": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "10.0.0.0/16",
+        "Tags": [
+          {"Key": "Name", "Value": {"Ref": "VpcName"}}
+        ]
+      }
+    }
+  }
+}
+TEMPLATE
+}
+`, rName)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig(rName, vpcNameOverride string) string {
+	overrides := ""
+	if vpcNameOverride != "" {
+		overrides = fmt.Sprintf(`
+  parameter_overrides {
+    VpcName = "%s"
+  }
+`, vpcNameOverride)
+	}
+
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+
+  operation_preferences {
+    failure_tolerance_count = 0
+    max_concurrent_count    = 1
+  }
+%s}
+`, overrides)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigStackSetInstanceRegion(rName, region string) string {
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_instance_region = "%s"
+  stack_set_name            = "${aws_cloudformation_stack_set.test.name}"
+}
+`, region)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig_mockApi(stackSetName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  account_id                = "%s"
+  stack_set_instance_region = "%s"
+  stack_set_name            = "%s"
+}
+`, awsMockAccountId, region, stackSetName)
+}
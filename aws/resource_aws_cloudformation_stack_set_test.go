@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSet_basic(t *testing.T) {
+	var stackSet cloudformation.StackSet
+	resourceName := "aws_cloudformation_stack_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "test description", "vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcName", "vpc"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_set_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_update(t *testing.T) {
+	var stackSet cloudformation.StackSet
+	resourceName := "aws_cloudformation_stack_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "test description", "vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcName", "vpc"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "updated description", "updated-vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcName", "updated-vpc"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckAWSCloudFormationStackSet skips the test unless the account
+// has the administration role that stack set operations are run with.
+func testAccPreCheckAWSCloudFormationStackSet(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).IAM()
+
+	_, err := conn.GetRole(&iam.GetRoleInput{
+		RoleName: aws.String("AWSCloudFormationStackSetAdministrationRole"),
+	})
+	if err != nil {
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			t.Skip("IAM role AWSCloudFormationStackSetAdministrationRole does not exist")
+		}
+		t.Fatalf("Error reading IAM role AWSCloudFormationStackSetAdministrationRole: %s", err)
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetExists(n string, stackSet *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).CloudFormation()
+		resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*stackSet = *resp.StackSet
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).CloudFormation()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set" {
+			continue
+		}
+
+		resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.StackSet.Status) != cloudformation.StackSetStatusDeleted {
+			return fmt.Errorf("CloudFormation Stack Set %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudFormationStackSetConfig(rName, description, vpcName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name        = "%s"
+  description = "%s"
+
+  parameters {
+    VpcName = "%s"
+  }
+
+  tags {
+    Name = "tf-acc-test"
+  }
+
+  template_body = <<TEMPLATE
+{
+  "Parameters": {
+    "VpcName": {
+      "Type": "String"
+    }
+  },
+  "Resources": {
+    "MyVPC": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "10.0.0.0/16",
+        "Tags": [
+          {"Key": "Name", "Value": {"Ref": "VpcName"}}
+        ]
+      }
+    }
+  }
+}
+TEMPLATE
+}
+`, rName, description, vpcName)
+}
@@ -23,6 +23,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 			State: resourceAwsCloudFrontDistributionImport,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -543,18 +545,19 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).CloudFront()
 
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               tagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+			Tags:               tagsFromMapCloudFront(d.Get("tags_all").(map[string]interface{})),
 		},
 	}
 
@@ -567,7 +570,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).CloudFront()
 	params := &cloudfront.GetDistributionInput{
 		Id: aws.String(d.Id()),
 	}
@@ -611,7 +614,7 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 			d.Id(), d.Get("arn").(string)), err)
 	}
 
-	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapCloudFront(tagResp.Tags)); err != nil {
 		return err
 	}
 
@@ -619,7 +622,7 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).CloudFront()
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -638,7 +641,7 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).CloudFront()
 
 	// manually disable the distribution first
 	d.Set("enabled", false)
@@ -696,7 +699,7 @@ func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interfac
 // The refresh function for resourceAwsCloudFrontWebDistributionWaitUntilDeployed.
 func resourceAwsCloudFrontWebDistributionStateRefreshFunc(id string, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).cloudfrontconn
+		conn := meta.(*AWSClient).CloudFront()
 		params := &cloudfront.GetDistributionInput{
 			Id: aws.String(id),
 		}
@@ -269,7 +269,7 @@ func testAccAuxCloudFrontGetDistributionConfig(s *terraform.State, cloudFrontRes
 		return nil, fmt.Errorf("No Id is set")
 	}
 
-	cloudfrontconn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	cloudfrontconn := testAccProvider.Meta().(*AWSClient).CloudFront()
 
 	req := &cloudfront.GetDistributionInput{
 		Id: aws.String(cf.Primary.ID),
@@ -21,6 +21,8 @@ func resourceAwsCloudTrail() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -80,13 +82,14 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).CloudTrail()
 
 	input := cloudtrail.CreateTrailInput{
 		Name:         aws.String(d.Get("name").(string)),
@@ -154,7 +157,7 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).CloudTrail()
 
 	input := cloudtrail.DescribeTrailsInput{
 		TrailNameList: []*string{
@@ -217,7 +220,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		tags = tagsOut.ResourceTagList[0].TagsList
 	}
 
-	if err := d.Set("tags", tagsToMapCloudtrail(tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapCloudtrail(tags)); err != nil {
 		return err
 	}
 
@@ -231,7 +234,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).CloudTrail()
 
 	input := cloudtrail.UpdateTrailInput{
 		Name: aws.String(d.Id()),
@@ -285,7 +288,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsCloudtrail(conn, d)
 		if err != nil {
 			return err
@@ -306,7 +309,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).CloudTrail()
 
 	log.Printf("[DEBUG] Deleting CloudTrail: %q", d.Id())
 	_, err := conn.DeleteTrail(&cloudtrail.DeleteTrailInput{
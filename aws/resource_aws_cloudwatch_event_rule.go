@@ -69,7 +69,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	input, err := buildPutRuleInputStruct(d)
 	if err != nil {
@@ -107,7 +107,7 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	input := events.DescribeRuleInput{
 		Name: aws.String(d.Id()),
@@ -150,7 +150,7 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) {
 		log.Printf("[DEBUG] Enabling CloudWatch Event Rule %q", d.Id())
@@ -203,7 +203,7 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	log.Printf("[INFO] Deleting CloudWatch Event Rule: %s", d.Id())
 	_, err := conn.DeleteRule(&events.DeleteRuleInput{
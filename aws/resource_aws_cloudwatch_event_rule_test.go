@@ -101,7 +101,7 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).CloudWatchEvents()
 		params := events.DescribeRuleInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -126,7 +126,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string, rule *even
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).CloudWatchEvents()
 		params := events.DescribeRuleInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -144,7 +144,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string, rule *even
 }
 
 func testAccCheckAWSCloudWatchEventRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).CloudWatchEvents()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_rule" {
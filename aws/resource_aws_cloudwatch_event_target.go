@@ -125,7 +125,7 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	rule := d.Get("rule").(string)
 
@@ -159,7 +159,7 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	t, err := findEventTargetById(
 		d.Get("target_id").(string),
@@ -244,7 +244,7 @@ func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudW
 }
 
 func resourceAwsCloudWatchEventTargetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	input := buildPutTargetInputStruct(d)
 
@@ -258,7 +258,7 @@ func resourceAwsCloudWatchEventTargetUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudWatchEventTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).CloudWatchEvents()
 
 	input := events.RemoveTargetsInput{
 		Ids:  []*string{aws.String(d.Get("target_id").(string))},
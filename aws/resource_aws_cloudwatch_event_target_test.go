@@ -152,7 +152,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).CloudWatchEvents()
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
 			rs.Primary.Attributes["rule"], nil, conn)
 		if err != nil {
@@ -166,7 +166,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 }
 
 func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).CloudWatchEvents()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_target" {
@@ -39,7 +39,7 @@ func resourceAwsCloudWatchLogDestinationPolicy() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogDestinationPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	destination_name := d.Get("destination_name").(string)
 	access_policy := d.Get("access_policy").(string)
@@ -60,7 +60,7 @@ func resourceAwsCloudWatchLogDestinationPolicyPut(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCloudWatchLogDestinationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 	destination_name := d.Get("destination_name").(string)
 	destination, exists, err := lookupCloudWatchLogDestination(conn, destination_name, nil)
 	if err != nil {
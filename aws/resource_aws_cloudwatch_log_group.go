@@ -23,6 +23,8 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -55,13 +57,14 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	var logGroupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -98,7 +101,7 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 	log.Printf("[DEBUG] Reading CloudWatch Log Group: %q", d.Get("name").(string))
 	lg, exists, err := lookupCloudWatchLogGroup(conn, d.Id(), nil)
 	if err != nil {
@@ -126,7 +129,9 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return err
 		}
-		d.Set("tags", tags)
+		if err := setTagsAll(d, meta, tags); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -157,7 +162,7 @@ func lookupCloudWatchLogGroup(conn *cloudwatchlogs.CloudWatchLogs,
 }
 
 func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	name := d.Id()
 	log.Printf("[DEBUG] Updating CloudWatch Log Group: %q", name)
@@ -186,32 +191,9 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffCloudWatchTags(o, n)
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags from %s", name)
-			_, err := conn.UntagLogGroup(&cloudwatchlogs.UntagLogGroupInput{
-				LogGroupName: aws.String(name),
-				Tags:         remove,
-			})
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags on %s", name)
-			_, err := conn.TagLogGroup(&cloudwatchlogs.TagLogGroupInput{
-				LogGroupName: aws.String(name),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
+	if !restricted {
+		if err := updateTagsAll(d, cloudWatchLogGroupTagsAdapter{conn: conn, name: name}); err != nil {
+			return err
 		}
 	}
 
@@ -239,25 +221,8 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 	return resourceAwsCloudWatchLogGroupRead(d, meta)
 }
 
-func diffCloudWatchTags(oldTags map[string]interface{}, newTags map[string]interface{}) (map[string]*string, []*string) {
-	create := make(map[string]*string)
-	for k, v := range newTags {
-		create[k] = aws.String(v.(string))
-	}
-
-	var remove []*string
-	for t, _ := range oldTags {
-		_, ok := create[t]
-		if !ok {
-			remove = append(remove, aws.String(t))
-		}
-	}
-
-	return create, remove
-}
-
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
 	_, err := conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
@@ -272,22 +237,44 @@ func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWatchLogs) (map[string]interface{}, error) {
-	tagsOutput, err := conn.ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
-		LogGroupName: aws.String(d.Get("name").(string)),
+// cloudWatchLogGroupTagsAdapter updates the tags of a CloudWatch log group.
+type cloudWatchLogGroupTagsAdapter struct {
+	conn *cloudwatchlogs.CloudWatchLogs
+	name string
+}
+
+func (a cloudWatchLogGroupTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
+		LogGroupName: aws.String(a.name),
 	})
 	if err != nil {
-		return nil, errwrap.Wrapf("Error Getting CloudWatch Logs Tag List: {{err}}", err)
+		return nil, err
 	}
-	if tagsOutput != nil {
-		output := make(map[string]interface{}, len(tagsOutput.Tags))
 
-		for i, v := range tagsOutput.Tags {
-			output[i] = *v
-		}
+	return newKeyValueTagsGeneric(resp.Tags).IgnoreAws(), nil
+}
 
-		return output, nil
+func (a cloudWatchLogGroupTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagLogGroup(&cloudwatchlogs.TagLogGroupInput{
+		LogGroupName: aws.String(a.name),
+		Tags:         tags.GenericTags(),
+	})
+	return err
+}
+
+func (a cloudWatchLogGroupTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagLogGroup(&cloudwatchlogs.UntagLogGroupInput{
+		LogGroupName: aws.String(a.name),
+		Tags:         aws.StringSlice(keys),
+	})
+	return err
+}
+
+func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWatchLogs) (map[string]string, error) {
+	tags, err := cloudWatchLogGroupTagsAdapter{conn: conn, name: d.Get("name").(string)}.ListTags()
+	if err != nil {
+		return nil, errwrap.Wrapf("Error Getting CloudWatch Logs Tag List: {{err}}", err)
 	}
 
-	return make(map[string]interface{}), nil
+	return tags.Map(), nil
 }
@@ -40,7 +40,7 @@ func resourceAwsCloudWatchLogResourcePolicy() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogResourcePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	policyName := d.Get("policy_name").(string)
 
@@ -61,7 +61,7 @@ func resourceAwsCloudWatchLogResourcePolicyPut(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsCloudWatchLogResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 	policyName := d.Get("policy_name").(string)
 	resourcePolicy, exists, err := lookupCloudWatchLogResourcePolicy(conn, policyName, nil)
 	if err != nil {
@@ -80,7 +80,7 @@ func resourceAwsCloudWatchLogResourcePolicyRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCloudWatchLogResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 	input := cloudwatchlogs.DeleteResourcePolicyInput{
 		PolicyName: aws.String(d.Id()),
 	}
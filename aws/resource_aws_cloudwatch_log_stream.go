@@ -40,7 +40,7 @@ func resourceAwsCloudWatchLogStream() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogStreamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	log.Printf("[DEBUG] Creating CloudWatch Log Stream: %s", d.Get("name").(string))
 	_, err := conn.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
@@ -57,7 +57,7 @@ func resourceAwsCloudWatchLogStreamCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchLogStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	ls, exists, err := lookupCloudWatchLogStream(conn, d.Id(), d.Get("log_group_name").(string), nil)
 	if err != nil {
@@ -77,7 +77,7 @@ func resourceAwsCloudWatchLogStreamRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudWatchLogStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).CloudWatchLogs()
 
 	log.Printf("[INFO] Deleting CloudWatch Log Stream: %s", d.Id())
 	params := &cloudwatchlogs.DeleteLogStreamInput{
@@ -120,7 +120,7 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 }
 
 func resourceAwsCloudWatchMetricAlarmCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).CloudWatch()
 
 	_, statisticOk := d.GetOk("statistic")
 	_, extendedStatisticOk := d.GetOk("extended_statistic")
@@ -189,7 +189,7 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchMetricAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).CloudWatch()
 	params := getAwsCloudWatchPutMetricAlarmInput(d)
 
 	log.Printf("[DEBUG] Updating CloudWatch Metric Alarm: %#v", params)
@@ -214,7 +214,7 @@ func resourceAwsCloudWatchMetricAlarmDelete(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Deleting CloudWatch Metric Alarm: %s", d.Id())
 
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).CloudWatch()
 	params := cloudwatch.DeleteAlarmsInput{
 		AlarmNames: []*string{aws.String(d.Id())},
 	}
@@ -309,7 +309,7 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 }
 
 func getAwsCloudWatchMetricAlarm(d *schema.ResourceData, meta interface{}) (*cloudwatch.MetricAlarm, error) {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).CloudWatch()
 
 	params := cloudwatch.DescribeAlarmsInput{
 		AlarmNames: []*string{aws.String(d.Id())},
@@ -168,7 +168,7 @@ func testAccCheckCloudWatchMetricAlarmExists(n string, alarm *cloudwatch.MetricA
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+		conn := testAccProvider.Meta().(*AWSClient).CloudWatch()
 		params := cloudwatch.DescribeAlarmsInput{
 			AlarmNames: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -186,7 +186,7 @@ func testAccCheckCloudWatchMetricAlarmExists(n string, alarm *cloudwatch.MetricA
 }
 
 func testAccCheckAWSCloudWatchMetricAlarmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+	conn := testAccProvider.Meta().(*AWSClient).CloudWatch()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_metric_alarm" {
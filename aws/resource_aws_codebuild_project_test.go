@@ -274,7 +274,7 @@ func testAccCheckAWSCodeBuildProjectExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No CodeBuild Project ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).CodeBuild()
 
 		out, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
 			Names: []*string{
@@ -295,7 +295,7 @@ func testAccCheckAWSCodeBuildProjectExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSCodeBuildProjectDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).CodeBuild()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_project" {
@@ -71,7 +71,7 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 }
 
 func resourceAwsCodeCommitTriggerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codecommitconn
+	conn := meta.(*AWSClient).CodeCommit()
 
 	// Expand the "trigger" set to aws-sdk-go compat []*codecommit.RepositoryTrigger
 	triggers := expandAwsCodeCommitTriggers(d.Get("trigger").(*schema.Set).List())
@@ -95,7 +95,7 @@ func resourceAwsCodeCommitTriggerCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codecommitconn
+	conn := meta.(*AWSClient).CodeCommit()
 
 	input := &codecommit.GetRepositoryTriggersInput{
 		RepositoryName: aws.String(d.Id()),
@@ -113,7 +113,7 @@ func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{})
 
 func resourceAwsCodeCommitTriggerDelete(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AWSClient).codecommitconn
+	conn := meta.(*AWSClient).CodeCommit()
 
 	log.Printf("[DEBUG] Deleting Trigger: %q", d.Id())
 
@@ -37,7 +37,7 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 }
 
 func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	application := d.Get("name").(string)
 	log.Printf("[DEBUG] Creating CodeDeploy application %s", application)
@@ -61,7 +61,7 @@ func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsCodeDeployAppRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	_, application := resourceAwsCodeDeployAppParseId(d.Id())
 	log.Printf("[DEBUG] Reading CodeDeploy application %s", application)
@@ -84,7 +84,7 @@ func resourceAwsCodeDeployAppRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsCodeDeployUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	o, n := d.GetChange("name")
 
@@ -103,7 +103,7 @@ func resourceAwsCodeDeployUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCodeDeployAppDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	_, err := conn.DeleteApplication(&codedeploy.DeleteApplicationInput{
 		ApplicationName: aws.String(d.Get("name").(string)),
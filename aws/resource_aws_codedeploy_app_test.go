@@ -34,7 +34,7 @@ func TestAccAWSCodeDeployApp_basic(t *testing.T) {
 }
 
 func testAccCheckAWSCodeDeployAppDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+	conn := testAccProvider.Meta().(*AWSClient).CodeDeploy()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codedeploy_app" {
@@ -104,7 +104,7 @@ func TestValidateAWSCodeDeployMinimumHealthyHostsType(t *testing.T) {
 }
 
 func testAccCheckAWSCodeDeployDeploymentConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+	conn := testAccProvider.Meta().(*AWSClient).CodeDeploy()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codedeploy_deployment_config" {
@@ -138,7 +138,7 @@ func testAccCheckAWSCodeDeployDeploymentConfigExists(name string, config *codede
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+		conn := testAccProvider.Meta().(*AWSClient).CodeDeploy()
 
 		resp, err := conn.GetDeploymentConfig(&codedeploy.GetDeploymentConfigInput{
 			DeploymentConfigName: aws.String(rs.Primary.ID),
@@ -334,7 +334,7 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 }
 
 func resourceAwsCodeDeployDeploymentGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	application := d.Get("app_name").(string)
 	deploymentGroup := d.Get("deployment_group_name").(string)
@@ -428,7 +428,7 @@ func resourceAwsCodeDeployDeploymentGroupCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCodeDeployDeploymentGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	log.Printf("[DEBUG] Reading CodeDeploy DeploymentGroup %s", d.Id())
 	resp, err := conn.GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
@@ -487,7 +487,7 @@ func resourceAwsCodeDeployDeploymentGroupRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCodeDeployDeploymentGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	input := codedeploy.UpdateDeploymentGroupInput{
 		ApplicationName:            aws.String(d.Get("app_name").(string)),
@@ -592,7 +592,7 @@ func resourceAwsCodeDeployDeploymentGroupUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCodeDeployDeploymentGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).CodeDeploy()
 
 	log.Printf("[DEBUG] Deleting CodeDeploy DeploymentGroup %s", d.Id())
 	_, err := conn.DeleteDeploymentGroup(&codedeploy.DeleteDeploymentGroupInput{
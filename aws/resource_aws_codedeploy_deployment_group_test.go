@@ -1934,7 +1934,7 @@ func testAccCheckTriggerTargetArn(group *codedeploy.DeploymentGroupInfo, trigger
 }
 
 func testAccCheckAWSCodeDeployDeploymentGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+	conn := testAccProvider.Meta().(*AWSClient).CodeDeploy()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codedeploy_deployment_group" {
@@ -1964,7 +1964,7 @@ func testAccCheckAWSCodeDeployDeploymentGroupDestroy(s *terraform.State) error {
 
 func testAccAWSCodeDeployDeploymentGroupDisappears(group *codedeploy.DeploymentGroupInfo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+		conn := testAccProvider.Meta().(*AWSClient).CodeDeploy()
 		opts := &codedeploy.DeleteDeploymentGroupInput{
 			ApplicationName:     group.ApplicationName,
 			DeploymentGroupName: group.DeploymentGroupName,
@@ -1999,7 +1999,7 @@ func testAccCheckAWSCodeDeployDeploymentGroupExists(name string, group *codedepl
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+		conn := testAccProvider.Meta().(*AWSClient).CodeDeploy()
 
 		resp, err := conn.GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
 			ApplicationName:     aws.String(rs.Primary.Attributes["app_name"]),
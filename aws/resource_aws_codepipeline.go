@@ -203,7 +203,7 @@ func validateAwsCodePipelineStageActionConfiguration(v interface{}, k string) (w
 }
 
 func resourceAwsCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).CodePipeline()
 	params := &codepipeline.CreatePipelineInput{
 		Pipeline: expandAwsCodePipeline(d),
 	}
@@ -450,7 +450,7 @@ func flattenAwsCodePipelineActionsInputArtifacts(artifacts []*codepipeline.Input
 }
 
 func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).CodePipeline()
 	resp, err := conn.GetPipeline(&codepipeline.GetPipelineInput{
 		Name: aws.String(d.Id()),
 	})
@@ -482,7 +482,7 @@ func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).CodePipeline()
 
 	pipeline := expandAwsCodePipeline(d)
 	params := &codepipeline.UpdatePipelineInput{
@@ -500,7 +500,7 @@ func resourceAwsCodePipelineUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsCodePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).CodePipeline()
 
 	_, err := conn.DeletePipeline(&codepipeline.DeletePipelineInput{
 		Name: aws.String(d.Id()),
@@ -87,7 +87,7 @@ func testAccCheckAWSCodePipelineExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No CodePipeline ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+		conn := testAccProvider.Meta().(*AWSClient).CodePipeline()
 
 		_, err := conn.GetPipeline(&codepipeline.GetPipelineInput{
 			Name: aws.String(rs.Primary.ID),
@@ -101,7 +101,7 @@ func testAccCheckAWSCodePipelineExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSCodePipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+	conn := testAccProvider.Meta().(*AWSClient).CodePipeline()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codepipeline" {
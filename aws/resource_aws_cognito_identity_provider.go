@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityProviderCreate,
+		Read:   resourceAwsCognitoIdentityProviderRead,
+		Update: resourceAwsCognitoIdentityProviderUpdate,
+		Delete: resourceAwsCognitoIdentityProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attribute_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"idp_identifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 40),
+				},
+			},
+
+			"provider_details": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+
+			"provider_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					cognitoidentityprovider.IdentityProviderTypeTypeSaml,
+					cognitoidentityprovider.IdentityProviderTypeTypeFacebook,
+					cognitoidentityprovider.IdentityProviderTypeTypeGoogle,
+					cognitoidentityprovider.IdentityProviderTypeTypeLoginWithAmazon,
+					// Not yet an enum value in the vendored SDK
+					"OIDC",
+				}, false),
+			},
+
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	userPoolID := d.Get("user_pool_id").(string)
+	providerName := d.Get("provider_name").(string)
+
+	params := &cognitoidentityprovider.CreateIdentityProviderInput{
+		ProviderDetails: stringMapToPointers(d.Get("provider_details").(map[string]interface{})),
+		ProviderName:    aws.String(providerName),
+		ProviderType:    aws.String(d.Get("provider_type").(string)),
+		UserPoolId:      aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("attribute_mapping"); ok {
+		params.AttributeMapping = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("idp_identifiers"); ok {
+		params.IdpIdentifiers = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Identity Provider: %s", params)
+
+	_, err := conn.CreateIdentityProvider(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Identity Provider: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", userPoolID, providerName))
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	userPoolID, providerName, err := decodeCognitoIdentityProviderID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := &cognitoidentityprovider.DescribeIdentityProviderInput{
+		ProviderName: aws.String(providerName),
+		UserPoolId:   aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Reading Cognito Identity Provider: %s", params)
+
+	resp, err := conn.DescribeIdentityProvider(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Identity Provider (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	ip := resp.IdentityProvider
+
+	// The active encryption certificate of a SAML provider is generated by
+	// Cognito and cannot be configured.
+	delete(ip.ProviderDetails, "ActiveEncryptionCertificate")
+
+	d.Set("attribute_mapping", aws.StringValueMap(ip.AttributeMapping))
+	d.Set("idp_identifiers", flattenStringList(ip.IdpIdentifiers))
+	d.Set("provider_details", aws.StringValueMap(ip.ProviderDetails))
+	d.Set("provider_name", ip.ProviderName)
+	d.Set("provider_type", ip.ProviderType)
+	d.Set("user_pool_id", ip.UserPoolId)
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	params := &cognitoidentityprovider.UpdateIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if d.HasChange("attribute_mapping") {
+		params.AttributeMapping = stringMapToPointers(d.Get("attribute_mapping").(map[string]interface{}))
+	}
+
+	if d.HasChange("idp_identifiers") {
+		params.IdpIdentifiers = expandStringList(d.Get("idp_identifiers").([]interface{}))
+	}
+
+	if d.HasChange("provider_details") {
+		params.ProviderDetails = stringMapToPointers(d.Get("provider_details").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Identity Provider: %s", params)
+
+	_, err := conn.UpdateIdentityProvider(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Identity Provider: %s", err)
+	}
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	params := &cognitoidentityprovider.DeleteIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Identity Provider: %s", params)
+
+	_, err := conn.DeleteIdentityProvider(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Identity Provider: %s", err)
+	}
+
+	return nil
+}
+
+func decodeCognitoIdentityProviderID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected USER_POOL_ID:PROVIDER_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
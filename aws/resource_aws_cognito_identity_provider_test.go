@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoIdentityProvider_basic(t *testing.T) {
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	resourceName := "aws_cognito_identity_provider.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(poolName, "test-client-id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "Google"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "Google"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.client_id", "test-client-id"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.email", "email"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(poolName, "updated-client-id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.client_id", "updated-client-id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoIdentityProviderExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Identity Provider ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).CognitoIdentityProvider()
+
+		_, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(rs.Primary.Attributes["provider_name"]),
+			UserPoolId:   aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoIdentityProviderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).CognitoIdentityProvider()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_identity_provider" {
+			continue
+		}
+
+		_, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(rs.Primary.Attributes["provider_name"]),
+			UserPoolId:   aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoIdentityProviderConfig_basic(poolName, clientID string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name                     = "%s"
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "main" {
+  user_pool_id  = "${aws_cognito_user_pool.main.id}"
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details {
+    authorize_scopes = "email"
+    client_id        = "%s"
+    client_secret    = "test-client-secret"
+  }
+
+  attribute_mapping {
+    email    = "email"
+    username = "sub"
+  }
+}
+`, poolName, clientID)
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoResourceServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoResourceServerCreate,
+		Read:   resourceAwsCognitoResourceServerRead,
+		Update: resourceAwsCognitoResourceServerUpdate,
+		Delete: resourceAwsCognitoResourceServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"scope_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"scope_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	identifier := d.Get("identifier").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	params := &cognitoidentityprovider.CreateResourceServerInput{
+		Identifier: aws.String(identifier),
+		Name:       aws.String(d.Get("name").(string)),
+		Scopes:     expandCognitoResourceServerScope(d.Get("scope").(*schema.Set).List()),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Resource Server: %s", params)
+
+	_, err := conn.CreateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Resource Server: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", userPoolID, identifier))
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	userPoolID, identifier, err := decodeCognitoResourceServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := &cognitoidentityprovider.DescribeResourceServerInput{
+		Identifier: aws.String(identifier),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Reading Cognito Resource Server: %s", params)
+
+	resp, err := conn.DescribeResourceServer(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Resource Server (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	rs := resp.ResourceServer
+
+	d.Set("identifier", rs.Identifier)
+	d.Set("name", rs.Name)
+	d.Set("user_pool_id", rs.UserPoolId)
+
+	if err := d.Set("scope", flattenCognitoResourceServerScope(rs.Scopes)); err != nil {
+		return fmt.Errorf("Error setting scope: %s", err)
+	}
+
+	scopeIdentifiers := make([]string, 0, len(rs.Scopes))
+	for _, s := range rs.Scopes {
+		scopeIdentifiers = append(scopeIdentifiers, fmt.Sprintf("%s/%s", *rs.Identifier, *s.ScopeName))
+	}
+	d.Set("scope_identifiers", scopeIdentifiers)
+
+	return nil
+}
+
+func resourceAwsCognitoResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	// UpdateResourceServer replaces the whole resource server, so the
+	// name and scopes are always sent.
+	params := &cognitoidentityprovider.UpdateResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		Name:       aws.String(d.Get("name").(string)),
+		Scopes:     expandCognitoResourceServerScope(d.Get("scope").(*schema.Set).List()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Resource Server: %s", params)
+
+	_, err := conn.UpdateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Resource Server: %s", err)
+	}
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	params := &cognitoidentityprovider.DeleteResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Resource Server: %s", params)
+
+	_, err := conn.DeleteResourceServer(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Resource Server: %s", err)
+	}
+
+	return nil
+}
+
+func decodeCognitoResourceServerID(id string) (string, string, error) {
+	// The identifier is usually a URL, so only the first separator counts.
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected USER_POOL_ID:IDENTIFIER", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandCognitoResourceServerScope(inputs []interface{}) []*cognitoidentityprovider.ResourceServerScopeType {
+	configs := make([]*cognitoidentityprovider.ResourceServerScopeType, 0, len(inputs))
+	for _, input := range inputs {
+		data := input.(map[string]interface{})
+		configs = append(configs, &cognitoidentityprovider.ResourceServerScopeType{
+			ScopeDescription: aws.String(data["scope_description"].(string)),
+			ScopeName:        aws.String(data["scope_name"].(string)),
+		})
+	}
+	return configs
+}
+
+func flattenCognitoResourceServerScope(inputs []*cognitoidentityprovider.ResourceServerScopeType) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(inputs))
+	for _, input := range inputs {
+		values = append(values, map[string]interface{}{
+			"scope_description": aws.StringValue(input.ScopeDescription),
+			"scope_name":        aws.StringValue(input.ScopeName),
+		})
+	}
+	return values
+}
@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoResourceServer_basic(t *testing.T) {
+	identifier := fmt.Sprintf("https://%s.example.com", acctest.RandString(10))
+	name := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	resourceName := "aws_cognito_resource_server.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoResourceServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoResourceServerConfig_basic(identifier, name, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identifier", identifier),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scope_identifiers.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoResourceServerConfig_scope(identifier, name, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scope_identifiers.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDecodeCognitoResourceServerID(t *testing.T) {
+	userPoolID, identifier, err := decodeCognitoResourceServerID("us-west-2_aBcDeFgHi:https://api.example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if userPoolID != "us-west-2_aBcDeFgHi" {
+		t.Fatalf("expected user pool ID %q, got %q", "us-west-2_aBcDeFgHi", userPoolID)
+	}
+	if identifier != "https://api.example.com" {
+		t.Fatalf("expected identifier %q, got %q", "https://api.example.com", identifier)
+	}
+
+	if _, _, err := decodeCognitoResourceServerID("us-west-2_aBcDeFgHi"); err == nil {
+		t.Fatal("expected an error for an ID without identifier")
+	}
+}
+
+func testAccCheckAWSCognitoResourceServerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Resource Server ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).CognitoIdentityProvider()
+
+		_, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+			Identifier: aws.String(rs.Primary.Attributes["identifier"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoResourceServerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).CognitoIdentityProvider()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_resource_server" {
+			continue
+		}
+
+		_, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+			Identifier: aws.String(rs.Primary.Attributes["identifier"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoResourceServerConfig_basic(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier   = "%s"
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+`, identifier, name, poolName)
+}
+
+func testAccAWSCognitoResourceServerConfig_scope(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier   = "%s"
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+
+  scope {
+    scope_name        = "scope_1_name"
+    scope_description = "scope_1_description"
+  }
+
+  scope {
+    scope_name        = "scope_2_name"
+    scope_description = "scope_2_description"
+  }
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+`, identifier, name, poolName)
+}
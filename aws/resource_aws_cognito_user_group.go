@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoUserGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserGroupCreate,
+		Read:   resourceAwsCognitoUserGroupRead,
+		Update: resourceAwsCognitoUserGroupUpdate,
+		Delete: resourceAwsCognitoUserGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"precedence": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	userPoolID := d.Get("user_pool_id").(string)
+	name := d.Get("name").(string)
+
+	params := &cognitoidentityprovider.CreateGroupInput{
+		GroupName:  aws.String(name),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("precedence"); ok {
+		params.Precedence = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		params.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito User Group: %s", params)
+
+	_, err := conn.CreateGroup(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito User Group: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", userPoolID, name))
+
+	return resourceAwsCognitoUserGroupRead(d, meta)
+}
+
+func resourceAwsCognitoUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	userPoolID, name, err := decodeCognitoUserGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := &cognitoidentityprovider.GetGroupInput{
+		GroupName:  aws.String(name),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Reading Cognito User Group: %s", params)
+
+	resp, err := conn.GetGroup(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito User Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("description", resp.Group.Description)
+	d.Set("name", resp.Group.GroupName)
+	d.Set("precedence", resp.Group.Precedence)
+	d.Set("role_arn", resp.Group.RoleArn)
+	d.Set("user_pool_id", resp.Group.UserPoolId)
+
+	return nil
+}
+
+func resourceAwsCognitoUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	params := &cognitoidentityprovider.UpdateGroupInput{
+		Description: aws.String(d.Get("description").(string)),
+		GroupName:   aws.String(d.Get("name").(string)),
+		Precedence:  aws.Int64(int64(d.Get("precedence").(int))),
+		UserPoolId:  aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		params.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito User Group: %s", params)
+
+	_, err := conn.UpdateGroup(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito User Group: %s", err)
+	}
+
+	return resourceAwsCognitoUserGroupRead(d, meta)
+}
+
+func resourceAwsCognitoUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
+
+	params := &cognitoidentityprovider.DeleteGroupInput{
+		GroupName:  aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito User Group: %s", params)
+
+	_, err := conn.DeleteGroup(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito User Group: %s", err)
+	}
+
+	return nil
+}
+
+func decodeCognitoUserGroupID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected USER_POOL_ID:NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
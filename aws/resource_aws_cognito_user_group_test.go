@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserGroup_basic(t *testing.T) {
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	resourceName := "aws_cognito_user_group.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserGroupConfig_basic(poolName, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", groupName),
+					resource.TestCheckResourceAttr(resourceName, "precedence", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserGroup_complex(t *testing.T) {
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	resourceName := "aws_cognito_user_group.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserGroupConfig_complex(poolName, groupName, "This is the user group description", 42),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is the user group description"),
+					resource.TestCheckResourceAttr(resourceName, "precedence", "42"),
+					resource.TestCheckResourceAttrSet(resourceName, "role_arn"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserGroupConfig_complex(poolName, groupName, "This is the updated user group description", 43),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is the updated user group description"),
+					resource.TestCheckResourceAttr(resourceName, "precedence", "43"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeCognitoUserGroupID(t *testing.T) {
+	userPoolID, name, err := decodeCognitoUserGroupID("us-west-2_aBcDeFgHi:admins")
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if userPoolID != "us-west-2_aBcDeFgHi" || name != "admins" {
+		t.Fatalf("unexpected result: %q, %q", userPoolID, name)
+	}
+
+	for _, id := range []string{"us-west-2_aBcDeFgHi", ":admins", "us-west-2_aBcDeFgHi:"} {
+		if _, _, err := decodeCognitoUserGroupID(id); err == nil {
+			t.Fatalf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func testAccCheckAWSCognitoUserGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito User Group ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).CognitoIdentityProvider()
+
+		_, err := conn.GetGroup(&cognitoidentityprovider.GetGroupInput{
+			GroupName:  aws.String(rs.Primary.Attributes["name"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).CognitoIdentityProvider()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_group" {
+			continue
+		}
+
+		_, err := conn.GetGroup(&cognitoidentityprovider.GetGroupInput{
+			GroupName:  aws.String(rs.Primary.Attributes["name"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserGroupConfig_basic(poolName, groupName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+
+resource "aws_cognito_user_group" "main" {
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+`, poolName, groupName)
+}
+
+func testAccAWSCognitoUserGroupConfig_complex(poolName, groupName, groupDescription string, precedence int) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_iam_role" "group_role" {
+  name = "%[2]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Federated": "cognito-identity.amazonaws.com"
+      },
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringEquals": {
+          "cognito-identity.amazonaws.com:aud": "us-east-1:12345678-dead-beef-cafe-123456790ab"
+        },
+        "ForAnyValue:StringLike": {
+          "cognito-identity.amazonaws.com:amr": "authenticated"
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cognito_user_group" "main" {
+  name         = "%[2]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+  description  = "%[3]s"
+  precedence   = %[4]d
+  role_arn     = "${aws_iam_role.group_role.arn}"
+}
+`, poolName, groupName, groupDescription, precedence)
+}
@@ -28,6 +28,9 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 		},
 
 		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateUserPool.html
+
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"admin_create_user_config": {
 				Type:     schema.TypeList,
@@ -361,7 +364,8 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ValidateFunc: validateCognitoUserPoolSmsVerificationMessage,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"username_attributes": {
 				Type:     schema.TypeList,
@@ -428,7 +432,7 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 }
 
 func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
 
 	params := &cognitoidentityprovider.CreateUserPoolInput{
 		PoolName: aws.String(d.Get("name").(string)),
@@ -555,7 +559,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
@@ -587,7 +591,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
 
 	params := &cognitoidentityprovider.DescribeUserPoolInput{
 		UserPoolId: aws.String(d.Id()),
@@ -613,10 +617,10 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("alias_attributes", flattenStringList(resp.UserPool.AliasAttributes))
 	}
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
+		Partition: meta.(*AWSClient).Partition(),
 		Region:    meta.(*AWSClient).region,
 		Service:   "cognito-idp",
-		AccountID: meta.(*AWSClient).accountid,
+		AccountID: meta.(*AWSClient).AccountID(),
 		Resource:  fmt.Sprintf("userpool/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
@@ -671,13 +675,13 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("creation_date", resp.UserPool.CreationDate.Format(time.RFC3339))
 	d.Set("last_modified_date", resp.UserPool.LastModifiedDate.Format(time.RFC3339))
 	d.Set("name", resp.UserPool.Name)
-	d.Set("tags", tagsToMapGeneric(resp.UserPool.UserPoolTags))
+	setTagsAll(d, meta, tagsToMapGeneric(resp.UserPool.UserPoolTags))
 
 	return nil
 }
 
 func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
 
 	params := &cognitoidentityprovider.UpdateUserPoolInput{
 		UserPoolId: aws.String(d.Id()),
@@ -800,7 +804,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -830,7 +834,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).CognitoIdentityProvider()
 
 	params := &cognitoidentityprovider.DeleteUserPoolInput{
 		UserPoolId: aws.String(d.Id()),
@@ -65,7 +65,7 @@ func resourceAwsConfigConfigurationRecorder() *schema.Resource {
 }
 
 func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 
 	name := d.Get("name").(string)
 	recorder := configservice.ConfigurationRecorder{
@@ -91,7 +91,7 @@ func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 
 	input := configservice.DescribeConfigurationRecordersInput{
 		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
@@ -135,7 +135,7 @@ func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsConfigConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 	input := configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Id()),
 	}
@@ -39,7 +39,7 @@ func resourceAwsConfigConfigurationRecorderStatus() *schema.Resource {
 }
 
 func resourceAwsConfigConfigurationRecorderStatusPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 
 	name := d.Get("name").(string)
 	d.SetId(name)
@@ -71,7 +71,7 @@ func resourceAwsConfigConfigurationRecorderStatusPut(d *schema.ResourceData, met
 }
 
 func resourceAwsConfigConfigurationRecorderStatusRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 
 	name := d.Id()
 	statusInput := configservice.DescribeConfigurationRecorderStatusInput{
@@ -108,7 +108,7 @@ func resourceAwsConfigConfigurationRecorderStatusRead(d *schema.ResourceData, me
 }
 
 func resourceAwsConfigConfigurationRecorderStatusDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 	input := configservice.StopConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Get("name").(string)),
 	}
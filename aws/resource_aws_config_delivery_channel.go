@@ -64,7 +64,7 @@ func resourceAwsConfigDeliveryChannel() *schema.Resource {
 }
 
 func resourceAwsConfigDeliveryChannelPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 
 	name := d.Get("name").(string)
 	channel := configservice.DeliveryChannel{
@@ -115,7 +115,7 @@ func resourceAwsConfigDeliveryChannelPut(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsConfigDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 
 	input := configservice.DescribeDeliveryChannelsInput{
 		DeliveryChannelNames: []*string{aws.String(d.Id())},
@@ -158,7 +158,7 @@ func resourceAwsConfigDeliveryChannelRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsConfigDeliveryChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).ConfigService()
 	input := configservice.DeleteDeliveryChannelInput{
 		DeliveryChannelName: aws.String(d.Id()),
 	}
@@ -94,7 +94,7 @@ func TestAccAWSCustomerGateway_disappears(t *testing.T) {
 
 func testAccAWSCustomerGatewayDisappears(gateway *ec2.CustomerGateway) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		opts := &ec2.DeleteCustomerGatewayInput{
 			CustomerGatewayId: gateway.CustomerGatewayId,
 		}
@@ -124,7 +124,7 @@ func testAccAWSCustomerGatewayDisappears(gateway *ec2.CustomerGateway) resource.
 }
 
 func testAccCheckCustomerGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_customer_gatewah" {
@@ -176,7 +176,7 @@ func testAccCheckCustomerGateway(gatewayResource string, cgw *ec2.CustomerGatewa
 			return fmt.Errorf("Not found: %s", gatewayResource)
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).EC2()
 		gatewayFilter := &ec2.Filter{
 			Name:   aws.String("customer-gateway-id"),
 			Values: []*string{aws.String(gateway.Primary.ID)},
@@ -0,0 +1,543 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDaxCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDaxClusterCreate,
+		Read:   resourceAwsDaxClusterRead,
+		Update: resourceAwsDaxClusterUpdate,
+		Delete: resourceAwsDaxClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DAX normalizes cluster names to lowercase,
+					// so we have to do this too or else we can end up
+					// with non-converging diffs.
+					return strings.ToLower(val.(string))
+				},
+				// DAX cluster names follow the same rules as ElastiCache
+				// cluster ids.
+				ValidateFunc: validateElastiCacheClusterId,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replication_factor": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notification_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					// DAX always changes the maintenance
+					// to lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"configuration_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+	}
+}
+
+func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &dax.CreateClusterInput{
+		ClusterName:       aws.String(clusterName),
+		IamRoleArn:        aws.String(d.Get("iam_role_arn").(string)),
+		NodeType:          aws.String(d.Get("node_type").(string)),
+		ReplicationFactor: aws.Int64(int64(d.Get("replication_factor").(int))),
+		Tags:              tagsFromMapDax(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("availability_zones"); ok {
+		input.AvailabilityZones = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("notification_topic_arn"); ok {
+		input.NotificationTopicArn = aws.String(v.(string))
+	}
+	// parameter groups are optional and can be defaulted by AWS
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		input.ParameterGroupName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		input.SubnetGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DAX Cluster: %s", input)
+	// IAM roles take some time to propagate
+	var resp *dax.CreateClusterOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateCluster(input)
+		if err != nil {
+			if isAWSErr(err, dax.ErrCodeInvalidParameterValueException, "No permission to assume role") {
+				log.Printf("[DEBUG] Retrying create of DAX cluster %s: %s", clusterName, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DAX cluster %s: %s", clusterName, err)
+	}
+
+	// Assign the cluster name as the resource ID
+	// DAX always retains the name in lower case, so we have to
+	// mimic that or else we won't be able to refresh a resource whose
+	// name contained uppercase characters.
+	d.SetId(strings.ToLower(aws.StringValue(resp.Cluster.ClusterName)))
+
+	pending := []string{"creating", "modifying"}
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{"available"},
+		Refresh:    daxClusterStateRefreshFunc(conn, d.Id(), "available", pending),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for DAX cluster (%s) to become available", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DAX cluster (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsDaxClusterRead(d, meta)
+}
+
+func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+		ClusterNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
+			log.Printf("[WARN] DAX Cluster (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DAX cluster %s: %s", d.Id(), err)
+	}
+
+	if len(resp.Clusters) == 0 {
+		log.Printf("[WARN] DAX Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	c := resp.Clusters[0]
+	d.Set("arn", c.ClusterArn)
+	d.Set("cluster_name", c.ClusterName)
+	d.Set("description", c.Description)
+	d.Set("iam_role_arn", c.IamRoleArn)
+	d.Set("node_type", c.NodeType)
+	d.Set("replication_factor", c.TotalNodes)
+
+	if c.ClusterDiscoveryEndpoint != nil {
+		d.Set("port", c.ClusterDiscoveryEndpoint.Port)
+		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.StringValue(c.ClusterDiscoveryEndpoint.Address), aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))
+		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+	}
+
+	d.Set("subnet_group_name", c.SubnetGroup)
+	if err := d.Set("security_group_ids", flattenDaxSecurityGroupIds(c.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	if c.ParameterGroup != nil {
+		d.Set("parameter_group_name", c.ParameterGroup.ParameterGroupName)
+	}
+
+	d.Set("maintenance_window", c.PreferredMaintenanceWindow)
+
+	if c.NotificationConfiguration != nil {
+		if aws.StringValue(c.NotificationConfiguration.TopicStatus) == "active" {
+			d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
+		}
+	}
+
+	if err := setDaxClusterNodeData(d, c); err != nil {
+		return err
+	}
+
+	tags, err := daxTagsAdapter{conn: conn, arn: aws.StringValue(c.ClusterArn)}.ListTags()
+	if err != nil {
+		return fmt.Errorf("Error listing tags for DAX cluster %s: %s", d.Id(), err)
+	}
+	setTagsAll(d, meta, tags.Map())
+
+	return nil
+}
+
+func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	if err := setTagsDax(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+
+	req := &dax.UpdateClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+
+	requestUpdate := false
+	awaitUpdate := false
+	if d.HasChange("description") {
+		req.Description = aws.String(d.Get("description").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("security_group_ids") {
+		if attr := d.Get("security_group_ids").(*schema.Set); attr.Len() > 0 {
+			req.SecurityGroupIds = expandStringSet(attr)
+			requestUpdate = true
+		}
+	}
+
+	if d.HasChange("parameter_group_name") {
+		req.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("maintenance_window") {
+		req.PreferredMaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("notification_topic_arn") {
+		v := d.Get("notification_topic_arn").(string)
+		req.NotificationTopicArn = aws.String(v)
+		if v == "" {
+			inactive := "inactive"
+			req.NotificationTopicStatus = &inactive
+		}
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Modifying DAX Cluster (%s), opts:\n%s", d.Id(), req)
+		if _, err := conn.UpdateCluster(req); err != nil {
+			return fmt.Errorf("Error updating DAX cluster (%s): %s", d.Id(), err)
+		}
+		awaitUpdate = true
+	}
+
+	if d.HasChange("replication_factor") {
+		oraw, nraw := d.GetChange("replication_factor")
+		o := oraw.(int)
+		n := nraw.(int)
+		if n < o {
+			log.Printf("[INFO] Decreasing nodes in DAX cluster %s from %d to %d", d.Id(), o, n)
+			_, err := conn.DecreaseReplicationFactor(&dax.DecreaseReplicationFactorInput{
+				ClusterName:          aws.String(d.Id()),
+				NewReplicationFactor: aws.Int64(int64(n)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error decreasing nodes in DAX cluster %s: %s", d.Id(), err)
+			}
+		}
+		if n > o {
+			log.Printf("[INFO] Increasing nodes in DAX cluster %s from %d to %d", d.Id(), o, n)
+			_, err := conn.IncreaseReplicationFactor(&dax.IncreaseReplicationFactorInput{
+				ClusterName:          aws.String(d.Id()),
+				NewReplicationFactor: aws.Int64(int64(n)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error increasing nodes in DAX cluster %s: %s", d.Id(), err)
+			}
+		}
+		awaitUpdate = true
+	}
+
+	if awaitUpdate {
+		log.Printf("[DEBUG] Waiting for update: %s", d.Id())
+		pending := []string{"modifying"}
+		stateConf := &resource.StateChangeConf{
+			Pending:    pending,
+			Target:     []string{"available"},
+			Refresh:    daxClusterStateRefreshFunc(conn, d.Id(), "available", pending),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 10 * time.Second,
+			Delay:      30 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for DAX cluster (%s) to update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDaxClusterRead(d, meta)
+}
+
+func setDaxClusterNodeData(d *schema.ResourceData, c *dax.Cluster) error {
+	sortedNodes := make([]*dax.Node, len(c.Nodes))
+	copy(sortedNodes, c.Nodes)
+	sort.Sort(byNodeId(sortedNodes))
+
+	nodeData := make([]map[string]interface{}, 0, len(sortedNodes))
+
+	for _, node := range sortedNodes {
+		if node.NodeId == nil || node.Endpoint == nil || node.Endpoint.Address == nil || node.Endpoint.Port == nil || node.AvailabilityZone == nil {
+			return fmt.Errorf("Unexpected nil pointer in: %s", node)
+		}
+		nodeData = append(nodeData, map[string]interface{}{
+			"id":                aws.StringValue(node.NodeId),
+			"address":           aws.StringValue(node.Endpoint.Address),
+			"port":              int(aws.Int64Value(node.Endpoint.Port)),
+			"availability_zone": aws.StringValue(node.AvailabilityZone),
+		})
+	}
+
+	return d.Set("nodes", nodeData)
+}
+
+type byNodeId []*dax.Node
+
+func (b byNodeId) Len() int      { return len(b) }
+func (b byNodeId) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byNodeId) Less(i, j int) bool {
+	return b[i].NodeId != nil && b[j].NodeId != nil &&
+		*b[i].NodeId < *b[j].NodeId
+}
+
+func flattenDaxSecurityGroupIds(securityGroups []*dax.SecurityGroupMembership) []string {
+	result := make([]string, 0, len(securityGroups))
+	for _, sg := range securityGroups {
+		if sg.SecurityGroupIdentifier != nil {
+			result = append(result, *sg.SecurityGroupIdentifier)
+		}
+	}
+	return result
+}
+
+func resourceAwsDaxClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	req := &dax.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteCluster(req)
+		if err != nil {
+			// The cluster may still be modifying, so we retry until it's ready for deletion
+			if isAWSErr(err, dax.ErrCodeInvalidClusterStateFault, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DAX cluster %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for deletion: %v", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network"},
+		Target:     []string{},
+		Refresh:    daxClusterStateRefreshFunc(conn, d.Id(), "", []string{}),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DAX cluster (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func daxClusterStateRefreshFunc(conn *dax.DAX, clusterName, givenState string, pending []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+			ClusterNames: []*string{aws.String(clusterName)},
+		})
+		if err != nil {
+			if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
+				log.Printf("[DEBUG] Detect deletion")
+				return nil, "", nil
+			}
+
+			log.Printf("[ERROR] daxClusterStateRefreshFunc: %s", err)
+			return nil, "", err
+		}
+
+		if len(resp.Clusters) == 0 {
+			return nil, "", fmt.Errorf("[WARN] Error: no DAX clusters found for name (%s)", clusterName)
+		}
+
+		var c *dax.Cluster
+		for _, cluster := range resp.Clusters {
+			if aws.StringValue(cluster.ClusterName) == clusterName {
+				log.Printf("[DEBUG] Found matching DAX cluster: %s", *cluster.ClusterName)
+				c = cluster
+			}
+		}
+
+		if c == nil {
+			return nil, "", fmt.Errorf("[WARN] Error: no matching DAX cluster for name (%s)", clusterName)
+		}
+
+		status := aws.StringValue(c.Status)
+		log.Printf("[DEBUG] DAX Cluster (%s) status: %v", clusterName, status)
+
+		// return the current state if it's in the pending array
+		for _, p := range pending {
+			if p == status {
+				log.Printf("[DEBUG] Return with status: %v", status)
+				return c, p, nil
+			}
+		}
+
+		// return given state if it's not in pending
+		if givenState != "" {
+			// check to make sure we have the node count we're expecting
+			if int64(len(c.Nodes)) != aws.Int64Value(c.TotalNodes) {
+				log.Printf("[DEBUG] Node count is not what is expected: %d found, %d expected", len(c.Nodes), aws.Int64Value(c.TotalNodes))
+				return nil, "creating", nil
+			}
+
+			log.Printf("[DEBUG] Node count matched (%d)", len(c.Nodes))
+			// loop the nodes and check their status as well
+			for _, n := range c.Nodes {
+				if n.NodeStatus != nil && aws.StringValue(n.NodeStatus) != "available" {
+					log.Printf("[DEBUG] Node (%s) is not yet available, status: %s", aws.StringValue(n.NodeId), aws.StringValue(n.NodeStatus))
+					return nil, "creating", nil
+				}
+			}
+			log.Printf("[DEBUG] DAX returning given state (%s), cluster: %s", givenState, c)
+			return c, givenState, nil
+		}
+		log.Printf("[DEBUG] current status: %v", status)
+		return c, status, nil
+	}
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDAXCluster_basic(t *testing.T) {
+	var dc dax.Cluster
+	rString := acctest.RandString(10)
+	iamRoleResourceName := "aws_iam_role.test"
+	resourceName := "aws_dax_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfig(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile("^arn:aws:dax:[\\w-]+:\\d+:cache/")),
+					resource.TestMatchResourceAttr(resourceName, "cluster_name", regexp.MustCompile("^tf-\\w+$")),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "node_type", "dax.r3.large"),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test cluster"),
+					resource.TestMatchResourceAttr(resourceName, "parameter_group_name", regexp.MustCompile("^default.dax")),
+					resource.TestMatchResourceAttr(resourceName, "maintenance_window", regexp.MustCompile("^\\w{3}:\\d{2}:\\d{2}-\\w{3}:\\d{2}:\\d{2}$")),
+					resource.TestCheckResourceAttr(resourceName, "subnet_group_name", "default"),
+					resource.TestMatchResourceAttr(resourceName, "nodes.0.id", regexp.MustCompile("^tf-[\\w-]+$")),
+					resource.TestMatchResourceAttr(resourceName, "configuration_endpoint", regexp.MustCompile(":\\d+$")),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_address"),
+					resource.TestMatchResourceAttr(resourceName, "port", regexp.MustCompile("^\\d+$")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDAXCluster_resize(t *testing.T) {
+	var dc dax.Cluster
+	rString := acctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfigResize_singleNode(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "1"),
+				),
+			},
+			{
+				Config: testAccAWSDAXClusterConfigResize_multiNode(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "2"),
+				),
+			},
+			{
+				Config: testAccAWSDAXClusterConfigResize_singleNode(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDAXClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).DAX()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dax_cluster" {
+			continue
+		}
+		res, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+			ClusterNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			// Verify the error is what we want
+			if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+		if len(res.Clusters) > 0 {
+			return fmt.Errorf("DAX cluster %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSDAXClusterExists(n string, v *dax.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DAX cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).DAX()
+		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+			ClusterNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return fmt.Errorf("DAX error: %v", err)
+		}
+
+		for _, c := range resp.Clusters {
+			if *c.ClusterName == rs.Primary.ID {
+				*v = *c
+				return nil
+			}
+		}
+
+		return fmt.Errorf("DAX cluster %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccAWSDAXClusterConfigIamRole(rString string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "dax.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "tf-%s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "dynamodb:*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rString, rString)
+}
+
+func testAccAWSDAXClusterConfig(rString string) string {
+	return testAccAWSDAXClusterConfigIamRole(rString) + fmt.Sprintf(`
+resource "aws_dax_cluster" "test" {
+  cluster_name       = "tf-%s"
+  iam_role_arn       = "${aws_iam_role.test.arn}"
+  node_type          = "dax.r3.large"
+  replication_factor = 1
+  description        = "test cluster"
+
+  tags {
+    foo = "bar"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rString)
+}
+
+func testAccAWSDAXClusterConfigResize_singleNode(rString string) string {
+	return testAccAWSDAXClusterConfigIamRole(rString) + fmt.Sprintf(`
+resource "aws_dax_cluster" "test" {
+  cluster_name       = "tf-%s"
+  iam_role_arn       = "${aws_iam_role.test.arn}"
+  node_type          = "dax.r3.large"
+  replication_factor = 1
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rString)
+}
+
+func testAccAWSDAXClusterConfigResize_multiNode(rString string) string {
+	return testAccAWSDAXClusterConfigIamRole(rString) + fmt.Sprintf(`
+resource "aws_dax_cluster" "test" {
+  cluster_name       = "tf-%s"
+  iam_role_arn       = "${aws_iam_role.test.arn}"
+  node_type          = "dax.r3.large"
+  replication_factor = 2
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rString)
+}
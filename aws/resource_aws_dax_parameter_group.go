@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDaxParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDaxParameterGroupCreate,
+		Read:   resourceAwsDaxParameterGroupRead,
+		Update: resourceAwsDaxParameterGroupUpdate,
+		Delete: resourceAwsDaxParameterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceAwsElasticacheParameterHash,
+			},
+		},
+	}
+}
+
+func resourceAwsDaxParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	input := &dax.CreateParameterGroupInput{
+		ParameterGroupName: aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DAX Parameter Group: %s", input)
+	if _, err := conn.CreateParameterGroup(input); err != nil {
+		return fmt.Errorf("Error creating DAX Parameter Group %s: %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	if len(d.Get("parameters").(*schema.Set).List()) > 0 {
+		return resourceAwsDaxParameterGroupUpdate(d, meta)
+	}
+	return resourceAwsDaxParameterGroupRead(d, meta)
+}
+
+func resourceAwsDaxParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	resp, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
+		ParameterGroupNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+			log.Printf("[WARN] DAX Parameter Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DAX Parameter Group %s: %s", d.Id(), err)
+	}
+
+	if len(resp.ParameterGroups) == 0 {
+		log.Printf("[WARN] DAX Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pg := resp.ParameterGroups[0]
+	d.Set("name", pg.ParameterGroupName)
+	d.Set("description", pg.Description)
+
+	var parameters []*dax.Parameter
+	input := &dax.DescribeParametersInput{
+		ParameterGroupName: aws.String(d.Id()),
+	}
+	for {
+		paramResp, err := conn.DescribeParameters(input)
+		if err != nil {
+			return fmt.Errorf("Error reading parameters of DAX Parameter Group %s: %s", d.Id(), err)
+		}
+		parameters = append(parameters, paramResp.Parameters...)
+		if paramResp.NextToken == nil {
+			break
+		}
+		input.NextToken = paramResp.NextToken
+	}
+
+	if err := d.Set("parameters", flattenDaxParameterGroupParameters(parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDaxParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	input := &dax.UpdateParameterGroupInput{
+		ParameterGroupName:  aws.String(d.Id()),
+		ParameterNameValues: expandDaxParameterGroupParameterNameValue(d.Get("parameters").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Updating DAX Parameter Group: %s", input)
+	if _, err := conn.UpdateParameterGroup(input); err != nil {
+		return fmt.Errorf("Error updating DAX Parameter Group %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsDaxParameterGroupRead(d, meta)
+}
+
+func resourceAwsDaxParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DAX()
+
+	log.Printf("[DEBUG] Deleting DAX Parameter Group: %s", d.Id())
+	_, err := conn.DeleteParameterGroup(&dax.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DAX Parameter Group %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDaxParameterGroupParameterNameValue(config []interface{}) []*dax.ParameterNameValue {
+	if len(config) == 0 {
+		return nil
+	}
+	results := make([]*dax.ParameterNameValue, 0, len(config))
+	for _, raw := range config {
+		m := raw.(map[string]interface{})
+		results = append(results, &dax.ParameterNameValue{
+			ParameterName:  aws.String(m["name"].(string)),
+			ParameterValue: aws.String(m["value"].(string)),
+		})
+	}
+	return results
+}
+
+func flattenDaxParameterGroupParameters(params []*dax.Parameter) []map[string]interface{} {
+	if len(params) == 0 {
+		return nil
+	}
+	results := make([]map[string]interface{}, 0, len(params))
+	for _, p := range params {
+		results = append(results, map[string]interface{}{
+			"name":  aws.StringValue(p.ParameterName),
+			"value": aws.StringValue(p.ParameterValue),
+		})
+	}
+	return results
+}
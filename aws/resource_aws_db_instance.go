@@ -31,6 +31,8 @@ func resourceAwsDbInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(40 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -350,14 +352,15 @@ func resourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	conn := meta.(*AWSClient).RDS()
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -704,7 +707,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	v, err := resourceAwsDbInstanceRetrieve(d.Id(), meta.(*AWSClient).rdsconn)
+	v, err := resourceAwsDbInstanceRetrieve(d.Id(), meta.(*AWSClient).RDS())
 
 	if err != nil {
 		return err
@@ -776,8 +779,8 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	// list tags for resource
 	// set tags
-	conn := meta.(*AWSClient).rdsconn
-	arn, err := buildRDSARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+	conn := meta.(*AWSClient).RDS()
+	arn, err := buildRDSARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region)
 	if err != nil {
 		name := "<empty>"
 		if v.DBName != nil && *v.DBName != "" {
@@ -798,7 +801,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
@@ -836,7 +839,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).RDS()
 
 	log.Printf("[DEBUG] DB Instance destroy: %v", d.Id())
 
@@ -876,7 +879,7 @@ func waitUntilAwsDbInstanceIsDeleted(id string, conn *rds.RDS, timeout time.Dura
 }
 
 func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).RDS()
 
 	d.Partial(true)
 
@@ -1073,11 +1076,12 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if arn, err := buildRDSARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
+	if arn, err := buildRDSARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region); err == nil {
 		if err := setTagsRDS(conn, d, arn); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 	d.Partial(false)
@@ -30,7 +30,7 @@ func testSweepDbInstances(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).RDS()
 
 	prefixes := []string{
 		"foobarbaz-test-terraform-",
@@ -490,7 +490,7 @@ func TestAccAWSDBInstance_ec2Classic(t *testing.T) {
 }
 
 func testAccCheckAWSDBInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).RDS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -586,7 +586,7 @@ func testAccCheckAWSDBInstanceSnapshot(rInt int) resource.TestCheckFunc {
 			}
 
 			awsClient := testAccProvider.Meta().(*AWSClient)
-			conn := awsClient.rdsconn
+			conn := awsClient.RDS()
 
 			var err error
 			log.Printf("[INFO] Trying to locate the DBInstance Final Snapshot")
@@ -603,7 +603,7 @@ func testAccCheckAWSDBInstanceSnapshot(rInt int) resource.TestCheckFunc {
 				}
 			} else { // snapshot was found,
 				// verify we have the tags copied to the snapshot
-				instanceARN, err := buildRDSARN(snapshot_identifier, testAccProvider.Meta().(*AWSClient).partition, testAccProvider.Meta().(*AWSClient).accountid, testAccProvider.Meta().(*AWSClient).region)
+				instanceARN, err := buildRDSARN(snapshot_identifier, testAccProvider.Meta().(*AWSClient).Partition(), testAccProvider.Meta().(*AWSClient).AccountID(), testAccProvider.Meta().(*AWSClient).region)
 				// tags have a different ARN, just swapping :db: for :snapshot:
 				tagsARN := strings.Replace(instanceARN, ":db:", ":snapshot:", 1)
 				if err != nil {
@@ -665,7 +665,7 @@ func testAccCheckAWSDBInstanceSnapshot(rInt int) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSDBInstanceNoSnapshot(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).RDS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -719,7 +719,7 @@ func testAccCheckAWSDBInstanceExists(n string, v *rds.DBInstance) resource.TestC
 			return fmt.Errorf("No DB Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).RDS()
 
 		opts := rds.DescribeDBInstancesInput{
 			DBInstanceIdentifier: aws.String(rs.Primary.ID),
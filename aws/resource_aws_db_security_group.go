@@ -25,6 +25,8 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -76,14 +78,15 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 				Set: resourceAwsDbSecurityGroupIngressHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	conn := meta.(*AWSClient).RDS()
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var err error
 	var errs []error
@@ -175,8 +178,8 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("ingress", rules)
 
-	conn := meta.(*AWSClient).rdsconn
-	arn, err := buildRDSSecurityGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+	conn := meta.(*AWSClient).RDS()
+	arn, err := buildRDSSecurityGroupARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region)
 	if err != nil {
 		name := "<empty>"
 		if sg.DBSecurityGroupName != nil && *sg.DBSecurityGroupName != "" {
@@ -197,21 +200,22 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
 }
 
 func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).RDS()
 
 	d.Partial(true)
-	if arn, err := buildRDSSecurityGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
+	if arn, err := buildRDSSecurityGroupARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region); err == nil {
 		if err := setTagsRDS(conn, d, arn); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -256,7 +260,7 @@ func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDbSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).RDS()
 
 	log.Printf("[DEBUG] DB Security Group destroy: %v", d.Id())
 
@@ -277,7 +281,7 @@ func resourceAwsDbSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDbSecurityGroupRetrieve(d *schema.ResourceData, meta interface{}) (*rds.DBSecurityGroup, error) {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).RDS()
 
 	opts := rds.DescribeDBSecurityGroupsInput{
 		DBSecurityGroupName: aws.String(d.Id()),
@@ -26,6 +26,8 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 		Delete: resourceAwsDefaultNetworkAclDelete,
 		Update: resourceAwsDefaultNetworkAclUpdate,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -145,7 +147,8 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 				Set: resourceAwsNetworkAclEntryHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -165,7 +168,7 @@ func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	d.Partial(true)
 
 	if d.HasChange("ingress") {
@@ -234,6 +237,7 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -250,7 +254,7 @@ func resourceAwsDefaultNetworkAclDelete(d *schema.ResourceData, meta interface{}
 // revokeAllNetworkACLEntries revoke all ingress and egress rules that the Default
 // Network ACL currently has
 func revokeAllNetworkACLEntries(netaclId string, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
 		NetworkAclIds: []*string{aws.String(netaclId)},
@@ -16,6 +16,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 		Update: resourceAwsRouteTableUpdate,
 		Delete: resourceAwsDefaultRouteTableDelete,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
 				Type:     schema.TypeString,
@@ -85,7 +87,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 				Set: resourceAwsRouteTableHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -93,7 +96,7 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_route_table_id").(string))
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
 	if err != nil {
 		return err
@@ -119,7 +122,7 @@ func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDefaultRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	// look up default route table for VPC
 	filter1 := &ec2.Filter{
 		Name:   aws.String("association.main"),
@@ -162,7 +165,7 @@ func resourceAwsDefaultRouteTableDelete(d *schema.ResourceData, meta interface{}
 // revokeAllRouteTableRules revoke all routes on the Default Route Table
 // This should only be ran once at creation time of this resource
 func revokeAllRouteTableRules(defaultRouteTableId string, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	log.Printf("\n***\nrevokeAllRouteTableRules\n***\n")
 
 	resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
@@ -50,7 +50,7 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 }
 
 func resourceAwsDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	req := &ec2.DescribeSubnetsInput{
 		Filters: []*ec2.Filter{
 			&ec2.Filter{
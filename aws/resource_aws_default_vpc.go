@@ -35,7 +35,7 @@ func resourceAwsDefaultVpc() *schema.Resource {
 }
 
 func resourceAwsDefaultVpcCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	req := &ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
 			{
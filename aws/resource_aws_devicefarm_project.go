@@ -31,7 +31,7 @@ func resourceAwsDevicefarmProject() *schema.Resource {
 }
 
 func resourceAwsDevicefarmProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).DeviceFarm()
 	region := meta.(*AWSClient).region
 
 	//	We need to ensure that DeviceFarm is only being run against us-west-2
@@ -57,7 +57,7 @@ func resourceAwsDevicefarmProjectCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDevicefarmProjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).DeviceFarm()
 
 	input := &devicefarm.GetProjectInput{
 		Arn: aws.String(d.Id()),
@@ -76,7 +76,7 @@ func resourceAwsDevicefarmProjectRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDevicefarmProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).DeviceFarm()
 
 	if d.HasChange("name") {
 		input := &devicefarm.UpdateProjectInput{
@@ -96,7 +96,7 @@ func resourceAwsDevicefarmProjectUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDevicefarmProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).DeviceFarm()
 
 	input := &devicefarm.DeleteProjectInput{
 		Arn: aws.String(d.Id()),
@@ -29,6 +29,8 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -64,7 +66,8 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 			"vpc_settings": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -312,7 +315,7 @@ func createActiveDirectoryService(dsconn *directoryservice.DirectoryService, d *
 }
 
 func resourceAwsDirectoryServiceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).DirectoryService()
 
 	creationFunc, ok := directoryCreationFuncs[d.Get("type").(string)]
 	if !ok {
@@ -376,7 +379,7 @@ func resourceAwsDirectoryServiceDirectoryCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).DirectoryService()
 
 	if d.HasChange("enable_sso") {
 		d.SetPartial("enable_sso")
@@ -407,7 +410,7 @@ func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).DirectoryService()
 
 	input := directoryservice.DescribeDirectoriesInput{
 		DirectoryIds: []*string{aws.String(d.Id())},
@@ -460,13 +463,13 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("Failed to get Directory service tags (id: %s): %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapDS(tagList.Tags))
+	setTagsAll(d, meta, tagsToMapDS(tagList.Tags))
 
 	return nil
 }
 
 func resourceAwsDirectoryServiceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).DirectoryService()
 
 	input := directoryservice.DeleteDirectoryInput{
 		DirectoryId: aws.String(d.Id()),
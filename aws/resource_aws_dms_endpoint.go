@@ -24,6 +24,8 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"certificate_arn": {
 				Type:         schema.TypeString,
@@ -112,6 +114,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -121,13 +124,13 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 }
 
 func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	request := &dms.CreateEndpointInput{
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	// if dynamodb then add required params
@@ -184,7 +187,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	response, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
 		Filters: []*dms.Filter{
@@ -214,13 +217,13 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAll(d, meta, dmsTagsToMap(tagsResp.TagList))
 
 	return nil
 }
 
 func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	request := &dms.ModifyEndpointInput{
 		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
@@ -284,7 +287,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -306,7 +309,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDmsEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	request := &dms.DeleteEndpointInput{
 		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
@@ -55,7 +55,7 @@ func checkDmsReplicationInstanceExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No ID is set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).DatabaseMigrationService()
 		resp, err := conn.DescribeReplicationInstances(&dms.DescribeReplicationInstancesInput{
 			Filters: []*dms.Filter{
 				{
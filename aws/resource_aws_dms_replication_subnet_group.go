@@ -20,6 +20,8 @@ func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"replication_subnet_group_arn": {
 				Type:     schema.TypeString,
@@ -45,6 +47,7 @@ func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -54,13 +57,13 @@ func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 }
 
 func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	request := &dms.CreateReplicationSubnetGroupInput{
 		ReplicationSubnetGroupIdentifier:  aws.String(d.Get("replication_subnet_group_id").(string)),
 		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
 		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
-		Tags:                              dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                              dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Println("[DEBUG] DMS create replication subnet group:", request)
@@ -75,7 +78,7 @@ func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	response, err := conn.DescribeReplicationSubnetGroups(&dms.DescribeReplicationSubnetGroupsInput{
 		Filters: []*dms.Filter{
@@ -96,7 +99,7 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 	// The AWS API for DMS subnet groups does not return the ARN which is required to
 	// retrieve tags. This ARN can be built.
 	d.Set("replication_subnet_group_arn", fmt.Sprintf("arn:aws:dms:%s:%s:subgrp:%s",
-		meta.(*AWSClient).region, meta.(*AWSClient).accountid, d.Id()))
+		meta.(*AWSClient).region, meta.(*AWSClient).AccountID(), d.Id()))
 
 	err = resourceAwsDmsReplicationSubnetGroupSetState(d, response.ReplicationSubnetGroups[0])
 	if err != nil {
@@ -109,13 +112,13 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAll(d, meta, dmsTagsToMap(tagsResp.TagList))
 
 	return nil
 }
 
 func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	// Updates to subnet groups are only valid when sending SubnetIds even if there are no
 	// changes to SubnetIds.
@@ -128,7 +131,7 @@ func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta int
 		request.ReplicationSubnetGroupDescription = aws.String(d.Get("replication_subnet_group_description").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_subnet_group_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -146,7 +149,7 @@ func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsDmsReplicationSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
 	request := &dms.DeleteReplicationSubnetGroupInput{
 		ReplicationSubnetGroupIdentifier: aws.String(d.Get("replication_subnet_group_id").(string)),
@@ -64,7 +64,7 @@ func checkDmsReplicationSubnetGroupExistsWithProviders(n string, providers *[]*s
 				continue
 			}
 
-			conn := provider.Meta().(*AWSClient).dmsconn
+			conn := provider.Meta().(*AWSClient).DatabaseMigrationService()
 			_, err := conn.DescribeReplicationSubnetGroups(&dms.DescribeReplicationSubnetGroupsInput{
 				Filters: []*dms.Filter{
 					{
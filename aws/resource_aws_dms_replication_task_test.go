@@ -53,7 +53,7 @@ func checkDmsReplicationTaskExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).DatabaseMigrationService()
 		resp, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
 			Filters: []*dms.Filter{
 				{
@@ -80,7 +80,7 @@ func dmsReplicationTaskDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).DatabaseMigrationService()
 		resp, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
 			Filters: []*dms.Filter{
 				{
@@ -38,7 +38,7 @@ func resourceAwsDxConnection() *schema.Resource {
 }
 
 func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.CreateConnectionInput{
 		Bandwidth:      aws.String(d.Get("bandwidth").(string)),
@@ -54,7 +54,7 @@ func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	connectionId := d.Id()
 	input := &directconnect.DescribeConnectionsInput{
@@ -78,7 +78,7 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDxConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.DeleteConnectionInput{
 		ConnectionId: aws.String(d.Id()),
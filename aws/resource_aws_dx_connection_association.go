@@ -30,7 +30,7 @@ func resourceAwsDxConnectionAssociation() *schema.Resource {
 }
 
 func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.AssociateConnectionWithLagInput{
 		ConnectionId: aws.String(d.Get("connection_id").(string)),
@@ -46,7 +46,7 @@ func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.DescribeConnectionsInput{
 		ConnectionId: aws.String(d.Id()),
@@ -72,7 +72,7 @@ func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsDxConnectionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.DisassociateConnectionFromLagInput{
 		ConnectionId: aws.String(d.Id()),
@@ -48,7 +48,7 @@ func resourceAwsDxLag() *schema.Resource {
 }
 
 func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.CreateLagInput{
 		ConnectionsBandwidth: aws.String(d.Get("connections_bandwidth").(string)),
@@ -65,7 +65,7 @@ func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	lagId := d.Id()
 	input := &directconnect.DescribeLagsInput{
@@ -89,7 +89,7 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	input := &directconnect.UpdateLagInput{
 		LagId: aws.String(d.Id()),
@@ -105,7 +105,7 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).DirectConnect()
 
 	if d.Get("force_destroy").(bool) {
 		input := &directconnect.DescribeLagsInput{
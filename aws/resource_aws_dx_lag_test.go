@@ -28,7 +28,7 @@ func TestAccAwsDxLag_basic(t *testing.T) {
 }
 
 func testAccCheckAwsDxLagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).DirectConnect()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_lag" {
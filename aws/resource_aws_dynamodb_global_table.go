@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbGlobalTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbGlobalTableCreate,
+		Read:   resourceAwsDynamoDbGlobalTableRead,
+		Update: resourceAwsDynamoDbGlobalTableUpdate,
+		Delete: resourceAwsDynamoDbGlobalTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDynamoDbName,
+			},
+			"replica": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	input := &dynamodb.CreateGlobalTableInput{
+		GlobalTableName:  aws.String(d.Get("name").(string)),
+		ReplicationGroup: expandAwsDynamoDbReplicas(d.Get("replica").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Global Table: %s", input)
+	_, err := conn.CreateGlobalTable(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB Global Table: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.GlobalTableStatusCreating},
+		Target:     []string{dynamodb.GlobalTableStatusActive},
+		Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Global Table (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
+}
+
+func resourceAwsDynamoDbGlobalTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	globalTable, err := resourceAwsDynamoDbGlobalTableRetrieve(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if globalTable == nil {
+		log.Printf("[WARN] DynamoDB Global Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalTable.GlobalTableArn)
+	d.Set("name", globalTable.GlobalTableName)
+
+	if err := d.Set("replica", flattenAwsDynamoDbReplicas(globalTable.ReplicationGroup)); err != nil {
+		return fmt.Errorf("Error setting replica: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	if d.HasChange("replica") {
+		o, n := d.GetChange("replica")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0)
+		for _, r := range os.Difference(ns).List() {
+			m := r.(map[string]interface{})
+			replicaUpdates = append(replicaUpdates, &dynamodb.ReplicaUpdate{
+				Delete: &dynamodb.DeleteReplicaAction{
+					RegionName: aws.String(m["region_name"].(string)),
+				},
+			})
+		}
+		for _, r := range ns.Difference(os).List() {
+			m := r.(map[string]interface{})
+			replicaUpdates = append(replicaUpdates, &dynamodb.ReplicaUpdate{
+				Create: &dynamodb.CreateReplicaAction{
+					RegionName: aws.String(m["region_name"].(string)),
+				},
+			})
+		}
+
+		// Only one replica can be added or removed per request.
+		for _, replicaUpdate := range replicaUpdates {
+			input := &dynamodb.UpdateGlobalTableInput{
+				GlobalTableName: aws.String(d.Id()),
+				ReplicaUpdates:  []*dynamodb.ReplicaUpdate{replicaUpdate},
+			}
+
+			log.Printf("[DEBUG] Updating DynamoDB Global Table: %s", input)
+			if _, err := conn.UpdateGlobalTable(input); err != nil {
+				return fmt.Errorf("Error updating DynamoDB Global Table (%s): %s", d.Id(), err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{dynamodb.GlobalTableStatusUpdating},
+				Target:     []string{dynamodb.GlobalTableStatusActive},
+				Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(conn, d.Id()),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				MinTimeout: 10 * time.Second,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB Global Table (%s) to be updated: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
+}
+
+// A global table cannot be deleted directly; it is removed once all of its
+// replicas have been removed.
+func resourceAwsDynamoDbGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	for _, r := range d.Get("replica").(*schema.Set).List() {
+		m := r.(map[string]interface{})
+		input := &dynamodb.UpdateGlobalTableInput{
+			GlobalTableName: aws.String(d.Id()),
+			ReplicaUpdates: []*dynamodb.ReplicaUpdate{{
+				Delete: &dynamodb.DeleteReplicaAction{
+					RegionName: aws.String(m["region_name"].(string)),
+				},
+			}},
+		}
+
+		log.Printf("[DEBUG] Deleting DynamoDB Global Table replica: %s", input)
+		if _, err := conn.UpdateGlobalTable(input); err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+				return nil
+			}
+			return fmt.Errorf("Error deleting DynamoDB Global Table (%s) replica: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				dynamodb.GlobalTableStatusUpdating,
+				dynamodb.GlobalTableStatusDeleting,
+			},
+			Target:     []string{dynamodb.GlobalTableStatusActive, ""},
+			Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(conn, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Global Table (%s) replica to be deleted: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbGlobalTableRetrieve(conn *dynamodb.DynamoDB, name string) (*dynamodb.GlobalTableDescription, error) {
+	output, err := conn.DescribeGlobalTable(&dynamodb.DescribeGlobalTableInput{
+		GlobalTableName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving DynamoDB Global Table (%s): %s", name, err)
+	}
+
+	return output.GlobalTableDescription, nil
+}
+
+func resourceAwsDynamoDbGlobalTableStateRefreshFunc(conn *dynamodb.DynamoDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalTable, err := resourceAwsDynamoDbGlobalTableRetrieve(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+		if globalTable == nil {
+			return name, "", nil
+		}
+		return globalTable, aws.StringValue(globalTable.GlobalTableStatus), nil
+	}
+}
+
+func expandAwsDynamoDbReplicas(configured []interface{}) []*dynamodb.Replica {
+	replicas := make([]*dynamodb.Replica, 0, len(configured))
+	for _, r := range configured {
+		m := r.(map[string]interface{})
+		replicas = append(replicas, &dynamodb.Replica{
+			RegionName: aws.String(m["region_name"].(string)),
+		})
+	}
+	return replicas
+}
+
+func flattenAwsDynamoDbReplicas(replicas []*dynamodb.ReplicaDescription) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(replicas))
+	for _, r := range replicas {
+		result = append(result, map[string]interface{}{
+			"region_name": aws.StringValue(r.RegionName),
+		})
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbGlobalTable_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_global_table.test"
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamoDbGlobalTableConfig_basic(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", tableName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "arn",
+						regexp.MustCompile("^arn:aws:dynamodb::[0-9]{12}:global-table/"+tableName+"$")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDynamoDbGlobalTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_global_table" {
+			continue
+		}
+
+		globalTable, err := resourceAwsDynamoDbGlobalTableRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if globalTable != nil {
+			return fmt.Errorf("DynamoDB Global Table %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDynamoDbGlobalTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+		_, err := conn.DescribeGlobalTable(&dynamodb.DescribeGlobalTableInput{
+			GlobalTableName: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccDynamoDbGlobalTableConfig_basic(tableName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {
+  current = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  hash_key         = "myAttribute"
+  name             = "%s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.test"]
+
+  name = "%s"
+
+  replica {
+    region_name = "${data.aws_region.current.name}"
+  }
+}
+`, tableName, tableName)
+}
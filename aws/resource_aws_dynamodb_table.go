@@ -42,6 +42,8 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsDynamoDbTableMigrateState,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -201,13 +203,20 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"restore_from_backup_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 
 	name := d.Get("name").(string)
 
@@ -318,11 +327,32 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	_, timeToLiveOk := d.GetOk("ttl")
-	_, tagsOk := d.GetOk("tags")
+	_, tagsOk := d.GetOk("tags_all")
+
+	backupArn, restoreOk := d.GetOk("restore_from_backup_arn")
 
 	attemptCount := 1
 	for attemptCount <= DYNAMODB_MAX_THROTTLE_RETRIES {
-		output, err := dynamodbconn.CreateTable(req)
+		var table *dynamodb.TableDescription
+		var err error
+		if restoreOk {
+			// The key schema, indexes and throughput of a restored table
+			// come from the backup.
+			var output *dynamodb.RestoreTableFromBackupOutput
+			output, err = dynamodbconn.RestoreTableFromBackup(&dynamodb.RestoreTableFromBackupInput{
+				BackupArn:       aws.String(backupArn.(string)),
+				TargetTableName: aws.String(name),
+			})
+			if err == nil {
+				table = output.TableDescription
+			}
+		} else {
+			var output *dynamodb.CreateTableOutput
+			output, err = dynamodbconn.CreateTable(req)
+			if err == nil {
+				table = output.TableDescription
+			}
+		}
 		if err != nil {
 			if awsErr, ok := err.(awserr.Error); ok {
 				switch code := awsErr.Code(); code {
@@ -351,8 +381,8 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 			}
 		} else {
 			// No error, set ID and return
-			d.SetId(*output.TableDescription.TableName)
-			tableArn := *output.TableDescription.TableArn
+			d.SetId(*table.TableName)
+			tableArn := *table.TableArn
 			if err := d.Set("arn", tableArn); err != nil {
 				return err
 			}
@@ -363,6 +393,12 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 				return err
 			}
 
+			if restoreOk {
+				if err := updateRestoredDynamoDbTable(d, meta, req); err != nil {
+					return err
+				}
+			}
+
 			log.Printf("[DEBUG] Setting DynamoDB TimeToLive on arn: %s", tableArn)
 			if timeToLiveOk {
 				if err := updateTimeToLive(d, meta); err != nil {
@@ -389,7 +425,7 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating DynamoDB table %s", d.Id())
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 
 	// Ensure table is active before trying to update
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
@@ -648,8 +684,56 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 	return resourceAwsDynamoDbTableRead(d, meta)
 }
 
+// updateRestoredDynamoDbTable applies the configured throughput and stream
+// settings to a table restored from a backup, which keeps the settings of
+// the source table.
+func updateRestoredDynamoDbTable(d *schema.ResourceData, meta interface{}, req *dynamodb.CreateTableInput) error {
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
+
+	result, err := dynamodbconn.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return err
+	}
+	table := result.Table
+
+	if *table.ProvisionedThroughput.ReadCapacityUnits != *req.ProvisionedThroughput.ReadCapacityUnits ||
+		*table.ProvisionedThroughput.WriteCapacityUnits != *req.ProvisionedThroughput.WriteCapacityUnits {
+		log.Printf("[DEBUG] Updating throughput of restored DynamoDB table %s", d.Id())
+		_, err := dynamodbconn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName:             aws.String(d.Id()),
+			ProvisionedThroughput: req.ProvisionedThroughput,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating throughput of restored DynamoDB table: %s", err)
+		}
+
+		if err := waitForTableToBeActive(d.Id(), meta); err != nil {
+			return errwrap.Wrapf("Error waiting for Dynamo DB Table update: {{err}}", err)
+		}
+	}
+
+	if req.StreamSpecification != nil && aws.BoolValue(req.StreamSpecification.StreamEnabled) {
+		log.Printf("[DEBUG] Enabling stream of restored DynamoDB table %s", d.Id())
+		_, err := dynamodbconn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName:           aws.String(d.Id()),
+			StreamSpecification: req.StreamSpecification,
+		})
+		if err != nil {
+			return fmt.Errorf("Error enabling stream of restored DynamoDB table: %s", err)
+		}
+
+		if err := waitForTableToBeActive(d.Id(), meta); err != nil {
+			return errwrap.Wrapf("Error waiting for Dynamo DB Table update: {{err}}", err)
+		}
+	}
+
+	return nil
+}
+
 func updateTimeToLive(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 
 	if ttl, ok := d.GetOk("ttl"); ok {
 
@@ -689,7 +773,7 @@ func updateTimeToLive(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 	log.Printf("[DEBUG] Loading data for DynamoDB table '%s'", d.Id())
 	req := &dynamodb.DescribeTableInput{
 		TableName: aws.String(d.Id()),
@@ -706,11 +790,22 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	return flattenAwsDynamoDbTableResource(d, meta, result.Table)
+	if err := flattenAwsDynamoDbTableResource(d, meta, result.Table); err != nil {
+		return err
+	}
+
+	tags, err := readTableTags(d, meta)
+	if err != nil {
+		return err
+	}
+	if len(tags) != 0 {
+		return setTagsAll(d, meta, tags)
+	}
+	return nil
 }
 
 func flattenAwsDynamoDbTableResource(d *schema.ResourceData, meta interface{}, table *dynamodb.TableDescription) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 
 	d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 	d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
@@ -832,19 +927,11 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, meta interface{}, t
 		log.Printf("[DEBUG] Loaded TimeToLive data for DynamoDB table '%s'", d.Id())
 	}
 
-	tags, err := readTableTags(d, meta)
-	if err != nil {
-		return err
-	}
-	if len(tags) != 0 {
-		d.Set("tags", tags)
-	}
-
 	return nil
 }
 
 func resourceAwsDynamoDbTableDelete(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
 		return errwrap.Wrapf("Error waiting for Dynamo DB Table update: {{err}}", err)
@@ -962,7 +1049,7 @@ func getAttributeType(d *schema.ResourceData, attributeName string) (string, err
 }
 
 func waitForGSIToBeActive(tableName string, gsiName string, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 	req := &dynamodb.DescribeTableInput{
 		TableName: aws.String(tableName),
 	}
@@ -1004,7 +1091,7 @@ func waitForGSIToBeActive(tableName string, gsiName string, meta interface{}) er
 }
 
 func waitForTableToBeActive(tableName string, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 	req := &dynamodb.DescribeTableInput{
 		TableName: aws.String(tableName),
 	}
@@ -1032,7 +1119,7 @@ func waitForTableToBeActive(tableName string, meta interface{}) error {
 }
 
 func waitForTimeToLiveUpdateToBeCompleted(tableName string, enabled bool, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 	req := &dynamodb.DescribeTimeToLiveInput{
 		TableName: aws.String(tableName),
 	}
@@ -1069,9 +1156,9 @@ func createTableTags(d *schema.ResourceData, meta interface{}) error {
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
 		return err
 	}
-	tags := d.Get("tags").(map[string]interface{})
+	tags := d.Get("tags_all").(map[string]interface{})
 	arn := d.Get("arn").(string)
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).DynamoDB()
 	req := &dynamodb.TagResourceInput{
 		ResourceArn: aws.String(arn),
 		Tags:        tagsFromMapDynamoDb(tags),
@@ -1087,19 +1174,9 @@ func readTableTags(d *schema.ResourceData, meta interface{}) (map[string]string,
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
 		return nil, err
 	}
-	arn := d.Get("arn").(string)
-	//result := make(map[string]string)
-
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
-	req := &dynamodb.ListTagsOfResourceInput{
-		ResourceArn: aws.String(arn),
-	}
-
-	output, err := dynamodbconn.ListTagsOfResource(req)
+	tags, err := dynamoDbTagsAdapter{conn: meta.(*AWSClient).DynamoDB(), arn: d.Get("arn").(string)}.ListTags()
 	if err != nil {
 		return nil, fmt.Errorf("Error reading tags from dynamodb resource: %s", err)
 	}
-	result := tagsToMapDynamoDb(output.Tags)
-	// TODO Read NextToken if avail
-	return result, nil
+	return tags.Map(), nil
 }
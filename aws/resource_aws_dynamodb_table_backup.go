@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableBackupCreate,
+		Read:   resourceAwsDynamoDbTableBackupRead,
+		Delete: resourceAwsDynamoDbTableBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDynamoDbName,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	input := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(d.Get("name").(string)),
+		TableName:  aws.String(d.Get("table_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB table backup: %s", input)
+	output, err := conn.CreateBackup(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB table backup: %s", err)
+	}
+
+	d.SetId(*output.BackupDetails.BackupArn)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.BackupStatusCreating},
+		Target:  []string{dynamodb.BackupStatusAvailable},
+		Refresh: func() (interface{}, string, error) {
+			output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+				BackupArn: aws.String(d.Id()),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			details := output.BackupDescription.BackupDetails
+			return details, aws.StringValue(details.BackupStatus), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB table backup (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table backup (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DynamoDB table backup (%s): %s", d.Id(), err)
+	}
+
+	details := output.BackupDescription.BackupDetails
+	if aws.StringValue(details.BackupStatus) == dynamodb.BackupStatusDeleted {
+		log.Printf("[WARN] DynamoDB table backup (%s) is deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", details.BackupArn)
+	d.Set("name", details.BackupName)
+	d.Set("size_bytes", details.BackupSizeBytes)
+	d.Set("status", details.BackupStatus)
+	if details.BackupCreationDateTime != nil {
+		d.Set("creation_date_time", details.BackupCreationDateTime.Format(time.RFC3339))
+	}
+
+	if source := output.BackupDescription.SourceTableDetails; source != nil {
+		d.Set("table_name", source.TableName)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	log.Printf("[DEBUG] Deleting DynamoDB table backup: %s", d.Id())
+	_, err := conn.DeleteBackup(&dynamodb.DeleteBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB table backup (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbTableBackup_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_table_backup.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbTableBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamoDbTableBackupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbTableBackupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", dynamodb.BackupStatusAvailable),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDynamoDbTableBackupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_backup" {
+			continue
+		}
+
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		status := aws.StringValue(output.BackupDescription.BackupDetails.BackupStatus)
+		if status != dynamodb.BackupStatusDeleted {
+			return fmt.Errorf("DynamoDB table backup %s still exists with status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDynamoDbTableBackupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+		_, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccDynamoDbTableBackupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = "%[1]s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_backup" "test" {
+  name       = "%[1]s"
+  table_name = "${aws_dynamodb_table.test.name}"
+}
+`, rName)
+}
@@ -0,0 +1,361 @@
+package aws
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbTableItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableItemCreate,
+		Read:   resourceAwsDynamoDbTableItemRead,
+		Update: resourceAwsDynamoDbTableItemUpdate,
+		Delete: resourceAwsDynamoDbTableItemDelete,
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"item": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDynamoDbTableItem,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableItemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	attributes, err := expandDynamoDbTableItemAttributes(d.Get("item").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := buildDynamoDbTableItemKey(attributes, hashKey, rangeKey)
+	if err != nil {
+		return err
+	}
+
+	// Refuse to silently overwrite an item that is not managed by Terraform.
+	input := &dynamodb.PutItemInput{
+		TableName:           aws.String(tableName),
+		Item:                attributes,
+		ConditionExpression: aws.String("attribute_not_exists(#hk)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#hk": aws.String(hashKey),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB table item: %s", input)
+	_, err = conn.PutItem(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB table item: %s", err)
+	}
+
+	d.SetId(buildDynamoDbTableItemId(tableName, hashKey, rangeKey, key))
+
+	return resourceAwsDynamoDbTableItemRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableItemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	attributes, err := expandDynamoDbTableItemAttributes(d.Get("item").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := buildDynamoDbTableItemKey(attributes, hashKey, rangeKey)
+	if err != nil {
+		return err
+	}
+
+	// Only the attributes in the configured item are managed, so drift is
+	// only detected on those.
+	input := &dynamodb.GetItemInput{
+		TableName:      aws.String(tableName),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	}
+	input.ProjectionExpression, input.ExpressionAttributeNames = buildDynamoDbProjectionExpression(attributes)
+
+	log.Printf("[DEBUG] Reading DynamoDB table item: %s", input)
+	output, err := conn.GetItem(input)
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table %q not found, removing item (%s) from state", tableName, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DynamoDB table item (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Item) == 0 {
+		log.Printf("[WARN] DynamoDB table item (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	item, err := flattenDynamoDbTableItemAttributes(output.Item)
+	if err != nil {
+		return err
+	}
+	d.Set("item", item)
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	if d.HasChange("item") {
+		tableName := d.Get("table_name").(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+
+		o, n := d.GetChange("item")
+
+		oldAttributes, err := expandDynamoDbTableItemAttributes(o.(string))
+		if err != nil {
+			return err
+		}
+		newAttributes, err := expandDynamoDbTableItemAttributes(n.(string))
+		if err != nil {
+			return err
+		}
+
+		oldKey, err := buildDynamoDbTableItemKey(oldAttributes, hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+		newKey, err := buildDynamoDbTableItemKey(newAttributes, hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+
+		input := &dynamodb.PutItemInput{
+			TableName: aws.String(tableName),
+			Item:      newAttributes,
+		}
+
+		log.Printf("[DEBUG] Updating DynamoDB table item: %s", input)
+		if _, err := conn.PutItem(input); err != nil {
+			return fmt.Errorf("Error updating DynamoDB table item (%s): %s", d.Id(), err)
+		}
+
+		// Changing a key value writes a new item, so the old one is removed.
+		if !reflect.DeepEqual(oldKey, newKey) {
+			log.Printf("[DEBUG] Deleting previous DynamoDB table item: %s", d.Id())
+			_, err := conn.DeleteItem(&dynamodb.DeleteItemInput{
+				TableName: aws.String(tableName),
+				Key:       oldKey,
+			})
+			if err != nil {
+				return fmt.Errorf("Error deleting previous DynamoDB table item (%s): %s", d.Id(), err)
+			}
+		}
+
+		d.SetId(buildDynamoDbTableItemId(tableName, hashKey, rangeKey, newKey))
+	}
+
+	return resourceAwsDynamoDbTableItemRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableItemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).DynamoDB()
+
+	attributes, err := expandDynamoDbTableItemAttributes(d.Get("item").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := buildDynamoDbTableItemKey(attributes, d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB table item: %s", d.Id())
+	_, err = conn.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(d.Get("table_name").(string)),
+		Key:       key,
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB table item (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func buildDynamoDbTableItemKey(attributes map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (map[string]*dynamodb.AttributeValue, error) {
+	key := make(map[string]*dynamodb.AttributeValue)
+
+	hashValue, ok := attributes[hashKey]
+	if !ok {
+		return nil, fmt.Errorf("Item does not contain the hash key %q", hashKey)
+	}
+	key[hashKey] = hashValue
+
+	if rangeKey != "" {
+		rangeValue, ok := attributes[rangeKey]
+		if !ok {
+			return nil, fmt.Errorf("Item does not contain the range key %q", rangeKey)
+		}
+		key[rangeKey] = rangeValue
+	}
+
+	return key, nil
+}
+
+// buildDynamoDbTableItemId joins the table name, key names and key values.
+// Key values are base64 encoded as they may contain the separator.
+func buildDynamoDbTableItemId(tableName, hashKey, rangeKey string, key map[string]*dynamodb.AttributeValue) string {
+	parts := []string{tableName, hashKey, rangeKey}
+
+	keyNames := []string{hashKey}
+	if rangeKey != "" {
+		keyNames = append(keyNames, rangeKey)
+	}
+	for _, name := range keyNames {
+		var value []byte
+		if v := key[name]; v != nil {
+			switch {
+			case v.B != nil:
+				value = v.B
+			case v.N != nil:
+				value = []byte(aws.StringValue(v.N))
+			default:
+				value = []byte(aws.StringValue(v.S))
+			}
+		}
+		parts = append(parts, base64.RawStdEncoding.EncodeToString(value))
+	}
+
+	return strings.Join(parts, "|")
+}
+
+// buildDynamoDbProjectionExpression uses placeholders for every attribute
+// name so that reserved words and special characters can be projected.
+func buildDynamoDbProjectionExpression(attributes map[string]*dynamodb.AttributeValue) (*string, map[string]*string) {
+	names := make(map[string]*string, len(attributes))
+	placeholders := make([]string, 0, len(attributes))
+
+	i := 0
+	for name := range attributes {
+		placeholder := fmt.Sprintf("#a%d", i)
+		names[placeholder] = aws.String(name)
+		placeholders = append(placeholders, placeholder)
+		i++
+	}
+
+	return aws.String(strings.Join(placeholders, ",")), names
+}
+
+// expandDynamoDbTableItemAttributes parses an item in DynamoDB JSON, e.g.
+// {"id": {"S": "one"}, "count": {"N": "1"}}.
+func expandDynamoDbTableItemAttributes(input string) (map[string]*dynamodb.AttributeValue, error) {
+	var attributes map[string]*dynamodb.AttributeValue
+
+	if err := json.Unmarshal([]byte(input), &attributes); err != nil {
+		return nil, fmt.Errorf("Error decoding DynamoDB item JSON: %s", err)
+	}
+
+	return attributes, nil
+}
+
+func flattenDynamoDbTableItemAttributes(attributes map[string]*dynamodb.AttributeValue) (string, error) {
+	m := make(map[string]interface{}, len(attributes))
+	for name, value := range attributes {
+		m[name] = flattenDynamoDbAttributeValue(value)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("Error encoding DynamoDB item JSON: %s", err)
+	}
+
+	return string(b), nil
+}
+
+// flattenDynamoDbAttributeValue omits the unset members of the value, which
+// json.Marshal would otherwise render as null.
+func flattenDynamoDbAttributeValue(value *dynamodb.AttributeValue) map[string]interface{} {
+	m := make(map[string]interface{})
+	if value == nil {
+		return m
+	}
+
+	if value.B != nil {
+		m["B"] = value.B
+	}
+	if value.BOOL != nil {
+		m["BOOL"] = aws.BoolValue(value.BOOL)
+	}
+	if value.BS != nil {
+		m["BS"] = value.BS
+	}
+	if value.L != nil {
+		l := make([]interface{}, 0, len(value.L))
+		for _, v := range value.L {
+			l = append(l, flattenDynamoDbAttributeValue(v))
+		}
+		m["L"] = l
+	}
+	if value.M != nil {
+		mm := make(map[string]interface{}, len(value.M))
+		for k, v := range value.M {
+			mm[k] = flattenDynamoDbAttributeValue(v)
+		}
+		m["M"] = mm
+	}
+	if value.N != nil {
+		m["N"] = aws.StringValue(value.N)
+	}
+	if value.NS != nil {
+		m["NS"] = aws.StringValueSlice(value.NS)
+	}
+	if value.NULL != nil {
+		m["NULL"] = aws.BoolValue(value.NULL)
+	}
+	if value.S != nil {
+		m["S"] = aws.StringValue(value.S)
+	}
+	if value.SS != nil {
+		m["SS"] = aws.StringValueSlice(value.SS)
+	}
+
+	return m
+}
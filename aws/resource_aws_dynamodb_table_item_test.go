@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFlattenDynamoDbTableItemAttributes(t *testing.T) {
+	items := []string{
+		`{"id":{"S":"one"}}`,
+		`{"count":{"N":"11"},"data":{"B":"dGVzdA=="},"id":{"S":"one"}}`,
+		`{"config":{"M":{"enabled":{"BOOL":true},"list":{"L":[{"NULL":true},{"NS":["1","2"]}]}}},"id":{"S":"one"}}`,
+	}
+
+	for _, item := range items {
+		attributes, err := expandDynamoDbTableItemAttributes(item)
+		if err != nil {
+			t.Fatalf("Error expanding %q: %s", item, err)
+		}
+
+		flattened, err := flattenDynamoDbTableItemAttributes(attributes)
+		if err != nil {
+			t.Fatalf("Error flattening %q: %s", item, err)
+		}
+
+		if flattened != item {
+			t.Fatalf("Expected %q, got %q", item, flattened)
+		}
+	}
+}
+
+func TestBuildDynamoDbTableItemId(t *testing.T) {
+	key := map[string]*dynamodb.AttributeValue{
+		"hashKey":  {S: aws.String("something|with|pipes")},
+		"rangeKey": {N: aws.String("12")},
+	}
+
+	id := buildDynamoDbTableItemId("table", "hashKey", "rangeKey", key)
+	expected := "table|hashKey|rangeKey|c29tZXRoaW5nfHdpdGh8cGlwZXM|MTI"
+	if id != expected {
+		t.Fatalf("Expected %q, got %q", expected, id)
+	}
+}
+
+func TestAccAWSDynamoDbTableItem_basic(t *testing.T) {
+	var conf dynamodb.GetItemOutput
+
+	tableName := acctest.RandomWithPrefix("tf-acc-test")
+	hashKey := "hashKey"
+	itemContent := `{
+	"hashKey": {"S": "something"},
+	"one": {"N": "11111"},
+	"two": {"N": "22222"},
+	"three": {"N": "33333"},
+	"four": {"N": "44444"}
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbItemConfigBasic(tableName, hashKey, itemContent),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemExists("aws_dynamodb_table_item.test", &conf),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 1),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "hash_key", hashKey),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "table_name", tableName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbTableItem_rangeKey(t *testing.T) {
+	var conf dynamodb.GetItemOutput
+
+	tableName := acctest.RandomWithPrefix("tf-acc-test")
+	hashKey := "hashKey"
+	rangeKey := "rangeKey"
+	itemContent := `{
+	"hashKey": {"S": "something"},
+	"rangeKey": {"S": "something-else"},
+	"one": {"N": "11111"}
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbItemConfigWithRangeKey(tableName, hashKey, rangeKey, itemContent),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemExists("aws_dynamodb_table_item.test", &conf),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 1),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "hash_key", hashKey),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "range_key", rangeKey),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbTableItem_update(t *testing.T) {
+	var conf dynamodb.GetItemOutput
+
+	tableName := acctest.RandomWithPrefix("tf-acc-test")
+	hashKey := "hashKey"
+
+	itemBefore := `{
+	"hashKey": {"S": "before"},
+	"value": {"S": "valueBefore"},
+	"extra": {"S": "extra"}
+}`
+	itemAfter := `{
+	"hashKey": {"S": "after"},
+	"value": {"S": "valueAfter"}
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbItemConfigBasic(tableName, hashKey, itemBefore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemExists("aws_dynamodb_table_item.test", &conf),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 1),
+				),
+			},
+			{
+				Config: testAccAWSDynamoDbItemConfigBasic(tableName, hashKey, itemAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemExists("aws_dynamodb_table_item.test", &conf),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbItemDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_item" {
+			continue
+		}
+
+		attributes, err := expandDynamoDbTableItemAttributes(rs.Primary.Attributes["item"])
+		if err != nil {
+			return err
+		}
+
+		key, err := buildDynamoDbTableItemKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"])
+		if err != nil {
+			return err
+		}
+
+		result, err := conn.GetItem(&dynamodb.GetItemInput{
+			TableName:      aws.String(rs.Primary.Attributes["table_name"]),
+			Key:            key,
+			ConsistentRead: aws.Bool(true),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		if len(result.Item) > 0 {
+			return fmt.Errorf("DynamoDB table item %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDynamoDbTableItemExists(n string, item *dynamodb.GetItemOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB table item ID specified!")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+
+		attributes, err := expandDynamoDbTableItemAttributes(rs.Primary.Attributes["item"])
+		if err != nil {
+			return err
+		}
+
+		key, err := buildDynamoDbTableItemKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"])
+		if err != nil {
+			return err
+		}
+
+		result, err := conn.GetItem(&dynamodb.GetItemInput{
+			TableName:      aws.String(rs.Primary.Attributes["table_name"]),
+			Key:            key,
+			ConsistentRead: aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("Problem getting DynamoDB table item '%s': %s", rs.Primary.ID, err)
+		}
+		if len(result.Item) == 0 {
+			return fmt.Errorf("DynamoDB table item '%s' not found", rs.Primary.ID)
+		}
+
+		*item = *result
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbTableItemCount(tableName string, count int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
+
+		out, err := conn.Scan(&dynamodb.ScanInput{
+			ConsistentRead: aws.Bool(true),
+			TableName:      aws.String(tableName),
+			Select:         aws.String(dynamodb.SelectCount),
+		})
+		if err != nil {
+			return err
+		}
+
+		if *out.Count != count {
+			return fmt.Errorf("Expected %d items, got %d", count, *out.Count)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDynamoDbItemConfigBasic(tableName, hashKey, item string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = "%s"
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "%s"
+
+  attribute {
+    name = "%s"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_item" "test" {
+  table_name = "${aws_dynamodb_table.test.name}"
+  hash_key   = "${aws_dynamodb_table.test.hash_key}"
+
+  item = <<ITEM
+%s
+ITEM
+}
+`, tableName, hashKey, hashKey, item)
+}
+
+func testAccAWSDynamoDbItemConfigWithRangeKey(tableName, hashKey, rangeKey, item string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = "%s"
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "%s"
+  range_key      = "%s"
+
+  attribute {
+    name = "%s"
+    type = "S"
+  }
+
+  attribute {
+    name = "%s"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_item" "test" {
+  table_name = "${aws_dynamodb_table.test.name}"
+  hash_key   = "${aws_dynamodb_table.test.hash_key}"
+  range_key  = "${aws_dynamodb_table.test.range_key}"
+
+  item = <<ITEM
+%s
+ITEM
+}
+`, tableName, hashKey, rangeKey, hashKey, rangeKey, item)
+}
@@ -137,6 +137,30 @@ func TestAccAWSDynamoDbTable_ttl(t *testing.T) {
 		},
 	})
 }
+func TestAccAWSDynamoDbTable_restoreFromBackup(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+
+	rName := acctest.RandomWithPrefix("TerraformTestTable")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbConfigRestoreFromBackup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists("aws_dynamodb_table.restored", &conf),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "name", rName+"-restored"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "hash_key", "TestTableHashKey"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "read_capacity", "2"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "write_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDynamoDbTableTimeToLiveWasUpdated(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		log.Printf("[DEBUG] Trying to create initial table state!")
@@ -149,7 +173,7 @@ func testAccCheckDynamoDbTableTimeToLiveWasUpdated(n string) resource.TestCheckF
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
 
 		params := &dynamodb.DescribeTimeToLiveInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -218,7 +242,7 @@ func TestResourceAWSDynamoDbTableStreamViewType_validation(t *testing.T) {
 }
 
 func testAccCheckAWSDynamoDbTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table" {
@@ -259,7 +283,7 @@ func testAccCheckInitialAWSDynamoDbTableExists(n string, table *dynamodb.Describ
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -289,7 +313,7 @@ func testAccCheckInitialAWSDynamoDbTableConf(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -358,7 +382,7 @@ func testAccCheckDynamoDbTableWasUpdated(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).DynamoDB()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -797,3 +821,37 @@ resource "aws_dynamodb_table" "basic-dynamodb-table" {
 }
 `, rName)
 }
+
+func testAccAWSDynamoDbConfigRestoreFromBackup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "source" {
+  name           = "%[1]s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_backup" "source" {
+  name       = "%[1]s"
+  table_name = "${aws_dynamodb_table.source.name}"
+}
+
+resource "aws_dynamodb_table" "restored" {
+  name                    = "%[1]s-restored"
+  read_capacity           = 2
+  write_capacity          = 2
+  hash_key                = "TestTableHashKey"
+  restore_from_backup_arn = "${aws_dynamodb_table_backup.source.arn}"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+`, rName)
+}
@@ -18,6 +18,8 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 		Read:   resourceAwsEbsSnapshotRead,
 		Delete: resourceAwsEbsSnapshotDelete,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -59,12 +61,17 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
 
 func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	request := &ec2.CreateSnapshotInput{
 		VolumeId: aws.String(d.Get("volume_id").(string)),
@@ -93,7 +100,7 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	req := &ec2.DescribeSnapshotsInput{
 		SnapshotIds: []*string{aws.String(d.Id())},
@@ -116,7 +123,7 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("kms_keey_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMap(snapshot.Tags)); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
@@ -124,7 +131,7 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEbsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		request := &ec2.DeleteSnapshotInput{
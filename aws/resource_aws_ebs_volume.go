@@ -25,6 +25,8 @@ func resourceAwsEbsVolume() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -69,13 +71,14 @@ func resourceAwsEbsVolume() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	request := &ec2.CreateVolumeInput{
 		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
@@ -139,7 +142,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 		}
@@ -149,8 +152,8 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
+	conn := meta.(*AWSClient).EC2()
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 		}
@@ -228,7 +231,7 @@ func volumeStateRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefres
 }
 
 func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	request := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(d.Id())},
@@ -247,7 +250,7 @@ func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		request := &ec2.DeleteVolumeInput{
@@ -276,10 +279,10 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 	d.SetId(*volume.VolumeId)
 
 	arn := arn.ARN{
-		Partition: client.partition,
+		Partition: client.Partition(),
 		Region:    client.region,
 		Service:   "ec2",
-		AccountID: client.accountid,
+		AccountID: client.AccountID(),
 		Resource:  fmt.Sprintf("volume/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
@@ -311,7 +314,7 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 		}
 	}
 
-	d.Set("tags", tagsToMap(volume.Tags))
+	setTagsAll(d, client, tagsToMap(volume.Tags))
 
 	return nil
 }
@@ -34,7 +34,7 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 }
 
 func resourceAwsEcrLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ECR()
 
 	input := &ecr.PutLifecyclePolicyInput{
 		RepositoryName:      aws.String(d.Get("repository").(string)),
@@ -51,7 +51,7 @@ func resourceAwsEcrLifecyclePolicyCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ECR()
 
 	input := &ecr.GetLifecyclePolicyInput{
 		RegistryId:     aws.String(d.Get("registry_id").(string)),
@@ -76,7 +76,7 @@ func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEcrLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ECR()
 
 	input := &ecr.DeleteLifecyclePolicyInput{
 		RegistryId:     aws.String(d.Get("registry_id").(string)),
@@ -32,7 +32,7 @@ func TestAccAWSEcrLifecyclePolicy_basic(t *testing.T) {
 }
 
 func testAccCheckAWSEcrLifecyclePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ECR()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_lifecycle_policy" {
@@ -31,7 +31,7 @@ func TestAccAWSEcrRepository_basic(t *testing.T) {
 }
 
 func testAccCheckAWSEcrRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ECR()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_repository" {
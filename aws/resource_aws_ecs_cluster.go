@@ -34,7 +34,7 @@ func resourceAwsEcsCluster() *schema.Resource {
 }
 
 func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ECS()
 
 	clusterName := d.Get("name").(string)
 	log.Printf("[DEBUG] Creating ECS cluster %s", clusterName)
@@ -54,7 +54,7 @@ func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ECS()
 
 	clusterName := d.Get("name").(string)
 	log.Printf("[DEBUG] Reading ECS cluster %s", clusterName)
@@ -88,7 +88,7 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEcsClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ECS()
 
 	log.Printf("[DEBUG] Deleting ECS cluster %s", d.Id())
 
@@ -30,7 +30,7 @@ func TestAccAWSEcsCluster_basic(t *testing.T) {
 }
 
 func testAccCheckAWSEcsClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ECS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_cluster" {
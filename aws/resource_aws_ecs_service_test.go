@@ -411,7 +411,7 @@ func TestAccAWSEcsServiceWithNetworkConfiguration(t *testing.T) {
 }
 
 func testAccCheckAWSEcsServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ECS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_service" {
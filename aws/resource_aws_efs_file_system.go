@@ -24,6 +24,8 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"creation_token": {
 				Type:         schema.TypeString,
@@ -69,13 +71,14 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).EFS()
 
 	creationToken := ""
 	if v, ok := d.GetOk("creation_token"); ok {
@@ -156,7 +159,7 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).EFS()
 	err := setTagsEFS(conn, d)
 	if err != nil {
 		return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
@@ -167,7 +170,7 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).EFS()
 
 	resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 		FileSystemId: aws.String(d.Id()),
@@ -212,7 +215,7 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = setTagsAll(d, meta, tagsToMapEFS(tags))
 	if err != nil {
 		return err
 	}
@@ -245,7 +248,7 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsEfsFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).EFS()
 
 	log.Printf("[DEBUG] Deleting EFS file system: %s", d.Id())
 	_, err := conn.DeleteFileSystem(&efs.DeleteFileSystemInput{
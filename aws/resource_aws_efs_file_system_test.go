@@ -212,7 +212,7 @@ func TestAccAWSEFSFileSystem_kmsConfigurationWithoutEncryption(t *testing.T) {
 }
 
 func testAccCheckEfsFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).EFS()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_file_system" {
 			continue
@@ -247,7 +247,7 @@ func testAccCheckEfsFileSystem(resourceID string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).EFS()
 		_, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -271,7 +271,7 @@ func testAccCheckEfsCreationToken(resourceID string, expectedToken string) resou
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).EFS()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -301,7 +301,7 @@ func testAccCheckEfsFileSystemTags(resourceID string, expectedTags map[string]st
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).EFS()
 		resp, err := conn.DescribeTags(&efs.DescribeTagsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -330,7 +330,7 @@ func testAccCheckEfsFileSystemPerformanceMode(resourceID string, expectedMode st
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).EFS()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -28,7 +28,7 @@ func TestAccAWSEgressOnlyInternetGateway_basic(t *testing.T) {
 }
 
 func testAccCheckAWSEgressOnlyInternetGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_egress_only_internet_gateway" {
@@ -63,7 +63,7 @@ func testAccCheckAWSEgressOnlyInternetGatewayExists(n string, igw *ec2.EgressOnl
 			return fmt.Errorf("No Egress Only IGW ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		resp, err := conn.DescribeEgressOnlyInternetGateways(&ec2.DescribeEgressOnlyInternetGatewaysInput{
 			EgressOnlyInternetGatewayIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -24,6 +24,8 @@ func resourceAwsEip() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -74,13 +76,14 @@ func resourceAwsEip() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).EC2()
 
 	// By default, we're not in a VPC
 	domainOpt := ""
@@ -114,7 +117,7 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] EIP ID: %s (domain: %v)", d.Id(), *allocResp.Domain)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(ec2conn, d); err != nil {
 			return fmt.Errorf("Error creating EIP tags: %s", err)
 		}
@@ -124,7 +127,7 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).EC2()
 
 	domain := resourceAwsEipDomain(d)
 	id := d.Id()
@@ -215,13 +218,13 @@ func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 		d.SetId(*address.AllocationId)
 	}
 
-	d.Set("tags", tagsToMap(address.Tags))
+	setTagsAll(d, meta, tagsToMap(address.Tags))
 
 	return nil
 }
 
 func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).EC2()
 
 	domain := resourceAwsEipDomain(d)
 
@@ -281,7 +284,7 @@ func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(ec2conn, d); err != nil {
 			return fmt.Errorf("Error updating EIP tags: %s", err)
 		}
@@ -291,7 +294,7 @@ func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEipDelete(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).EC2()
 
 	if err := resourceAwsEipRead(d, meta); err != nil {
 		return err
@@ -350,7 +353,7 @@ func resourceAwsEipDomain(d *schema.ResourceData) string {
 }
 
 func disassociateEip(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).EC2()
 	log.Printf("[DEBUG] Disassociating EIP: %s", d.Id())
 	var err error
 	switch resourceAwsEipDomain(d) {
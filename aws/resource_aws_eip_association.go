@@ -63,7 +63,7 @@ func resourceAwsEipAssociation() *schema.Resource {
 }
 
 func resourceAwsEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	request := &ec2.AssociateAddressInput{}
 
@@ -99,7 +99,7 @@ func resourceAwsEipAssociationCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] EIP Assoc Response: %s", resp)
 
-	supportedPlatforms := meta.(*AWSClient).supportedplatforms
+	supportedPlatforms := meta.(*AWSClient).SupportedPlatforms()
 	if len(supportedPlatforms) > 0 && !hasEc2Classic(supportedPlatforms) && resp.AssociationId == nil {
 		// We expect no association ID in EC2 Classic
 		// but still error out if ID is missing and we _know_ it's NOT EC2 Classic
@@ -118,9 +118,9 @@ func resourceAwsEipAssociationCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsEipAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
-	request, err := describeAddressesById(d.Id(), meta.(*AWSClient).supportedplatforms)
+	request, err := describeAddressesById(d.Id(), meta.(*AWSClient).SupportedPlatforms())
 	if err != nil {
 		return err
 	}
@@ -140,13 +140,13 @@ func resourceAwsEipAssociationRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	var opts *ec2.DisassociateAddressInput
 	// We assume EC2 Classic if ID is a valid IPv4 address
 	ip := net.ParseIP(d.Id())
 	if ip != nil {
-		supportedPlatforms := meta.(*AWSClient).supportedplatforms
+		supportedPlatforms := meta.(*AWSClient).SupportedPlatforms()
 		if len(supportedPlatforms) > 0 && !hasEc2Classic(supportedPlatforms) {
 			return fmt.Errorf("Received IPv4 address as ID in account that doesn't support EC2 Classic (%q)",
 				supportedPlatforms)
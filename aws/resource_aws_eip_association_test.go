@@ -91,7 +91,7 @@ func TestAccAWSEIPAssociation_disappears(t *testing.T) {
 
 func testAccCheckEIPAssociationDisappears(address *ec2.Address) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		opts := &ec2.DisassociateAddressInput{
 			AssociationId: address.AssociationId,
 		}
@@ -113,8 +113,8 @@ func testAccCheckAWSEIPAssociationExists(name string, res *ec2.Address) resource
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
-		platforms := testAccProvider.Meta().(*AWSClient).supportedplatforms
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
+		platforms := testAccProvider.Meta().(*AWSClient).SupportedPlatforms()
 
 		request, err := describeAddressesById(rs.Primary.ID, platforms)
 		if err != nil {
@@ -164,7 +164,7 @@ func testAccCheckAWSEIPAssociationDestroy(s *terraform.State) error {
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		request := &ec2.DescribeAddressesInput{
 			Filters: []*ec2.Filter{
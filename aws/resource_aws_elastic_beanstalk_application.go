@@ -39,7 +39,7 @@ func resourceAwsElasticBeanstalkApplication() *schema.Resource {
 }
 
 func resourceAwsElasticBeanstalkApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn
+	beanstalkConn := meta.(*AWSClient).ElasticBeanstalk()
 
 	// Get the name and description
 	name := d.Get("name").(string)
@@ -63,7 +63,7 @@ func resourceAwsElasticBeanstalkApplicationCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsElasticBeanstalkApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn
+	beanstalkConn := meta.(*AWSClient).ElasticBeanstalk()
 
 	if d.HasChange("description") {
 		if err := resourceAwsElasticBeanstalkApplicationDescriptionUpdate(beanstalkConn, d); err != nil {
@@ -103,7 +103,7 @@ func resourceAwsElasticBeanstalkApplicationRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticBeanstalkApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn
+	beanstalkConn := meta.(*AWSClient).ElasticBeanstalk()
 
 	a, err := getBeanstalkApplication(d, meta)
 	if err != nil {
@@ -128,7 +128,7 @@ func resourceAwsElasticBeanstalkApplicationDelete(d *schema.ResourceData, meta i
 func getBeanstalkApplication(
 	d *schema.ResourceData,
 	meta interface{}) (*elasticbeanstalk.ApplicationDescription, error) {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).ElasticBeanstalk()
 
 	resp, err := conn.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{
 		ApplicationNames: []*string{aws.String(d.Id())},
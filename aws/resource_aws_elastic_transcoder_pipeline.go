@@ -188,7 +188,7 @@ func resourceAwsElasticTranscoderPipeline() *schema.Resource {
 }
 
 func resourceAwsElasticTranscoderPipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	req := &elastictranscoder.CreatePipelineInput{
 		AwsKmsKeyArn:    getStringPtr(d, "aws_kms_key_arn"),
@@ -348,7 +348,7 @@ func flattenETPermList(perms []*elastictranscoder.Permission) []map[string]inter
 }
 
 func resourceAwsElasticTranscoderPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	req := &elastictranscoder.UpdatePipelineInput{
 		Id: aws.String(d.Id()),
@@ -396,7 +396,7 @@ func resourceAwsElasticTranscoderPipelineUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticTranscoderPipelineRead(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	resp, err := elastictranscoderconn.ReadPipeline(&elastictranscoder.ReadPipelineInput{
 		Id: aws.String(d.Id()),
@@ -468,7 +468,7 @@ func resourceAwsElasticTranscoderPipelineRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsElasticTranscoderPipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	log.Printf("[DEBUG] Elastic Transcoder Delete Pipeline: %s", d.Id())
 	_, err := elastictranscoderconn.DeletePipeline(&elastictranscoder.DeletePipelineInput{
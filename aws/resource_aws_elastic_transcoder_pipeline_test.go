@@ -182,7 +182,7 @@ func testAccCheckAWSElasticTranscoderPipelineExists(n string, res *elastictransc
 			return fmt.Errorf("No Pipeline ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+		conn := testAccProvider.Meta().(*AWSClient).ElasticTranscoder()
 
 		out, err := conn.ReadPipeline(&elastictranscoder.ReadPipelineInput{
 			Id: aws.String(rs.Primary.ID),
@@ -199,7 +199,7 @@ func testAccCheckAWSElasticTranscoderPipelineExists(n string, res *elastictransc
 }
 
 func testAccCheckElasticTranscoderPipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+	conn := testAccProvider.Meta().(*AWSClient).ElasticTranscoder()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastictranscoder_pipline" {
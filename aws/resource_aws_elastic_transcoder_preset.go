@@ -317,7 +317,7 @@ func resourceAwsElasticTranscoderPreset() *schema.Resource {
 }
 
 func resourceAwsElasticTranscoderPresetCreate(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	req := &elastictranscoder.CreatePresetInput{
 		Audio:       expandETAudioParams(d),
@@ -468,7 +468,7 @@ func expandETVideoWatermarks(d *schema.ResourceData) []*elastictranscoder.Preset
 }
 
 func resourceAwsElasticTranscoderPresetRead(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	resp, err := elastictranscoderconn.ReadPreset(&elastictranscoder.ReadPresetInput{
 		Id: aws.String(d.Id()),
@@ -624,7 +624,7 @@ func flattenETWatermarks(watermarks []*elastictranscoder.PresetWatermark) []map[
 }
 
 func resourceAwsElasticTranscoderPresetDelete(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).ElasticTranscoder()
 
 	log.Printf("[DEBUG] Elastic Transcoder Delete Preset: %s", d.Id())
 	_, err := elastictranscoderconn.DeletePreset(&elastictranscoder.DeletePresetInput{
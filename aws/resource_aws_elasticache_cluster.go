@@ -128,7 +128,8 @@ func resourceAwsElastiCacheCommonSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 
-		"tags": tagsSchema(),
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaAll(),
 	}
 }
 
@@ -216,11 +217,13 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 		},
 
 		Schema: resourceSchema,
+
+		CustomizeDiff: setTagsAllDiff,
 	}
 }
 
 func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	clusterId := d.Get("cluster_id").(string)
 	nodeType := d.Get("node_type").(string)           // e.g) cache.m1.small
@@ -234,7 +237,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 	securityNames := expandStringList(securityNameSet.List())
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 
 	req := &elasticache.CreateCacheClusterInput{
 		CacheClusterId:          aws.String(clusterId),
@@ -330,7 +333,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Id()),
 		ShowCacheNodeInfo: aws.Bool(true),
@@ -390,7 +393,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 		}
 		// list tags for resource
 		// set tags
-		arn, err := buildECARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+		arn, err := buildECARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region)
 		if err != nil {
 			log.Printf("[DEBUG] Error building ARN for ElastiCache Cluster, not setting Tags for cluster %s", *c.CacheClusterId)
 		} else {
@@ -406,7 +409,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 			if len(resp.TagList) > 0 {
 				et = resp.TagList
 			}
-			d.Set("tags", tagsToMapEC(et))
+			setTagsAll(d, meta, tagsToMapEC(et))
 		}
 	}
 
@@ -414,8 +417,8 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
-	arn, err := buildECARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+	conn := meta.(*AWSClient).ElastiCache()
+	arn, err := buildECARN(d.Id(), meta.(*AWSClient).Partition(), meta.(*AWSClient).AccountID(), meta.(*AWSClient).region)
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for ElastiCache Cluster, not updating Tags for cluster %s", d.Id())
 	} else {
@@ -569,7 +572,7 @@ func (b byCacheNodeId) Less(i, j int) bool {
 }
 
 func resourceAwsElasticacheClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	req := &elasticache.DeleteCacheClusterInput{
 		CacheClusterId: aws.String(d.Id()),
@@ -67,7 +67,7 @@ func resourceAwsElasticacheParameterGroup() *schema.Resource {
 }
 
 func resourceAwsElasticacheParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	createOpts := elasticache.CreateCacheParameterGroupInput{
 		CacheParameterGroupName:   aws.String(d.Get("name").(string)),
@@ -94,7 +94,7 @@ func resourceAwsElasticacheParameterGroupCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticacheParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	describeOpts := elasticache.DescribeCacheParameterGroupsInput{
 		CacheParameterGroupName: aws.String(d.Id()),
@@ -131,7 +131,7 @@ func resourceAwsElasticacheParameterGroupRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsElasticacheParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	d.Partial(true)
 
@@ -221,7 +221,7 @@ func resourceAwsElasticacheParameterGroupUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticacheParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		deleteOpts := elasticache.DeleteCacheParameterGroupInput{
@@ -119,13 +119,15 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 		},
 
 		Schema: resourceSchema,
+
+		CustomizeDiff: setTagsAllDiff,
 	}
 }
 
 func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 	params := &elasticache.CreateReplicationGroupInput{
 		ReplicationGroupId:          aws.String(d.Get("replication_group_id").(string)),
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
@@ -253,7 +255,7 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 	req := &elasticache.DescribeReplicationGroupsInput{
 		ReplicationGroupId: aws.String(d.Id()),
 	}
@@ -359,7 +361,7 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	requestUpdate := false
 	params := &elasticache.ModifyReplicationGroupInput{
@@ -463,7 +465,7 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsElasticacheReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).ElastiCache()
 
 	req := &elasticache.DeleteReplicationGroupInput{
 		ReplicationGroupId: aws.String(d.Id()),
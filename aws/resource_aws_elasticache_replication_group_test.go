@@ -456,7 +456,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 			return fmt.Errorf("No replication group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).ElastiCache()
 		res, err := conn.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{
 			ReplicationGroupId: aws.String(rs.Primary.ID),
 		})
@@ -475,7 +475,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 }
 
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).ElastiCache()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_replication_group" {
@@ -25,6 +25,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			State: resourceAwsElasticSearchDomainImport,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:             schema.TypeString,
@@ -208,7 +210,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -225,7 +228,7 @@ func createAwsElasticsearchIAMServiceRoleIfMissing(meta interface{}) error {
 	serviceRoleName := "AWSServiceRoleForAmazonElasticsearchService"
 	serviceName := "es.amazonaws.com"
 
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 
 	getRequest := &iam.GetRoleInput{
 		RoleName: aws.String(serviceRoleName),
@@ -251,7 +254,7 @@ func createAwsElasticsearchIAMServiceRoleIfMissing(meta interface{}) error {
 }
 
 func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).ElasticsearchService()
 
 	// The API doesn't check for duplicate names
 	// so w/out this check Create would act as upsert
@@ -383,14 +386,15 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapElasticsearchService(d.Get("tags_all").(map[string]interface{}))
 
 	if err := setTagsElasticsearchService(conn, d, *out.DomainStatus.ARN); err != nil {
 		return err
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(tags))
+	setTagsAll(d, meta, tagsToMapElasticsearchService(tags))
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
 	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
@@ -423,7 +427,7 @@ func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService
 }
 
 func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).ElasticsearchService()
 
 	out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -520,13 +524,13 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		est = listOut.TagList
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(est))
+	setTagsAll(d, meta, tagsToMapElasticsearchService(est))
 
 	return nil
 }
 
 func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).ElasticsearchService()
 
 	d.Partial(true)
 
@@ -535,6 +539,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -635,7 +640,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).ElasticsearchService()
 
 	log.Printf("[DEBUG] Deleting ElasticSearch domain: %q", d.Get("domain_name").(string))
 	_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
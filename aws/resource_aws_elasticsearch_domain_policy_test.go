@@ -55,7 +55,7 @@ func TestAccAWSElasticSearchDomainPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_elasticsearch_domain.example", "elasticsearch_version", "2.3"),
 					func(s *terraform.State) error {
 						awsClient := testAccProvider.Meta().(*AWSClient)
-						expectedArn, err := buildESDomainArn(name, awsClient.partition, awsClient.accountid, awsClient.region)
+						expectedArn, err := buildESDomainArn(name, awsClient.Partition(), awsClient.AccountID(), awsClient.region)
 						if err != nil {
 							return err
 						}
@@ -43,7 +43,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		CheckDestroy: func(s *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).esconn
+			conn := testAccProvider.Meta().(*AWSClient).ElasticsearchService()
 			_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 				DomainName: aws.String(name),
 			})
@@ -53,7 +53,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Create duplicate
-					conn := testAccProvider.Meta().(*AWSClient).esconn
+					conn := testAccProvider.Meta().(*AWSClient).ElasticsearchService()
 					_, err := conn.CreateElasticsearchDomain(&elasticsearch.CreateElasticsearchDomainInput{
 						DomainName: aws.String(name),
 						EBSOptions: &elasticsearch.EBSOptions{
@@ -341,7 +341,7 @@ func testAccCheckESNumberOfInstances(numberOfInstances int, status *elasticsearc
 
 func testAccLoadESTags(conf *elasticsearch.ElasticsearchDomainStatus, td *elasticsearch.ListTagsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).ElasticsearchService()
 
 		describe, err := conn.ListTags(&elasticsearch.ListTagsInput{
 			ARN: conf.ARN,
@@ -368,7 +368,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 			return fmt.Errorf("No ES Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).ElasticsearchService()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -390,7 +390,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).ElasticsearchService()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
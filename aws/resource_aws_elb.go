@@ -29,6 +29,8 @@ func resourceAwsElb() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -253,13 +255,14 @@ func resourceAwsElb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	// Expand the "listener" set to aws-sdk-go compat []*elb.Listener
 	listeners, err := expandListeners(d.Get("listener").(*schema.Set).List())
@@ -279,7 +282,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("name", elbName)
 	}
 
-	tags := tagsFromMapELB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapELB(d.Get("tags_all").(map[string]interface{}))
 	// Provision the elb
 	elbOpts := &elb.CreateLoadBalancerInput{
 		LoadBalancerName: aws.String(elbName),
@@ -337,20 +340,20 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("security_groups")
 	d.SetPartial("subnets")
 
-	d.Set("tags", tagsToMapELB(tags))
+	setTagsAll(d, meta, tagsToMapELB(tags))
 
 	return resourceAwsElbUpdate(d, meta)
 }
 
 func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 	elbName := d.Id()
 
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
+		Partition: meta.(*AWSClient).Partition(),
 		Region:    meta.(*AWSClient).region,
 		Service:   "elasticloadbalancing",
-		AccountID: meta.(*AWSClient).accountid,
+		AccountID: meta.(*AWSClient).AccountID(),
 		Resource:  fmt.Sprintf("loadbalancer/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
@@ -374,7 +377,25 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to find ELB: %#v", describeResp.LoadBalancerDescriptions)
 	}
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, describeResp.LoadBalancerDescriptions[0])
+	if err := flattenAwsELbResource(d, meta.(*AWSClient).EC2(), elbconn, describeResp.LoadBalancerDescriptions[0]); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsElbTags(elbconn, d.Id())
+	if err != nil {
+		return err
+	}
+	return setTagsAll(d, meta, tags)
+}
+
+// describeAwsElbTags returns the tags of the named ELB.
+func describeAwsElbTags(elbconn *elb.ELB, name string) (map[string]string, error) {
+	tags, err := elbTagsAdapter{conn: elbconn, name: name}.ListTags()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving ELB tags: %s", err)
+	}
+
+	return tags.Map(), nil
 }
 
 // flattenAwsELbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
@@ -453,16 +474,6 @@ func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *el
 		}
 	}
 
-	resp, err := elbconn.DescribeTags(&elb.DescribeTagsInput{
-		LoadBalancerNames: []*string{lb.LoadBalancerName},
-	})
-
-	var et []*elb.Tag
-	if len(resp.TagDescriptions) > 0 {
-		et = resp.TagDescriptions[0].Tags
-	}
-	d.Set("tags", tagsToMapELB(et))
-
 	// There's only one health check, so save that to state as we
 	// currently can
 	if *lb.HealthCheck.Target != "" {
@@ -473,7 +484,7 @@ func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *el
 }
 
 func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	d.Partial(true)
 
@@ -797,13 +808,14 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	return resourceAwsElbRead(d, meta)
 }
 
 func resourceAwsElbDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	log.Printf("[INFO] Deleting ELB: %s", d.Id())
 
@@ -817,7 +829,7 @@ func resourceAwsElbDelete(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 
-	err := cleanupELBNetworkInterfaces(meta.(*AWSClient).ec2conn, name)
+	err := cleanupELBNetworkInterfaces(meta.(*AWSClient).EC2(), name)
 	if err != nil {
 		log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %#v", name, err)
 	}
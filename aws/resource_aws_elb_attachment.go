@@ -33,7 +33,7 @@ func resourceAwsElbAttachment() *schema.Resource {
 }
 
 func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 	elbName := d.Get("elb").(string)
 
 	instance := d.Get("instance").(string)
@@ -56,7 +56,7 @@ func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsElbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 	elbName := d.Get("elb").(string)
 
 	// only add the instance that was previously defined for this resource
@@ -100,7 +100,7 @@ func resourceAwsElbAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsElbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 	elbName := d.Get("elb").(string)
 
 	instance := d.Get("instance").(string)
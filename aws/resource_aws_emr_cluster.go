@@ -23,6 +23,9 @@ func resourceAwsEMRCluster() *schema.Resource {
 		Read:   resourceAwsEMRClusterRead,
 		Update: resourceAwsEMRClusterUpdate,
 		Delete: resourceAwsEMRClusterDelete,
+
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -214,7 +217,8 @@ func resourceAwsEMRCluster() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 			"configurations": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -250,7 +254,7 @@ func resourceAwsEMRCluster() *schema.Resource {
 }
 
 func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 
 	log.Printf("[DEBUG] Creating EMR cluster")
 	applications := d.Get("applications").(*schema.Set).List()
@@ -366,7 +370,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		bootstrapActions := v.(*schema.Set).List()
 		params.BootstrapActions = expandBootstrapActions(bootstrapActions)
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tagsIn := v.(map[string]interface{})
 		params.Tags = expandTags(tagsIn)
 	}
@@ -406,7 +410,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
-	emrconn := meta.(*AWSClient).emrconn
+	emrconn := meta.(*AWSClient).EMR()
 
 	req := &emr.DescribeClusterInput{
 		ClusterId: aws.String(d.Id()),
@@ -460,7 +464,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
 	d.Set("visible_to_all_users", cluster.VisibleToAllUsers)
-	d.Set("tags", tagsToMapEMR(cluster.Tags))
+	setTagsAll(d, meta, tagsToMapEMR(cluster.Tags))
 	d.Set("ebs_root_volume_size", cluster.EbsRootVolumeSize)
 
 	if err := d.Set("applications", flattenApplications(cluster.Applications)); err != nil {
@@ -492,7 +496,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 
 	d.Partial(true)
 
@@ -572,6 +576,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -580,7 +585,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEMRClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 
 	req := &emr.TerminateJobFlowsInput{
 		JobFlowIds: []*string{
@@ -746,7 +751,7 @@ func flattenBootstrapArguments(actions []*emr.Command) []map[string]interface{}
 }
 
 func loadGroups(d *schema.ResourceData, meta interface{}) ([]*emr.InstanceGroup, error) {
-	emrconn := meta.(*AWSClient).emrconn
+	emrconn := meta.(*AWSClient).EMR()
 	reqGrps := &emr.ListInstanceGroupsInput{
 		ClusterId: aws.String(d.Id()),
 	}
@@ -769,83 +774,70 @@ func findGroup(grps []*emr.InstanceGroup, typ string) *emr.InstanceGroup {
 	return nil
 }
 
-func expandTags(m map[string]interface{}) []*emr.Tag {
-	var result []*emr.Tag
-	for k, v := range m {
-		result = append(result, &emr.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+// emrTagsAdapter updates the tags of an EMR cluster.
+type emrTagsAdapter struct {
+	conn      *emr.EMR
+	clusterId string
+}
+
+func (a emrTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.DescribeCluster(&emr.DescribeClusterInput{
+		ClusterId: aws.String(a.clusterId),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return result
+	return newKeyValueTagsEMR(resp.Cluster.Tags).IgnoreAws(), nil
 }
 
-func tagsToMapEMR(ts []*emr.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
+func (a emrTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTags(&emr.AddTagsInput{
+		ResourceId: aws.String(a.clusterId),
+		Tags:       tags.EMRTags(),
+	})
+	return err
+}
 
-	return result
+func (a emrTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTags(&emr.RemoveTagsInput{
+		ResourceId: aws.String(a.clusterId),
+		TagKeys:    aws.StringSlice(keys),
+	})
+	return err
 }
 
-func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+func newKeyValueTagsEMR(ts []*emr.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	// Build the list of what to remove
-	var remove []*emr.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+	return tags
+}
+
+func (tags keyValueTags) EMRTags() []*emr.Tag {
+	var result []*emr.Tag
+	for _, k := range tags.Keys() {
+		result = append(result, &emr.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
-	return expandTags(create), remove
+	return result
 }
 
-func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+func expandTags(m map[string]interface{}) []*emr.Tag {
+	return newKeyValueTags(m).IgnoreAws().EMRTags()
+}
 
-			_, err := conn.RemoveTags(&emr.RemoveTagsInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeys:    k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTags(&emr.AddTagsInput{
-				ResourceId: aws.String(d.Id()),
-				Tags:       create,
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
+func tagsToMapEMR(ts []*emr.Tag) map[string]string {
+	return newKeyValueTagsEMR(ts).IgnoreAws().Map()
+}
 
-	return nil
+func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
+	return updateTagsAll(d, emrTagsAdapter{conn: conn, clusterId: d.Id()})
 }
 
 func expandBootstrapActions(bootstrapActions []interface{}) []*emr.BootstrapActionConfig {
@@ -976,7 +968,7 @@ func readBodyJson(body string, target interface{}) error {
 
 func resourceAwsEMRClusterStateRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).emrconn
+		conn := meta.(*AWSClient).EMR()
 
 		log.Printf("[INFO] Reading EMR Cluster Information: %s", d.Id())
 		params := &emr.DescribeClusterInput{
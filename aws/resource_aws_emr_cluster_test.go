@@ -257,7 +257,7 @@ func TestAccAWSEMRCluster_root_volume_size(t *testing.T) {
 func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
-		emrconn := testAccProvider.Meta().(*AWSClient).emrconn
+		emrconn := testAccProvider.Meta().(*AWSClient).EMR()
 		req := emr.ListBootstrapActionsInput{
 			ClusterId: cluster.Id,
 		}
@@ -294,7 +294,7 @@ func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []
 }
 
 func testAccCheckAWSEmrDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).emrconn
+	conn := testAccProvider.Meta().(*AWSClient).EMR()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_cluster" {
@@ -334,7 +334,7 @@ func testAccCheckAWSEmrClusterExists(n string, v *emr.Cluster) resource.TestChec
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No cluster id set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).emrconn
+		conn := testAccProvider.Meta().(*AWSClient).EMR()
 		describe, err := conn.DescribeCluster(&emr.DescribeClusterInput{
 			ClusterId: aws.String(rs.Primary.ID),
 		})
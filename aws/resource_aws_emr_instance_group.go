@@ -117,7 +117,7 @@ func readEmrEBSConfig(d *schema.ResourceData) *emr.EbsConfiguration {
 }
 
 func resourceAwsEMRInstanceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 
 	clusterId := d.Get("cluster_id").(string)
 	instanceType := d.Get("instance_type").(string)
@@ -155,7 +155,7 @@ func resourceAwsEMRInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEMRInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 	group, err := fetchEMRInstanceGroup(conn, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		switch err {
@@ -243,7 +243,7 @@ func fetchEMRInstanceGroup(conn *emr.EMR, clusterId, groupId string) (*emr.Insta
 }
 
 func resourceAwsEMRInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 
 	log.Printf("[DEBUG] Modify EMR task group")
 	instanceCount := d.Get("instance_count").(int)
@@ -298,7 +298,7 @@ func instanceGroupStateRefresh(conn *emr.EMR, clusterID, igID string) resource.S
 
 func resourceAwsEMRInstanceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARN] AWS EMR Instance Group does not support DELETE; resizing cluster to zero before removing from state")
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).EMR()
 	params := &emr.ModifyInstanceGroupsInput{
 		InstanceGroups: []*emr.InstanceGroupModifyConfig{
 			{
@@ -63,7 +63,7 @@ func resourceAwsFlowLog() *schema.Resource {
 }
 
 func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	types := []struct {
 		ID   string
@@ -113,7 +113,7 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	opts := &ec2.DescribeFlowLogsInput{
 		FlowLogIds: []*string{aws.String(d.Id())},
@@ -153,7 +153,7 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	log.Printf(
 		"[DEBUG] Flow Log Destroy: %s", d.Id())
@@ -25,6 +25,8 @@ func resourceAwsGlacierVault() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -83,13 +85,14 @@ func resourceAwsGlacierVault() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsGlacierVaultCreate(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).Glacier()
 
 	input := &glacier.CreateVaultInput{
 		VaultName: aws.String(d.Get("name").(string)),
@@ -107,7 +110,7 @@ func resourceAwsGlacierVaultCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlacierVaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).Glacier()
 
 	if err := setGlacierVaultTags(glacierconn, d); err != nil {
 		return err
@@ -129,7 +132,7 @@ func resourceAwsGlacierVaultUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).Glacier()
 
 	input := &glacier.DescribeVaultInput{
 		VaultName: aws.String(d.Id()),
@@ -144,7 +147,7 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", out.VaultName)
 	d.Set("arn", out.VaultARN)
 
-	location, err := buildGlacierVaultLocation(awsClient.accountid, d.Id())
+	location, err := buildGlacierVaultLocation(awsClient.AccountID(), d.Id())
 	if err != nil {
 		return err
 	}
@@ -154,7 +157,7 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tags)
+	setTagsAll(d, meta, tags)
 
 	log.Printf("[DEBUG] Getting the access_policy for Vault %s", d.Id())
 	pol, err := glacierconn.GetVaultAccessPolicy(&glacier.GetVaultAccessPolicyInput{
@@ -186,7 +189,7 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsGlacierVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).Glacier()
 
 	log.Printf("[DEBUG] Glacier Delete Vault: %s", d.Id())
 	_, err := glacierconn.DeleteVault(&glacier.DeleteVaultInput{
@@ -271,113 +274,53 @@ func resourceAwsGlacierVaultPolicyUpdate(glacierconn *glacier.Glacier, d *schema
 	return nil
 }
 
-func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffGlacierVaultTags(mapGlacierVaultTags(o), mapGlacierVaultTags(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			tagsToRemove := &glacier.RemoveTagsFromVaultInput{
-				VaultName: aws.String(d.Id()),
-				TagKeys:   glacierStringsToPointyString(remove),
-			}
-
-			log.Printf("[DEBUG] Removing tags: from %s", d.Id())
-			_, err := conn.RemoveTagsFromVault(tagsToRemove)
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			tagsToAdd := &glacier.AddTagsToVaultInput{
-				VaultName: aws.String(d.Id()),
-				Tags:      glacierVaultTagsFromMap(create),
-			}
-
-			log.Printf("[DEBUG] Creating tags: for %s", d.Id())
-			_, err := conn.AddTagsToVault(tagsToAdd)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
-func mapGlacierVaultTags(m map[string]interface{}) map[string]string {
-	results := make(map[string]string)
-	for k, v := range m {
-		results[k] = v.(string)
-	}
-
-	return results
-}
-
-func diffGlacierVaultTags(oldTags, newTags map[string]string) (map[string]string, []string) {
-
-	create := make(map[string]string)
-	for k, v := range newTags {
-		create[k] = v
-	}
-
-	// Build the list of what to remove
-	var remove []string
-	for k, v := range oldTags {
-		old, ok := create[k]
-		if !ok || old != v {
-			// Delete it!
-			remove = append(remove, k)
-		}
-	}
-
-	return create, remove
+// glacierVaultTagsAdapter updates the tags of a Glacier vault.
+type glacierVaultTagsAdapter struct {
+	conn      *glacier.Glacier
+	vaultName string
 }
 
-func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[string]string, error) {
-	request := &glacier.ListTagsForVaultInput{
-		VaultName: aws.String(vaultName),
-	}
-
-	log.Printf("[DEBUG] Getting the tags: for %s", vaultName)
-	response, err := glacierconn.ListTagsForVault(request)
+func (a glacierVaultTagsAdapter) ListTags() (keyValueTags, error) {
+	log.Printf("[DEBUG] Getting the tags: for %s", a.vaultName)
+	response, err := a.conn.ListTagsForVault(&glacier.ListTagsForVaultInput{
+		VaultName: aws.String(a.vaultName),
+	})
 	if awserr, ok := err.(awserr.Error); ok && awserr.Code() == "NoSuchTagSet" {
-		return map[string]string{}, nil
+		return keyValueTags{}, nil
 	} else if err != nil {
 		return nil, err
 	}
 
-	return glacierVaultTagsToMap(response.Tags), nil
+	return newKeyValueTagsGeneric(response.Tags).IgnoreAws(), nil
 }
 
-func glacierVaultTagsToMap(responseTags map[string]*string) map[string]string {
-	results := make(map[string]string, len(responseTags))
-	for k, v := range responseTags {
-		results[k] = *v
-	}
-
-	return results
+func (a glacierVaultTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTagsToVault(&glacier.AddTagsToVaultInput{
+		VaultName: aws.String(a.vaultName),
+		Tags:      tags.GenericTags(),
+	})
+	return err
 }
 
-func glacierVaultTagsFromMap(responseTags map[string]string) map[string]*string {
-	results := make(map[string]*string, len(responseTags))
-	for k, v := range responseTags {
-		results[k] = aws.String(v)
-	}
+func (a glacierVaultTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTagsFromVault(&glacier.RemoveTagsFromVaultInput{
+		VaultName: aws.String(a.vaultName),
+		TagKeys:   aws.StringSlice(keys),
+	})
+	return err
+}
 
-	return results
+func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
+	return updateTagsAll(d, glacierVaultTagsAdapter{conn: conn, vaultName: d.Id()})
 }
 
-func glacierStringsToPointyString(s []string) []*string {
-	results := make([]*string, len(s))
-	for i, x := range s {
-		results[i] = aws.String(x)
+func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[string]string, error) {
+	tags, err := glacierVaultTagsAdapter{conn: glacierconn, vaultName: vaultName}.ListTags()
+	if err != nil {
+		return nil, err
 	}
 
-	return results
+	return tags.Map(), nil
 }
 
 func glacierPointersToStringList(pointers []*string) []interface{} {
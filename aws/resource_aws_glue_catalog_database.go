@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGlueCatalogDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCatalogDatabaseCreate,
+		Read:   resourceAwsGlueCatalogDatabaseRead,
+		Update: resourceAwsGlueCatalogDatabaseUpdate,
+		Delete: resourceAwsGlueCatalogDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCatalogDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).AccountID())
+	name := d.Get("name").(string)
+
+	input := &glue.CreateDatabaseInput{
+		CatalogId: aws.String(catalogID),
+		DatabaseInput: &glue.DatabaseInput{
+			Name: aws.String(name),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Glue Catalog Database: %s", input)
+	if _, err := conn.CreateDatabase(input); err != nil {
+		return fmt.Errorf("Error creating Glue Catalog Database: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", catalogID, name))
+
+	return resourceAwsGlueCatalogDatabaseUpdate(d, meta)
+}
+
+func resourceAwsGlueCatalogDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	catalogID, name, err := readAwsGlueCatalogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dbInput := &glue.DatabaseInput{
+		Name: aws.String(name),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		dbInput.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("location_uri"); ok {
+		dbInput.LocationUri = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		dbInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if d.HasChange("description") || d.HasChange("location_uri") || d.HasChange("parameters") {
+		input := &glue.UpdateDatabaseInput{
+			CatalogId:     aws.String(catalogID),
+			DatabaseInput: dbInput,
+			Name:          aws.String(name),
+		}
+
+		log.Printf("[DEBUG] Updating Glue Catalog Database: %s", input)
+		if _, err := conn.UpdateDatabase(input); err != nil {
+			return fmt.Errorf("Error updating Glue Catalog Database %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueCatalogDatabaseRead(d, meta)
+}
+
+func resourceAwsGlueCatalogDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	catalogID, name, err := readAwsGlueCatalogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	out, err := conn.GetDatabase(&glue.GetDatabaseInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Catalog Database (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Catalog Database %s: %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", catalogID)
+	d.Set("name", out.Database.Name)
+	d.Set("description", out.Database.Description)
+	d.Set("location_uri", out.Database.LocationUri)
+	if err := d.Set("parameters", aws.StringValueMap(out.Database.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCatalogDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	catalogID, name, err := readAwsGlueCatalogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Database: %s", d.Id())
+	_, err = conn.DeleteDatabase(&glue.DeleteDatabaseInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Catalog Database %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// readAwsGlueCatalogID splits an ID of the form CATALOG-ID:NAME.
+func readAwsGlueCatalogID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected CATALOG-ID:NAME", id)
+	}
+	return idParts[0], idParts[1], nil
+}
+
+// createAwsGlueCatalogID returns the configured catalog_id, or the account
+// ID which Glue uses as the ID of the default Data Catalog.
+func createAwsGlueCatalogID(d *schema.ResourceData, accountID string) string {
+	if v, ok := d.GetOk("catalog_id"); ok {
+		return v.(string)
+	}
+	return accountID
+}
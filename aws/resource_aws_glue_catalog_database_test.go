@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCatalogDatabase_full(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogDatabase_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists("aws_glue_catalog_database.test"),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "name", fmt.Sprintf("my_test_catalog_database_%d", rInt)),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "description", ""),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "location_uri", ""),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "parameters.%", "0"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogDatabase_full(rInt, "A test catalog from terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists("aws_glue_catalog_database.test"),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "description", "A test catalog from terraform"),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "location_uri", "my-location"),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "parameters.param1", "value1"),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "parameters.param2", "1"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogDatabase_full(rInt, "An updated test catalog from terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists("aws_glue_catalog_database.test"),
+					resource.TestCheckResourceAttr("aws_glue_catalog_database.test", "description", "An updated test catalog from terraform"),
+				),
+			},
+			{
+				ResourceName:      "aws_glue_catalog_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestReadAwsGlueCatalogID(t *testing.T) {
+	cases := []struct {
+		ID        string
+		CatalogID string
+		Name      string
+		ErrCount  int
+	}{
+		{ID: "123456789012:my_database", CatalogID: "123456789012", Name: "my_database"},
+		{ID: "123456789012:my:database", CatalogID: "123456789012", Name: "my:database"},
+		{ID: "my_database", ErrCount: 1},
+		{ID: ":my_database", ErrCount: 1},
+		{ID: "123456789012:", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		catalogID, name, err := readAwsGlueCatalogID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to error, got: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Fatalf("expected %q to error", tc.ID)
+			}
+			continue
+		}
+		if catalogID != tc.CatalogID || name != tc.Name {
+			t.Fatalf("expected %q to be split into %q and %q, got %q and %q", tc.ID, tc.CatalogID, tc.Name, catalogID, name)
+		}
+	}
+}
+
+func testAccCheckGlueDatabaseDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).Glue()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_database" {
+			continue
+		}
+
+		catalogID, name, err := readAwsGlueCatalogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetDatabase(&glue.GetDatabaseInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Catalog Database %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlueCatalogDatabaseExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, err := readAwsGlueCatalogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).Glue()
+		out, err := conn.GetDatabase(&glue.GetDatabaseInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(dbName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if out.Database == nil {
+			return fmt.Errorf("No Glue Database Found")
+		}
+
+		if aws.StringValue(out.Database.Name) != dbName {
+			return fmt.Errorf("Glue Database Mismatch - existing: %q, state: %q", aws.StringValue(out.Database.Name), dbName)
+		}
+
+		return nil
+	}
+}
+
+func testAccGlueCatalogDatabase_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "my_test_catalog_database_%d"
+}
+`, rInt)
+}
+
+func testAccGlueCatalogDatabase_full(rInt int, desc string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name         = "my_test_catalog_database_%d"
+  description  = "%s"
+  location_uri = "my-location"
+
+  parameters {
+    param1 = "value1"
+    param2 = true
+    param3 = 50
+  }
+}
+`, rInt, desc)
+}
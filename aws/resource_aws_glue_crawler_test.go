@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCrawler_S3Target(t *testing.T) {
+	var crawler glue.Crawler
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_crawler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCrawlerConfig_S3Target(rName, "s3://bucket1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "role", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.path", "s3://bucket1"),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "DEPRECATE_IN_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "UPDATE_IN_DATABASE"),
+				),
+			},
+			{
+				Config: testAccGlueCrawlerConfig_S3Target(rName, "s3://bucket2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.path", "s3://bucket2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_JdbcTarget(t *testing.T) {
+	var crawler glue.Crawler
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_crawler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCrawlerConfig_JdbcTarget(rName, "database-name/%"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.path", "database-name/%"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_Schedule(t *testing.T) {
+	var crawler glue.Crawler
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_crawler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCrawlerConfig_Schedule(rName, "cron(0 1 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 1 * * ? *)"),
+				),
+			},
+			{
+				Config: testAccGlueCrawlerConfig_Schedule(rName, "cron(0 2 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 2 * * ? *)"),
+				),
+			},
+			{
+				Config: testAccGlueCrawlerConfig_S3Target(rName, "s3://bucket1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schedule", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueCrawlerExists(resourceName string, crawler *glue.Crawler) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Crawler ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).Glue()
+		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Crawler == nil {
+			return fmt.Errorf("Glue Crawler (%s) not found", rs.Primary.ID)
+		}
+
+		*crawler = *output.Crawler
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueCrawlerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).Glue()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_crawler" {
+			continue
+		}
+
+		_, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Crawler %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGlueCrawlerConfig_Base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%s"
+}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "glue.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = "${aws_iam_role.test.name}"
+}
+`, rName, rName)
+}
+
+func testAccGlueCrawlerConfig_S3Target(rName, path string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_crawler" "test" {
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+
+  s3_target {
+    path = "%s"
+  }
+}
+`, testAccGlueCrawlerConfig_Base(rName), rName, path)
+}
+
+func testAccGlueCrawlerConfig_JdbcTarget(rName, path string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_connection" "test" {
+  name = "%s"
+
+  connection_properties = {
+    JDBC_CONNECTION_URL = "jdbc:mysql://terraformacctesting.com/testdatabase"
+    PASSWORD            = "testpassword"
+    USERNAME            = "testusername"
+  }
+}
+
+resource "aws_glue_crawler" "test" {
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+
+  jdbc_target {
+    connection_name = "${aws_glue_connection.test.name}"
+    path            = "%s"
+  }
+}
+`, testAccGlueCrawlerConfig_Base(rName), rName, rName, path)
+}
+
+func testAccGlueCrawlerConfig_Schedule(rName, schedule string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_crawler" "test" {
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+  schedule      = "%s"
+
+  s3_target {
+    path = "s3://bucket1"
+  }
+}
+`, testAccGlueCrawlerConfig_Base(rName), rName, schedule)
+}
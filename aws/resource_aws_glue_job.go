@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueJobCreate,
+		Read:   resourceAwsGlueJobRead,
+		Update: resourceAwsGlueJobUpdate,
+		Delete: resourceAwsGlueJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"command": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "glueetl",
+						},
+						"script_location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_arguments": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"execution_property": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_runs": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+	name := d.Get("name").(string)
+
+	input := &glue.CreateJobInput{
+		AllocatedCapacity: aws.Int64(int64(d.Get("allocated_capacity").(int))),
+		Command:           expandGlueJobCommand(d.Get("command").([]interface{})),
+		Name:              aws.String(name),
+		Role:              aws.String(d.Get("role_arn").(string)),
+	}
+	if v, ok := d.GetOk("connections"); ok {
+		input.Connections = &glue.ConnectionsList{
+			Connections: expandStringList(v.([]interface{})),
+		}
+	}
+	if v, ok := d.GetOk("default_arguments"); ok {
+		input.DefaultArguments = stringMapToPointers(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("execution_property"); ok {
+		input.ExecutionProperty = expandGlueExecutionProperty(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("max_retries"); ok {
+		input.MaxRetries = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Job: %s", input)
+	if _, err := conn.CreateJob(input); err != nil {
+		return fmt.Errorf("Error creating Glue Job %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueJobRead(d, meta)
+}
+
+func resourceAwsGlueJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	out, err := conn.GetJob(&glue.GetJobInput{
+		JobName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Job %s: %s", d.Id(), err)
+	}
+
+	job := out.Job
+	if job == nil {
+		log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("allocated_capacity", job.AllocatedCapacity)
+	d.Set("description", job.Description)
+	d.Set("max_retries", job.MaxRetries)
+	d.Set("name", job.Name)
+	d.Set("role_arn", job.Role)
+
+	if err := d.Set("command", flattenGlueJobCommand(job.Command)); err != nil {
+		return fmt.Errorf("error setting command: %s", err)
+	}
+	var connections []interface{}
+	if job.Connections != nil {
+		connections = flattenStringList(job.Connections.Connections)
+	}
+	if err := d.Set("connections", connections); err != nil {
+		return fmt.Errorf("error setting connections: %s", err)
+	}
+	if err := d.Set("default_arguments", aws.StringValueMap(job.DefaultArguments)); err != nil {
+		return fmt.Errorf("error setting default_arguments: %s", err)
+	}
+	if err := d.Set("execution_property", flattenGlueExecutionProperty(job.ExecutionProperty)); err != nil {
+		return fmt.Errorf("error setting execution_property: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	jobUpdate := &glue.JobUpdate{
+		AllocatedCapacity: aws.Int64(int64(d.Get("allocated_capacity").(int))),
+		Command:           expandGlueJobCommand(d.Get("command").([]interface{})),
+		Connections: &glue.ConnectionsList{
+			Connections: expandStringList(d.Get("connections").([]interface{})),
+		},
+		DefaultArguments: stringMapToPointers(d.Get("default_arguments").(map[string]interface{})),
+		Description:      aws.String(d.Get("description").(string)),
+		MaxRetries:       aws.Int64(int64(d.Get("max_retries").(int))),
+		Role:             aws.String(d.Get("role_arn").(string)),
+	}
+	if v, ok := d.GetOk("execution_property"); ok {
+		jobUpdate.ExecutionProperty = expandGlueExecutionProperty(v.([]interface{}))
+	}
+
+	input := &glue.UpdateJobInput{
+		JobName:   aws.String(d.Id()),
+		JobUpdate: jobUpdate,
+	}
+
+	log.Printf("[DEBUG] Updating Glue Job: %s", input)
+	if _, err := conn.UpdateJob(input); err != nil {
+		return fmt.Errorf("Error updating Glue Job %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueJobRead(d, meta)
+}
+
+func resourceAwsGlueJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	log.Printf("[DEBUG] Deleting Glue Job: %s", d.Id())
+	_, err := conn.DeleteJob(&glue.DeleteJobInput{
+		JobName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Job %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueJobCommand(l []interface{}) *glue.JobCommand {
+	m := l[0].(map[string]interface{})
+
+	return &glue.JobCommand{
+		Name:           aws.String(m["name"].(string)),
+		ScriptLocation: aws.String(m["script_location"].(string)),
+	}
+}
+
+func expandGlueExecutionProperty(l []interface{}) *glue.ExecutionProperty {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.ExecutionProperty{
+		MaxConcurrentRuns: aws.Int64(int64(m["max_concurrent_runs"].(int))),
+	}
+}
+
+func flattenGlueJobCommand(jobCommand *glue.JobCommand) []map[string]interface{} {
+	if jobCommand == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"name":            aws.StringValue(jobCommand.Name),
+		"script_location": aws.StringValue(jobCommand.ScriptLocation),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenGlueExecutionProperty(executionProperty *glue.ExecutionProperty) []map[string]interface{} {
+	if executionProperty == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_concurrent_runs": int(aws.Int64Value(executionProperty.MaxConcurrentRuns)),
+	}
+
+	return []map[string]interface{}{m}
+}
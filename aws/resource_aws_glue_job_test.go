@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueJob_basic(t *testing.T) {
+	var job glue.Job
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueJobConfig_Required(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "allocated_capacity", "10"),
+					resource.TestCheckResourceAttr(resourceName, "command.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "glueetl"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.script_location", "testscriptlocation"),
+					resource.TestCheckResourceAttr(resourceName, "default_arguments.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "role_arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:iam::[^:]+:role/%s", rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueJob_Update(t *testing.T) {
+	var job glue.Job
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueJobConfig_Full(rName, "First Description", 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "allocated_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "First Description"),
+					resource.TestCheckResourceAttr(resourceName, "default_arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_arguments.--job-language", "python"),
+					resource.TestCheckResourceAttr(resourceName, "execution_property.0.max_concurrent_runs", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "1"),
+				),
+			},
+			{
+				Config: testAccAWSGlueJobConfig_Full(rName, "Second Description", 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "allocated_capacity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second Description"),
+					resource.TestCheckResourceAttr(resourceName, "execution_property.0.max_concurrent_runs", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueJobExists(resourceName string, job *glue.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).Glue()
+		output, err := conn.GetJob(&glue.GetJobInput{
+			JobName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Job == nil {
+			return fmt.Errorf("Glue Job (%s) not found", rs.Primary.ID)
+		}
+
+		if aws.StringValue(output.Job.Name) == rs.Primary.ID {
+			*job = *output.Job
+			return nil
+		}
+
+		return fmt.Errorf("Glue Job (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSGlueJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).Glue()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_job" {
+			continue
+		}
+
+		output, err := conn.GetJob(&glue.GetJobInput{
+			JobName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if output.Job != nil && aws.StringValue(output.Job.Name) == rs.Primary.ID {
+			return fmt.Errorf("Glue Job %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueJobConfig_Base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "glue.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = "${aws_iam_role.test.name}"
+}
+`, rName)
+}
+
+func testAccAWSGlueJobConfig_Full(rName, description string, maxRetries, allocatedCapacity int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  allocated_capacity = %d
+  description        = "%s"
+  max_retries        = %d
+  name               = "%s"
+  role_arn           = "${aws_iam_role.test.arn}"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  default_arguments = {
+    "--job-language" = "python"
+  }
+
+  execution_property {
+    max_concurrent_runs = %d
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueJobConfig_Base(rName), allocatedCapacity, description, maxRetries, rName, maxRetries)
+}
+
+func testAccAWSGlueJobConfig_Required(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name     = "%s"
+  role_arn = "${aws_iam_role.test.arn}"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueJobConfig_Base(rName), rName)
+}
@@ -0,0 +1,401 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueTriggerCreate,
+		Read:   resourceAwsGlueTriggerRead,
+		Update: resourceAwsGlueTriggerUpdate,
+		Delete: resourceAwsGlueTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"job_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"predicate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"job_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"logical_operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  glue.LogicalOperatorEquals,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.LogicalOperatorEquals,
+										}, false),
+									},
+									"state": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.JobRunStateFailed,
+											glue.JobRunStateStopped,
+											glue.JobRunStateSucceeded,
+										}, false),
+									},
+								},
+							},
+						},
+						"logical": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.LogicalAnd,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.LogicalAnd,
+							}, false),
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.TriggerTypeConditional,
+					glue.TriggerTypeOnDemand,
+					glue.TriggerTypeScheduled,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+	name := d.Get("name").(string)
+	triggerType := d.Get("type").(string)
+
+	input := &glue.CreateTriggerInput{
+		Actions: expandGlueActions(d.Get("actions").([]interface{})),
+		Name:    aws.String(name),
+		Type:    aws.String(triggerType),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("predicate"); ok {
+		input.Predicate = expandGluePredicate(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Trigger: %s", input)
+	if _, err := conn.CreateTrigger(input); err != nil {
+		return fmt.Errorf("Error creating Glue Trigger %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.TriggerStateCreating},
+		Target:  []string{glue.TriggerStateCreated},
+		Refresh: resourceAwsGlueTriggerRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Glue Trigger %s to be created: %s", d.Id(), err)
+	}
+
+	// Starting an on-demand trigger runs its jobs, so only scheduled and
+	// conditional triggers are started here.
+	if d.Get("enabled").(bool) && triggerType != glue.TriggerTypeOnDemand {
+		log.Printf("[DEBUG] Starting Glue Trigger: %s", d.Id())
+		if _, err := conn.StartTrigger(&glue.StartTriggerInput{Name: aws.String(d.Id())}); err != nil {
+			return fmt.Errorf("Error starting Glue Trigger %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueTriggerRead(d, meta)
+}
+
+func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	out, err := conn.GetTrigger(&glue.GetTriggerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Trigger %s: %s", d.Id(), err)
+	}
+
+	trigger := out.Trigger
+	if trigger == nil {
+		log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("actions", flattenGlueActions(trigger.Actions)); err != nil {
+		return fmt.Errorf("error setting actions: %s", err)
+	}
+
+	d.Set("description", trigger.Description)
+	d.Set("name", trigger.Name)
+	d.Set("schedule", trigger.Schedule)
+	d.Set("type", trigger.Type)
+
+	// On-demand triggers are never started, so their state says nothing
+	// about enabled.
+	if aws.StringValue(trigger.Type) != glue.TriggerTypeOnDemand {
+		state := aws.StringValue(trigger.State)
+		d.Set("enabled", state == glue.TriggerStateActivated || state == glue.TriggerStateActivating)
+	}
+
+	if err := d.Set("predicate", flattenGluePredicate(trigger.Predicate)); err != nil {
+		return fmt.Errorf("error setting predicate: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	if d.HasChange("actions") || d.HasChange("description") || d.HasChange("predicate") || d.HasChange("schedule") {
+		triggerUpdate := &glue.TriggerUpdate{
+			Actions: expandGlueActions(d.Get("actions").([]interface{})),
+		}
+		if v, ok := d.GetOk("description"); ok {
+			triggerUpdate.Description = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("predicate"); ok {
+			triggerUpdate.Predicate = expandGluePredicate(v.([]interface{}))
+		}
+		if v, ok := d.GetOk("schedule"); ok {
+			triggerUpdate.Schedule = aws.String(v.(string))
+		}
+
+		input := &glue.UpdateTriggerInput{
+			Name:          aws.String(d.Id()),
+			TriggerUpdate: triggerUpdate,
+		}
+
+		log.Printf("[DEBUG] Updating Glue Trigger: %s", input)
+		if _, err := conn.UpdateTrigger(input); err != nil {
+			return fmt.Errorf("Error updating Glue Trigger %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") && d.Get("type").(string) != glue.TriggerTypeOnDemand {
+		if d.Get("enabled").(bool) {
+			log.Printf("[DEBUG] Starting Glue Trigger: %s", d.Id())
+			if _, err := conn.StartTrigger(&glue.StartTriggerInput{Name: aws.String(d.Id())}); err != nil {
+				return fmt.Errorf("Error starting Glue Trigger %s: %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[DEBUG] Stopping Glue Trigger: %s", d.Id())
+			if _, err := conn.StopTrigger(&glue.StopTriggerInput{Name: aws.String(d.Id())}); err != nil {
+				return fmt.Errorf("Error stopping Glue Trigger %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsGlueTriggerRead(d, meta)
+}
+
+func resourceAwsGlueTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Glue()
+
+	log.Printf("[DEBUG] Deleting Glue Trigger: %s", d.Id())
+	_, err := conn.DeleteTrigger(&glue.DeleteTriggerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Trigger %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.TriggerStateDeleting},
+		Target:  []string{""},
+		Refresh: resourceAwsGlueTriggerRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Glue Trigger %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueTriggerRefreshFunc(conn *glue.Glue, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.GetTrigger(&glue.GetTriggerInput{
+			Name: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				return out, "", nil
+			}
+			return nil, "", err
+		}
+
+		if out.Trigger == nil {
+			return out, "", nil
+		}
+
+		return out, aws.StringValue(out.Trigger.State), nil
+	}
+}
+
+func expandGlueActions(l []interface{}) []*glue.Action {
+	actions := []*glue.Action{}
+
+	for _, mRaw := range l {
+		m := mRaw.(map[string]interface{})
+
+		action := &glue.Action{
+			JobName: aws.String(m["job_name"].(string)),
+		}
+		if v, ok := m["arguments"].(map[string]interface{}); ok && len(v) > 0 {
+			action.Arguments = stringMapToPointers(v)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func expandGluePredicate(l []interface{}) *glue.Predicate {
+	m := l[0].(map[string]interface{})
+
+	predicate := &glue.Predicate{
+		Conditions: expandGlueConditions(m["conditions"].([]interface{})),
+	}
+	if v, ok := m["logical"]; ok && v.(string) != "" {
+		predicate.Logical = aws.String(v.(string))
+	}
+
+	return predicate
+}
+
+func expandGlueConditions(l []interface{}) []*glue.Condition {
+	conditions := []*glue.Condition{}
+
+	for _, mRaw := range l {
+		m := mRaw.(map[string]interface{})
+
+		conditions = append(conditions, &glue.Condition{
+			JobName:         aws.String(m["job_name"].(string)),
+			LogicalOperator: aws.String(m["logical_operator"].(string)),
+			State:           aws.String(m["state"].(string)),
+		})
+	}
+
+	return conditions
+}
+
+func flattenGlueActions(actions []*glue.Action) []interface{} {
+	l := []interface{}{}
+
+	for _, action := range actions {
+		l = append(l, map[string]interface{}{
+			"arguments": aws.StringValueMap(action.Arguments),
+			"job_name":  aws.StringValue(action.JobName),
+		})
+	}
+
+	return l
+}
+
+func flattenGluePredicate(predicate *glue.Predicate) []map[string]interface{} {
+	if predicate == nil || len(predicate.Conditions) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"conditions": flattenGlueConditions(predicate.Conditions),
+		"logical":    glue.LogicalAnd,
+	}
+	if v := aws.StringValue(predicate.Logical); v != "" {
+		m["logical"] = v
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenGlueConditions(conditions []*glue.Condition) []interface{} {
+	l := []interface{}{}
+
+	for _, condition := range conditions {
+		l = append(l, map[string]interface{}{
+			"job_name":         aws.StringValue(condition.JobName),
+			"logical_operator": aws.StringValue(condition.LogicalOperator),
+			"state":            aws.StringValue(condition.State),
+		})
+	}
+
+	return l
+}
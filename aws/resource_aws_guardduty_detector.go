@@ -35,7 +35,7 @@ func resourceAwsGuardDutyDetector() *schema.Resource {
 }
 
 func resourceAwsGuardDutyDetectorCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).GuardDuty()
 
 	input := guardduty.CreateDetectorInput{
 		Enable: aws.Bool(d.Get("enable").(bool)),
@@ -52,7 +52,7 @@ func resourceAwsGuardDutyDetectorCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).GuardDuty()
 	input := guardduty.GetDetectorInput{
 		DetectorId: aws.String(d.Id()),
 	}
@@ -68,14 +68,14 @@ func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Reading GuardDuty Detector '%s' failed: %s", d.Id(), err.Error())
 	}
 
-	d.Set("account_id", meta.(*AWSClient).accountid)
+	d.Set("account_id", meta.(*AWSClient).AccountID())
 	d.Set("enable", *gdo.Status == guardduty.DetectorStatusEnabled)
 
 	return nil
 }
 
 func resourceAwsGuardDutyDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).GuardDuty()
 
 	input := guardduty.UpdateDetectorInput{
 		DetectorId: aws.String(d.Id()),
@@ -92,7 +92,7 @@ func resourceAwsGuardDutyDetectorUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsGuardDutyDetectorDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).GuardDuty()
 	input := guardduty.DeleteDetectorInput{
 		DetectorId: aws.String(d.Id()),
 	}
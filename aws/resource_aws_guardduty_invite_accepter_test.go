@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsGuardDutyInviteAccepter_basic(t *testing.T) {
+	masterProfile := os.Getenv("GUARDDUTY_MASTER_PROFILE")
+	if masterProfile == "" {
+		t.Skip("Environment variable GUARDDUTY_MASTER_PROFILE is not set")
+	}
+
+	resourceName := "aws_guardduty_invite_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyInviteAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyInviteAccepterConfig_basic(masterProfile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyInviteAccepterExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_account_id", "aws_guardduty_detector.master", "account_id"),
+				),
+			},
+			{
+				// The member is only read by the master before the invitation is accepted.
+				Config: testAccGuardDutyInviteAccepterConfig_basic(masterProfile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_guardduty_member.member", "relationship_status", "Enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAWSGuardDutyInviteAccepter_mockApi(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	guardDuty := newAwsMockGuardDuty(api)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("GuardDuty members", guardDuty.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig() + testAccGuardDutyInviteAccepterConfig_mockApi(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_guardduty_member.member", "invite", "true"),
+					resource.TestCheckResourceAttr("aws_guardduty_member.member", "relationship_status", "Enabled"),
+					resource.TestCheckResourceAttr("aws_guardduty_invite_accepter.test", "master_account_id", awsMockAccountId),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyInviteAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).GuardDuty()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_invite_accepter" {
+			continue
+		}
+
+		resp, err := conn.GetMasterAccount(&guardduty.GetMasterAccountInput{
+			DetectorId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+				return nil
+			}
+			return err
+		}
+
+		if resp.Master != nil {
+			return fmt.Errorf("Expected GuardDuty detector %s to have no master account", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyInviteAccepterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).GuardDuty()
+		resp, err := conn.GetMasterAccount(&guardduty.GetMasterAccountInput{
+			DetectorId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Master == nil {
+			return fmt.Errorf("GuardDuty detector %s has no master account", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGuardDutyInviteAccepterConfig_basic(masterProfile string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "master"
+  profile = "%s"
+}
+
+resource "aws_guardduty_detector" "master" {
+  provider = "aws.master"
+}
+
+resource "aws_guardduty_detector" "member" {}
+
+resource "aws_guardduty_member" "member" {
+  provider = "aws.master"
+
+  account_id  = "${aws_guardduty_detector.member.account_id}"
+  detector_id = "${aws_guardduty_detector.master.id}"
+  email       = "required@example.com"
+  invite      = true
+}
+
+resource "aws_guardduty_invite_accepter" "test" {
+  depends_on = ["aws_guardduty_member.member"]
+
+  detector_id       = "${aws_guardduty_detector.member.id}"
+  master_account_id = "${aws_guardduty_detector.master.account_id}"
+}
+`, masterProfile)
+}
+
+// The invitation is accepted while the member is still being invited, as the
+// accepter does not wait for the member.
+func testAccGuardDutyInviteAccepterConfig_mockApi() string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_member" "member" {
+  account_id  = "111111111111"
+  detector_id = "%s"
+  email       = "required@example.com"
+  invite      = true
+}
+
+resource "aws_guardduty_invite_accepter" "test" {
+  detector_id       = "%s"
+  master_account_id = "%s"
+}
+`, awsMockGuardDutyMasterDetectorId, awsMockGuardDutyMemberDetectorId, awsMockAccountId)
+}
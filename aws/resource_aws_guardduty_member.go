@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyMemberCreate,
+		Read:   resourceAwsGuardDutyMemberRead,
+		Update: resourceAwsGuardDutyMemberUpdate,
+		Delete: resourceAwsGuardDutyMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Second),
+			Update: schema.DefaultTimeout(60 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).GuardDuty()
+
+	accountID := d.Get("account_id").(string)
+	detectorID := d.Get("detector_id").(string)
+
+	input := &guardduty.CreateMembersInput{
+		AccountDetails: []*guardduty.AccountDetail{{
+			AccountId: aws.String(accountID),
+			Email:     aws.String(d.Get("email").(string)),
+		}},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Member: %s", input)
+	resp, err := conn.CreateMembers(input)
+	if err != nil {
+		return fmt.Errorf("Creating GuardDuty Member failed: %s", err.Error())
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Creating GuardDuty Member failed: %s", aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, accountID))
+
+	if d.Get("invite").(bool) {
+		if err := inviteGuardDutyMember(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).GuardDuty()
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.GetMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Member: %s", input)
+	resp, err := conn.GetMembers(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			log.Printf("[WARN] GuardDuty detector %q not found, removing member %q from state", detectorID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading GuardDuty Member '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	if len(resp.Members) == 0 {
+		log.Printf("[WARN] GuardDuty Member %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	member := resp.Members[0]
+	d.Set("account_id", member.AccountId)
+	d.Set("detector_id", detectorID)
+	d.Set("email", member.Email)
+
+	status := aws.StringValue(member.RelationshipStatus)
+	d.Set("relationship_status", status)
+
+	// https://docs.aws.amazon.com/guardduty/latest/ug/list-members.html
+	switch status {
+	case "Disabled", "Enabled", "Invited", "EmailVerificationInProgress":
+		d.Set("invite", true)
+	case "Removed", "Resigned", "Created":
+		d.Set("invite", false)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).GuardDuty()
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := inviteGuardDutyMember(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			input := &guardduty.DisassociateMembersInput{
+				AccountIds: []*string{aws.String(accountID)},
+				DetectorId: aws.String(detectorID),
+			}
+
+			log.Printf("[DEBUG] Disassociating GuardDuty Member: %s", input)
+			_, err := conn.DisassociateMembers(input)
+			if err != nil {
+				return fmt.Errorf("Disassociating GuardDuty Member '%s' failed: %s", d.Id(), err.Error())
+			}
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).GuardDuty()
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Member: %s", input)
+	_, err = conn.DeleteMembers(input)
+	if err != nil {
+		return fmt.Errorf("Deleting GuardDuty Member '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+// inviteGuardDutyMember sends the invitation to the member account and waits
+// until GuardDuty has delivered it, or until the member has accepted it.
+func inviteGuardDutyMember(conn *guardduty.GuardDuty, d *schema.ResourceData, timeout time.Duration) error {
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.InviteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Inviting GuardDuty Member: %s", input)
+	resp, err := conn.InviteMembers(input)
+	if err != nil {
+		return fmt.Errorf("Inviting GuardDuty Member '%s' failed: %s", d.Id(), err.Error())
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Inviting GuardDuty Member '%s' failed: %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Created", "EmailVerificationInProgress"},
+		Target:  []string{"Invited", "Enabled"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.GetMembers(&guardduty.GetMembersInput{
+				AccountIds: []*string{aws.String(accountID)},
+				DetectorId: aws.String(detectorID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(resp.Members) == 0 {
+				return nil, "", fmt.Errorf("GuardDuty Member '%s' not found", d.Id())
+			}
+			return resp, aws.StringValue(resp.Members[0].RelationshipStatus), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Waiting for GuardDuty Member '%s' invitation failed: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func decodeGuardDutyMemberID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected DETECTOR_ID:MEMBER_ACCOUNT_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeGuardDutyMemberID(t *testing.T) {
+	detectorID, accountID, err := decodeGuardDutyMemberID("00b00fd5aecc0ab60a708659477e9617:111111111111")
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if detectorID != "00b00fd5aecc0ab60a708659477e9617" {
+		t.Fatalf("expected detector ID %q, got %q", "00b00fd5aecc0ab60a708659477e9617", detectorID)
+	}
+	if accountID != "111111111111" {
+		t.Fatalf("expected account ID %q, got %q", "111111111111", accountID)
+	}
+
+	for _, id := range []string{"00b00fd5aecc0ab60a708659477e9617", ":111111111111", "00b00fd5aecc0ab60a708659477e9617:", "a:b:c"} {
+		if _, _, err := decodeGuardDutyMemberID(id); err == nil {
+			t.Fatalf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func testAccAwsGuardDutyMember_basic(t *testing.T) {
+	resourceName := "aws_guardduty_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyMemberConfig_basic(accountID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Created"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invite"},
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyMember_invite(t *testing.T) {
+	accountID := os.Getenv("GUARDDUTY_MEMBER_ACCOUNT_ID")
+	email := os.Getenv("GUARDDUTY_MEMBER_EMAIL")
+	if accountID == "" || email == "" {
+		t.Skip("Environment variables GUARDDUTY_MEMBER_ACCOUNT_ID and GUARDDUTY_MEMBER_EMAIL are not set")
+	}
+
+	resourceName := "aws_guardduty_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyMemberConfig_basic(accountID, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Invited"),
+				),
+			},
+			{
+				Config: testAccGuardDutyMemberConfig_basic(accountID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Removed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).GuardDuty()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_member" {
+			continue
+		}
+
+		detectorID, accountID, err := decodeGuardDutyMemberID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.GetMembers(&guardduty.GetMembersInput{
+			AccountIds: []*string{aws.String(accountID)},
+			DetectorId: aws.String(detectorID),
+		})
+		if err != nil {
+			if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+				return nil
+			}
+			return err
+		}
+
+		if len(resp.Members) > 0 {
+			return fmt.Errorf("Expected GuardDuty Member to be destroyed, %s found", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyMemberExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		detectorID, accountID, err := decodeGuardDutyMemberID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).GuardDuty()
+		resp, err := conn.GetMembers(&guardduty.GetMembersInput{
+			AccountIds: []*string{aws.String(accountID)},
+			DetectorId: aws.String(detectorID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Members) == 0 {
+			return fmt.Errorf("GuardDuty Member %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGuardDutyMemberConfig_basic(accountID, email string, invite bool) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_member" "test" {
+  account_id  = "%s"
+  detector_id = "${aws_guardduty_detector.test.id}"
+  email       = "%s"
+  invite      = %t
+}
+`, accountID, email, invite)
+}
@@ -57,7 +57,7 @@ func resourceAwsIamAccessKey() *schema.Resource {
 }
 
 func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	request := &iam.CreateAccessKeyInput{
 		UserName: aws.String(d.Get("user").(string)),
@@ -109,7 +109,7 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	request := &iam.ListAccessKeysInput{
 		UserName: aws.String(d.Get("user").(string)),
@@ -148,7 +148,7 @@ func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKe
 }
 
 func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	request := &iam.DeleteAccessKeyInput{
 		AccessKeyId: aws.String(d.Id()),
@@ -67,7 +67,7 @@ func testAccAWSIAMAccountAlias_basic_with_datasource(t *testing.T) {
 }
 
 func testAccCheckAWSIAMAccountAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_account_alias" {
@@ -113,7 +113,7 @@ func testAccCheckAWSIAMAccountAliasExists(n string, a *string) resource.TestChec
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).IAM()
 		params := &iam.ListAccountAliasesInput{}
 
 		resp, err := conn.ListAccountAliases(params)
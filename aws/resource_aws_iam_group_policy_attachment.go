@@ -33,7 +33,7 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
@@ -48,7 +48,7 @@ func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -90,7 +90,7 @@ func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
 
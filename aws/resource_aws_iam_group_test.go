@@ -78,7 +78,7 @@ func TestAccAWSIAMGroup_basic(t *testing.T) {
 }
 
 func testAccCheckAWSGroupDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_group" {
@@ -117,7 +117,7 @@ func testAccCheckAWSGroupExists(n string, res *iam.GetGroupOutput) resource.Test
 			return errors.New("No Group name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 		resp, err := iamconn.GetGroup(&iam.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -61,7 +61,7 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
@@ -91,7 +91,7 @@ func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	arn := d.Get("policy_arn").(string)
 	name := d.Get("name").(string)
 
@@ -146,7 +146,7 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	name := d.Get("name").(string)
 	var userErr, roleErr, groupErr error
 
@@ -166,7 +166,7 @@ func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
 	users := expandStringList(d.Get("users").(*schema.Set).List())
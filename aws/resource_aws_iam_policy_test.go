@@ -57,7 +57,7 @@ func testAccCheckAWSPolicyExists(resource string, res *iam.GetPolicyOutput) reso
 			return fmt.Errorf("No Policy name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 		resp, err := iamconn.GetPolicy(&iam.GetPolicyInput{
 			PolicyArn: aws.String(rs.Primary.Attributes["arn"]),
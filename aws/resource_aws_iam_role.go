@@ -116,7 +116,7 @@ func resourceAwsIamRoleImport(
 }
 
 func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -156,7 +156,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	request := &iam.GetRoleInput{
 		RoleName: aws.String(d.Id()),
@@ -207,7 +207,7 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	if d.HasChange("assume_role_policy") {
 		assumeRolePolicyInput := &iam.UpdateAssumeRolePolicyInput{
@@ -243,7 +243,7 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	// Roles cannot be destroyed when attached to an existing Instance Profile
 	resp, err := iamconn.ListInstanceProfilesForRole(&iam.ListInstanceProfilesForRoleInput{
@@ -56,7 +56,7 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 }
 
 func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	request := &iam.PutRolePolicyInput{
 		RoleName:       aws.String(d.Get("role").(string)),
@@ -82,7 +82,7 @@ func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	role, name, err := resourceAwsIamRolePolicyParseId(d.Id())
 	if err != nil {
@@ -121,7 +121,7 @@ func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsIamRolePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	role, name, err := resourceAwsIamRolePolicyParseId(d.Id())
 	if err != nil {
@@ -33,7 +33,7 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
@@ -48,7 +48,7 @@ func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -92,7 +92,7 @@ func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -106,7 +106,7 @@ func TestAccAWSIAMRolePolicy_invalidJSON(t *testing.T) {
 }
 
 func testAccCheckIAMRolePolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_role_policy" {
@@ -158,7 +158,7 @@ func testAccCheckIAMRolePolicy(
 			return fmt.Errorf("Not Found: %s", iamRolePolicyResource)
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 		role, name, err := resourceAwsIamRolePolicyParseId(policy.Primary.ID)
 		if err != nil {
 			return err
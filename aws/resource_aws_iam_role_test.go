@@ -73,6 +73,42 @@ func TestAccAWSIAMRole_basicWithDescription(t *testing.T) {
 	})
 }
 
+func TestAWSIAMRole_mockApi(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	roles := newAwsMockIamRoles(api)
+
+	rName := acctest.RandString(10)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("IAM roles", roles.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig() + testAccAWSIAMRoleConfigWithDescription(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_role.role", "path", "/"),
+					resource.TestCheckResourceAttr("aws_iam_role.role", "arn",
+						fmt.Sprintf("arn:aws:iam::%s:role/test-role-%s", awsMockAccountId, rName)),
+					resource.TestCheckResourceAttr("aws_iam_role.role", "description", "This 1s a D3scr!pti0n with weird content: &@90ë“‘{«¡Çø}"),
+					resource.TestCheckResourceAttrSet("aws_iam_role.role", "create_date"),
+				),
+			},
+			{
+				Config: api.ProviderConfig() + testAccAWSIAMRoleConfigWithUpdatedDescription(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_role.role", "description", "This 1s an Upd@ted D3scr!pti0n with weird content: &90ë“‘{«¡Çø}"),
+				),
+			},
+			{
+				Config:            api.ProviderConfig() + testAccAWSIAMRoleConfigWithUpdatedDescription(rName),
+				ResourceName:      "aws_iam_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSIAMRole_namePrefix(t *testing.T) {
 	var conf iam.GetRoleOutput
 	rName := acctest.RandString(10)
@@ -159,7 +195,7 @@ func TestAccAWSIAMRole_force_detach_policies(t *testing.T) {
 }
 
 func testAccCheckAWSRoleDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_role" {
@@ -198,7 +234,7 @@ func testAccCheckAWSRoleExists(n string, res *iam.GetRoleOutput) resource.TestCh
 			return fmt.Errorf("No Role name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 		resp, err := iamconn.GetRole(&iam.GetRoleInput{
 			RoleName: aws.String(rs.Primary.ID),
@@ -241,7 +277,7 @@ func testAccAddAwsIAMRolePolicy(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Role name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 		input := &iam.PutRolePolicyInput{
 			RoleName: aws.String(rs.Primary.ID),
@@ -47,7 +47,7 @@ func resourceAwsIamSamlProvider() *schema.Resource {
 }
 
 func resourceAwsIamSamlProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	input := &iam.CreateSAMLProviderInput{
 		Name:                 aws.String(d.Get("name").(string)),
@@ -65,7 +65,7 @@ func resourceAwsIamSamlProviderCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsIamSamlProviderRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	input := &iam.GetSAMLProviderInput{
 		SAMLProviderArn: aws.String(d.Id()),
@@ -82,7 +82,7 @@ func resourceAwsIamSamlProviderRead(d *schema.ResourceData, meta interface{}) er
 
 	validUntil := out.ValidUntil.Format(time.RFC1123)
 	d.Set("arn", d.Id())
-	name, err := extractNameFromIAMSamlProviderArn(d.Id(), meta.(*AWSClient).partition)
+	name, err := extractNameFromIAMSamlProviderArn(d.Id(), meta.(*AWSClient).Partition())
 	if err != nil {
 		return err
 	}
@@ -94,7 +94,7 @@ func resourceAwsIamSamlProviderRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIamSamlProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	input := &iam.UpdateSAMLProviderInput{
 		SAMLProviderArn:      aws.String(d.Id()),
@@ -109,7 +109,7 @@ func resourceAwsIamSamlProviderUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsIamSamlProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	input := &iam.DeleteSAMLProviderInput{
 		SAMLProviderArn: aws.String(d.Id()),
@@ -25,7 +25,7 @@ func testSweepIamServerCertificates(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).IAM()
 
 	prefixes := []string{
 		"tf-acctest-",
@@ -114,7 +114,7 @@ func TestAccAWSIAMServerCertificate_disappears(t *testing.T) {
 
 	testDestroyCert := func(*terraform.State) error {
 		// reach out and DELETE the Cert
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).IAM()
 		_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
 			ServerCertificateName: cert.ServerCertificateMetadata.ServerCertificateName,
 		})
@@ -182,7 +182,7 @@ func testAccCheckCertExists(n string, cert *iam.ServerCertificate) resource.Test
 			return fmt.Errorf("No Server Cert ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).IAM()
 		describeOpts := &iam.GetServerCertificateInput{
 			ServerCertificateName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -224,7 +224,7 @@ func testAccCheckAWSServerCertAttributes(cert *iam.ServerCertificate, certBody *
 }
 
 func testAccCheckIAMServerCertificateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_server_certificate" {
@@ -61,7 +61,7 @@ func resourceAwsIamUser() *schema.Resource {
 }
 
 func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 	name := d.Get("name").(string)
 	path := d.Get("path").(string)
 
@@ -80,7 +80,7 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	request := &iam.GetUserInput{
 		UserName: aws.String(d.Id()),
@@ -116,7 +116,7 @@ func resourceAwsIamUserReadResult(d *schema.ResourceData, user *iam.User) error
 
 func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("name") || d.HasChange("path") {
-		iamconn := meta.(*AWSClient).iamconn
+		iamconn := meta.(*AWSClient).IAM()
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -142,7 +142,7 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamUserDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	// IAM Users must be removed from all groups before they can be deleted
 	var groups []string
@@ -98,7 +98,7 @@ func generatePassword(length int) string {
 }
 
 func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).IAM()
 
 	encryptionKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
 	if err != nil {
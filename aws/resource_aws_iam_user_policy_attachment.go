@@ -33,7 +33,7 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
@@ -48,7 +48,7 @@ func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -89,7 +89,7 @@ func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).IAM()
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
 
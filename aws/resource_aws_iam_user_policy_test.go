@@ -87,7 +87,7 @@ func TestAccAWSIAMUserPolicy_generatedName(t *testing.T) {
 }
 
 func testAccCheckIAMUserPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user_policy" {
@@ -137,7 +137,7 @@ func testAccCheckIAMUserPolicy(
 			return fmt.Errorf("Not Found: %s", iamUserPolicyResource)
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 		username, name := resourceAwsIamUserPolicyParseId(policy.Primary.ID)
 		_, err := iamconn.GetUserPolicy(&iam.GetUserPolicyInput{
 			UserName:   aws.String(username),
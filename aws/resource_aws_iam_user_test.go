@@ -81,7 +81,7 @@ func TestAccAWSUser_basic(t *testing.T) {
 }
 
 func testAccCheckAWSUserDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user" {
@@ -120,7 +120,7 @@ func testAccCheckAWSUserExists(n string, res *iam.GetUserOutput) resource.TestCh
 			return fmt.Errorf("No User name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).IAM()
 
 		resp, err := iamconn.GetUser(&iam.GetUserInput{
 			UserName: aws.String(rs.Primary.ID),
@@ -37,7 +37,7 @@ func resourceAWSInspectorAssessmentTarget() *schema.Resource {
 }
 
 func resourceAwsInspectorAssessmentTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).Inspector()
 
 	targetName := d.Get("name").(string)
 	resourceGroupArn := d.Get("resource_group_arn").(string)
@@ -58,7 +58,7 @@ func resourceAwsInspectorAssessmentTargetCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).Inspector()
 
 	resp, err := conn.DescribeAssessmentTargets(&inspector.DescribeAssessmentTargetsInput{
 		AssessmentTargetArns: []*string{
@@ -83,7 +83,7 @@ func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsInspectorAssessmentTargetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).Inspector()
 
 	input := inspector.UpdateAssessmentTargetInput{
 		AssessmentTargetArn:  aws.String(d.Id()),
@@ -102,7 +102,7 @@ func resourceAwsInspectorAssessmentTargetUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsInspectorAssessmentTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).Inspector()
 
 	return resource.Retry(60*time.Minute, func() *resource.RetryError {
 		_, err := conn.DeleteAssessmentTarget(&inspector.DeleteAssessmentTargetInput{
@@ -40,7 +40,7 @@ func TestAccAWSInspectorTarget_basic(t *testing.T) {
 }
 
 func testAccCheckAWSInspectorTargetAssessmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+	conn := testAccProvider.Meta().(*AWSClient).Inspector()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_inspector_assessment_target" {
@@ -38,10 +38,13 @@ func resourceAwsInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -68,7 +71,37 @@ func resourceAwsInstance() *schema.Resource {
 
 			"instance_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"launch_template": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "$Default",
+						},
+					},
+				},
 			},
 
 			"key_name": {
@@ -261,7 +294,8 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"volume_tags": tagsSchemaComputed(),
 
@@ -424,8 +458,31 @@ func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return strings.ToLower(v) != ec2.VolumeTypeIo1
 }
 
+// resourceAwsInstanceCustomizeDiff requires the ami and instance_type of a new
+// instance unless it is launched from a launch_template, which provides them.
+func resourceAwsInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := setTagsAllDiff(diff, meta); err != nil {
+		return err
+	}
+
+	if diff.Id() != "" {
+		return nil
+	}
+	if _, ok := diff.GetOk("launch_template"); ok {
+		return nil
+	}
+
+	for _, k := range []string{"ami", "instance_type"} {
+		if _, ok := diff.GetOk(k); !ok {
+			return fmt.Errorf("%q must be set unless the instance is launched from a launch_template", k)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	instanceOpts, err := buildAwsInstanceOpts(d, meta)
 	if err != nil {
@@ -445,6 +502,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		Ipv6AddressCount:                  instanceOpts.Ipv6AddressCount,
 		Ipv6Addresses:                     instanceOpts.Ipv6Addresses,
 		KeyName:                           instanceOpts.KeyName,
+		LaunchTemplate:                    instanceOpts.LaunchTemplate,
 		MaxCount:                          aws.Int64(int64(1)),
 		MinCount:                          aws.Int64(int64(1)),
 		NetworkInterfaces:                 instanceOpts.NetworkInterfaces,
@@ -467,7 +525,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	if !restricted {
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
+		if v, ok := d.GetOk("tags_all"); ok {
 			tags := tagsFromMap(v.(map[string]interface{}))
 
 			spec := &ec2.TagSpecification{
@@ -575,7 +633,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
 		InstanceIds: []*string{aws.String(d.Id())},
@@ -713,7 +771,15 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	setTagsAll(d, meta, tagsToMap(instance.Tags))
+
+	launchTemplate, err := readLaunchTemplateFromInstance(instance, conn, d.Get("launch_template").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("launch_template", launchTemplate); err != nil {
+		return fmt.Errorf("Error setting launch_template: %s", err)
+	}
 
 	if err := readVolumeTags(conn, d); err != nil {
 		return err
@@ -767,18 +833,19 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	d.Partial(true)
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !d.IsNewResource() || restricted {
 			if err := setTags(conn, d); err != nil {
 				return err
 			} else {
 				d.SetPartial("tags")
+				d.SetPartial("tags_all")
 			}
 		}
 	}
@@ -1034,7 +1101,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	if err := awsTerminateInstance(conn, d.Id(), d); err != nil {
 		return err
@@ -1544,6 +1611,7 @@ type awsInstanceOpts struct {
 	Ipv6AddressCount                  *int64
 	Ipv6Addresses                     []*ec2.InstanceIpv6Address
 	KeyName                           *string
+	LaunchTemplate                    *ec2.LaunchTemplateSpecification
 	NetworkInterfaces                 []*ec2.InstanceNetworkInterfaceSpecification
 	Placement                         *ec2.Placement
 	PrivateIPAddress                  *string
@@ -1556,7 +1624,7 @@ type awsInstanceOpts struct {
 
 func buildAwsInstanceOpts(
 	d *schema.ResourceData, meta interface{}) (*awsInstanceOpts, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	opts := &awsInstanceOpts{
 		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
@@ -1577,6 +1645,31 @@ func buildAwsInstanceOpts(
 		Name: aws.String(d.Get("iam_instance_profile").(string)),
 	}
 
+	// Arguments left out of the configuration are taken from the launch
+	// template rather than overridden with their defaults
+	if v, ok := d.GetOk("launch_template"); ok {
+		opts.LaunchTemplate = expandEc2LaunchTemplateSpecification(v.([]interface{}))
+
+		if _, ok := d.GetOk("ami"); !ok {
+			opts.ImageID = nil
+		}
+		if _, ok := d.GetOk("instance_type"); !ok {
+			opts.InstanceType = nil
+		}
+		if _, ok := d.GetOk("disable_api_termination"); !ok {
+			opts.DisableAPITermination = nil
+		}
+		if _, ok := d.GetOk("ebs_optimized"); !ok {
+			opts.EBSOptimized = nil
+		}
+		if _, ok := d.GetOk("monitoring"); !ok {
+			opts.Monitoring = nil
+		}
+		if _, ok := d.GetOk("iam_instance_profile"); !ok {
+			opts.IAMInstanceProfile = nil
+		}
+	}
+
 	userData := d.Get("user_data").(string)
 	userDataBase64 := d.Get("user_data_base64").(string)
 
@@ -1754,3 +1847,71 @@ func getAwsInstanceVolumeIds(conn *ec2.EC2, d *schema.ResourceData) ([]*string,
 
 	return volumeIds, nil
 }
+
+func expandEc2LaunchTemplateSpecification(l []interface{}) *ec2.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &ec2.LaunchTemplateSpecification{
+		Version: aws.String(m["version"].(string)),
+	}
+	if v := m["id"].(string); v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	}
+	if v := m["name"].(string); v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+
+	return spec
+}
+
+// readLaunchTemplateFromInstance returns the launch_template of an instance
+// from the aws:ec2launchtemplate:id and aws:ec2launchtemplate:version tags EC2
+// adds to instances launched from a launch template. A configured name is kept
+// as long as it still refers to the template of the instance, and so is a
+// configured $Default or $Latest version, as the tag holds the version number
+// it resolved to at launch.
+func readLaunchTemplateFromInstance(instance *ec2.Instance, conn *ec2.EC2, l []interface{}) ([]interface{}, error) {
+	var id, version string
+	for _, t := range instance.Tags {
+		switch aws.StringValue(t.Key) {
+		case "aws:ec2launchtemplate:id":
+			id = aws.StringValue(t.Value)
+		case "aws:ec2launchtemplate:version":
+			version = aws.StringValue(t.Value)
+		}
+	}
+	if id == "" {
+		return []interface{}{}, nil
+	}
+
+	m := map[string]interface{}{
+		"id":      id,
+		"name":    "",
+		"version": version,
+	}
+	if len(l) == 0 || l[0] == nil {
+		return []interface{}{m}, nil
+	}
+
+	c := l[0].(map[string]interface{})
+	if v := c["version"].(string); v == "$Default" || v == "$Latest" {
+		m["version"] = v
+	}
+	if name := c["name"].(string); name != "" {
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateNames: []*string{aws.String(name)},
+		})
+		if err != nil && !isAWSErr(err, "InvalidLaunchTemplateName.NotFoundException", "") {
+			return nil, err
+		}
+		if err == nil && len(resp.LaunchTemplates) == 1 && aws.StringValue(resp.LaunchTemplates[0].LaunchTemplateId) == id {
+			m["id"] = ""
+			m["name"] = name
+		}
+	}
+
+	return []interface{}{m}, nil
+}
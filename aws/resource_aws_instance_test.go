@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -55,7 +56,7 @@ func TestAccAWSInstance_basic(t *testing.T) {
 				// Need a resource in this config so the provisioner will be available
 				Config: testAccInstanceConfig_pre(rInt),
 				Check: func(*terraform.State) error {
-					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+					conn := testAccProvider.Meta().(*AWSClient).EC2()
 					var err error
 					vol, err = conn.CreateVolume(&ec2.CreateVolumeInput{
 						AvailabilityZone: aws.String("us-west-2a"),
@@ -102,7 +103,7 @@ func TestAccAWSInstance_basic(t *testing.T) {
 			{
 				Config: testAccInstanceConfig(rInt),
 				Check: func(*terraform.State) error {
-					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+					conn := testAccProvider.Meta().(*AWSClient).EC2()
 					_, err := conn.DeleteVolume(&ec2.DeleteVolumeInput{VolumeId: vol.VolumeId})
 					return err
 				},
@@ -493,7 +494,7 @@ func TestAccAWSInstance_disableApiTermination(t *testing.T) {
 
 	checkDisableApiTermination := func(expected bool) resource.TestCheckFunc {
 		return func(*terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).ec2conn
+			conn := testAccProvider.Meta().(*AWSClient).EC2()
 			r, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
 				InstanceId: v.InstanceId,
 				Attribute:  aws.String("disableApiTermination"),
@@ -756,6 +757,38 @@ func TestAccAWSInstance_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_launchTemplate(t *testing.T) {
+	var v ec2.Instance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplate(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &v),
+					resource.TestCheckResourceAttr("aws_instance.foo", "ami", "ami-4fccb37f"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "launch_template.#", "1"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "launch_template.0.name", fmt.Sprintf("tf-acctest-%d", rInt)),
+					resource.TestCheckResourceAttr("aws_instance.foo", "launch_template.0.version", "$Default"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigLaunchTemplate(rInt, "t2.nano"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &v),
+					resource.TestCheckResourceAttr("aws_instance.foo", "ami", "ami-4fccb37f"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "instance_type", "t2.nano"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_volumeTags(t *testing.T) {
 	var v ec2.Instance
 
@@ -1343,7 +1376,7 @@ func testAccCheckInstanceDestroyWithProviders(providers *[]*schema.Provider) res
 }
 
 func testAccCheckInstanceDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).ec2conn
+	conn := provider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_instance" {
@@ -1396,7 +1429,7 @@ func testAccCheckInstanceExistsWithProviders(n string, i *ec2.Instance, provider
 				continue
 			}
 
-			conn := provider.Meta().(*AWSClient).ec2conn
+			conn := provider.Meta().(*AWSClient).EC2()
 			resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
 				InstanceIds: []*string{aws.String(rs.Primary.ID)},
 			})
@@ -1433,9 +1466,104 @@ func TestInstanceTenancySchema(t *testing.T) {
 	}
 }
 
+func TestResourceAwsInstanceCustomizeDiff(t *testing.T) {
+	r := resourceAwsInstance()
+	meta := &AWSClient{}
+	launchTemplate := []interface{}{map[string]interface{}{"name": "test"}}
+
+	cases := []struct {
+		Config        map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Config: map[string]interface{}{"ami": "ami-4fccb37f", "instance_type": "t2.micro"},
+		},
+		{
+			Config:        map[string]interface{}{"instance_type": "t2.micro"},
+			ExpectedError: `"ami" must be set`,
+		},
+		{
+			Config:        map[string]interface{}{"ami": "ami-4fccb37f"},
+			ExpectedError: `"instance_type" must be set`,
+		},
+		{
+			Config: map[string]interface{}{"launch_template": launchTemplate},
+		},
+		{
+			Config: map[string]interface{}{"instance_type": "t2.nano", "launch_template": launchTemplate},
+		},
+	}
+
+	for i, tc := range cases {
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = r.Diff(nil, terraform.NewResourceConfig(raw), meta)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+			continue
+		}
+		if err == nil || !regexp.MustCompile(tc.ExpectedError).MatchString(err.Error()) {
+			t.Fatalf("%d: expected error matching %q, got %v", i, tc.ExpectedError, err)
+		}
+	}
+}
+
+func TestReadLaunchTemplateFromInstance(t *testing.T) {
+	tags := []*ec2.Tag{
+		{Key: aws.String("Name"), Value: aws.String("test")},
+		{Key: aws.String("aws:ec2launchtemplate:id"), Value: aws.String("lt-12345678")},
+		{Key: aws.String("aws:ec2launchtemplate:version"), Value: aws.String("3")},
+	}
+
+	cases := []struct {
+		Tags     []*ec2.Tag
+		Config   []interface{}
+		Expected []interface{}
+	}{
+		{
+			Tags:     []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+			Expected: []interface{}{},
+		},
+		{
+			Tags:     []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+			Config:   []interface{}{map[string]interface{}{"id": "lt-12345678", "name": "", "version": "$Default"}},
+			Expected: []interface{}{},
+		},
+		{
+			Tags:     tags,
+			Expected: []interface{}{map[string]interface{}{"id": "lt-12345678", "name": "", "version": "3"}},
+		},
+		{
+			Tags:     tags,
+			Config:   []interface{}{map[string]interface{}{"id": "lt-12345678", "name": "", "version": "$Latest"}},
+			Expected: []interface{}{map[string]interface{}{"id": "lt-12345678", "name": "", "version": "$Latest"}},
+		},
+		{
+			Tags:     tags,
+			Config:   []interface{}{map[string]interface{}{"id": "lt-87654321", "name": "", "version": "2"}},
+			Expected: []interface{}{map[string]interface{}{"id": "lt-12345678", "name": "", "version": "3"}},
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := readLaunchTemplateFromInstance(&ec2.Instance{Tags: tc.Tags}, nil, tc.Config)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, actual)
+		}
+	}
+}
+
 func driftTags(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		_, err := conn.CreateTags(&ec2.CreateTagsInput{
 			Resources: []*string{instance.InstanceId},
 			Tags: []*ec2.Tag{
@@ -2778,3 +2906,26 @@ resource "aws_instance" "foo" {
   }
 }`, rInt, rInt)
 }
+
+func testAccInstanceConfigLaunchTemplate(rInt int, instanceType string) string {
+	// The instance type of the launch template is used when left out
+	if instanceType != "" {
+		instanceType = fmt.Sprintf("instance_type = %q", instanceType)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "tf-acctest-%d"
+  image_id      = "ami-4fccb37f"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "foo" {
+  %s
+
+  launch_template {
+    name = "${aws_launch_template.foo.name}"
+  }
+}
+`, rInt, instanceType)
+}
@@ -23,18 +23,21 @@ func resourceAwsInternetGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Create the gateway
 	log.Printf("[DEBUG] Creating internet gateway")
@@ -75,7 +78,7 @@ func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	igRaw, _, err := IGStateRefreshFunc(conn, d.Id())()
 	if err != nil {
@@ -95,7 +98,7 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	d.Set("tags", tagsToMap(ig.Tags))
+	setTagsAll(d, meta, tagsToMap(ig.Tags))
 
 	return nil
 }
@@ -113,19 +116,20 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	if err := setTags(conn, d); err != nil {
 		return err
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return nil
 }
 
 func resourceAwsInternetGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Detach if it is attached
 	if err := resourceAwsInternetGatewayDetach(d, meta); err != nil {
@@ -159,7 +163,7 @@ func resourceAwsInternetGatewayDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsInternetGatewayAttach(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	if d.Get("vpc_id").(string) == "" {
 		log.Printf(
@@ -216,7 +220,7 @@ func resourceAwsInternetGatewayAttach(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsInternetGatewayDetach(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Get the old VPC ID to detach from
 	vpcID, _ := d.GetChange("vpc_id")
@@ -33,7 +33,7 @@ func resourceAwsIotCertificate() *schema.Resource {
 }
 
 func resourceAwsIotCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	log.Printf("[DEBUG] Creating certificate from csr")
 	out, err := conn.CreateCertificateFromCsr(&iot.CreateCertificateFromCsrInput{
@@ -53,7 +53,7 @@ func resourceAwsIotCertificateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsIotCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	out, err := conn.DescribeCertificate(&iot.DescribeCertificateInput{
 		CertificateId: aws.String(d.Id()),
@@ -71,7 +71,7 @@ func resourceAwsIotCertificateRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	if d.HasChange("active") {
 		status := iot.CertificateStatusInactive
@@ -94,7 +94,7 @@ func resourceAwsIotCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsIotCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	_, err := conn.UpdateCertificate(&iot.UpdateCertificateInput{
 		CertificateId: aws.String(d.Id()),
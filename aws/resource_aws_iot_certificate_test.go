@@ -30,7 +30,7 @@ func TestAccAWSIoTCertificate_basic(t *testing.T) {
 }
 
 func testAccCheckAWSIoTCertificateDestroy_basic(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_certificate" {
@@ -37,7 +37,7 @@ func resourceAwsIotPolicy() *schema.Resource {
 
 func resourceAwsIotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	out, err := conn.CreatePolicy(&iot.CreatePolicyInput{
 		PolicyName:     aws.String(d.Get("name").(string)),
@@ -55,7 +55,7 @@ func resourceAwsIotPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	out, err := conn.GetPolicy(&iot.GetPolicyInput{
 		PolicyName: aws.String(d.Id()),
@@ -73,7 +73,7 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	if d.HasChange("policy") {
 		_, err := conn.CreatePolicyVersion(&iot.CreatePolicyVersionInput{
@@ -93,7 +93,7 @@ func resourceAwsIotPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAwsIotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).IoT()
 
 	out, err := conn.ListPolicyVersions(&iot.ListPolicyVersionsInput{
 		PolicyName: aws.String(d.Id()),
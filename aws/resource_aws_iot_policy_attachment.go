@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIotPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotPolicyAttachmentCreate,
+		Read:   resourceAwsIotPolicyAttachmentRead,
+		Delete: resourceAwsIotPolicyAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	policy := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	params := &iot.AttachPolicyInput{
+		PolicyName: aws.String(policy),
+		Target:     aws.String(target),
+	}
+
+	log.Printf("[DEBUG] Attaching IoT Policy: %s", params)
+	_, err := conn.AttachPolicy(params)
+	if err != nil {
+		return fmt.Errorf("Error attaching IoT Policy %s to %s: %s", policy, target, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", policy, target))
+
+	return resourceAwsIotPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	policy := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	found, err := iotTargetHasPolicy(conn, target, policy)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Policy Attachment target (%s) not found, removing from state", target)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing IoT Policies attached to %s: %s", target, err)
+	}
+
+	if !found {
+		log.Printf("[WARN] IoT Policy Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	policy := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	params := &iot.DetachPolicyInput{
+		PolicyName: aws.String(policy),
+		Target:     aws.String(target),
+	}
+
+	log.Printf("[DEBUG] Detaching IoT Policy: %s", params)
+	_, err := conn.DetachPolicy(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error detaching IoT Policy %s from %s: %s", policy, target, err)
+	}
+
+	return nil
+}
+
+func iotTargetHasPolicy(conn *iot.IoT, target, policy string) (bool, error) {
+	params := &iot.ListAttachedPoliciesInput{
+		Target: aws.String(target),
+	}
+
+	for {
+		out, err := conn.ListAttachedPolicies(params)
+		if err != nil {
+			return false, err
+		}
+
+		for _, p := range out.Policies {
+			if aws.StringValue(p.PolicyName) == policy {
+				return true, nil
+			}
+		}
+
+		if out.NextMarker == nil || *out.NextMarker == "" {
+			return false, nil
+		}
+		params.Marker = out.NextMarker
+	}
+}
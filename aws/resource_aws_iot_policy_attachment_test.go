@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotPolicyAttachment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotPolicyAttachmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotPolicyAttachmentExists("aws_iot_policy_attachment.test"),
+					resource.TestCheckResourceAttr("aws_iot_policy_attachment.test", "policy", rName),
+					resource.TestCheckResourceAttrPair("aws_iot_policy_attachment.test", "target", "aws_iot_certificate.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Policy Attachment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).IoT()
+		found, err := iotTargetHasPolicy(conn, rs.Primary.Attributes["target"], rs.Primary.Attributes["policy"])
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("IoT Policy Attachment %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_policy_attachment" {
+			continue
+		}
+
+		found, err := iotTargetHasPolicy(conn, rs.Primary.Attributes["target"], rs.Primary.Attributes["policy"])
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("IoT Policy Attachment %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotPolicyAttachmentConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_certificate" "test" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+
+resource "aws_iot_policy" "test" {
+  name = "%s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["iot:*"],
+    "Resource": ["*"]
+  }]
+}
+EOF
+}
+
+resource "aws_iot_policy_attachment" "test" {
+  policy = "${aws_iot_policy.test.name}"
+  target = "${aws_iot_certificate.test.arn}"
+}
+`, rName)
+}
@@ -51,7 +51,7 @@ func TestAccAWSIoTPolicy_invalidJson(t *testing.T) {
 }
 
 func testAccCheckAWSIoTPolicyDestroy_basic(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_policy" {
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotRoleAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotRoleAliasCreate,
+		Read:   resourceAwsIotRoleAliasRead,
+		Update: resourceAwsIotRoleAliasUpdate,
+		Delete: resourceAwsIotRoleAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"credential_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(900, 3600),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotRoleAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.CreateRoleAliasInput{
+		CredentialDurationSeconds: aws.Int64(int64(d.Get("credential_duration").(int))),
+		RoleAlias:                 aws.String(d.Get("alias").(string)),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating IoT Role Alias: %s", params)
+	out, err := conn.CreateRoleAlias(params)
+	if err != nil {
+		return fmt.Errorf("Error creating IoT Role Alias: %s", err)
+	}
+
+	d.SetId(*out.RoleAlias)
+
+	return resourceAwsIotRoleAliasRead(d, meta)
+}
+
+func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.DescribeRoleAliasInput{
+		RoleAlias: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Reading IoT Role Alias: %s", params)
+	out, err := conn.DescribeRoleAlias(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Role Alias (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	alias := out.RoleAliasDescription
+
+	d.Set("alias", alias.RoleAlias)
+	d.Set("role_arn", alias.RoleArn)
+	d.Set("credential_duration", alias.CredentialDurationSeconds)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).Partition(),
+		Region:    meta.(*AWSClient).region,
+		Service:   "iot",
+		AccountID: meta.(*AWSClient).AccountID(),
+		Resource:  fmt.Sprintf("rolealias/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+
+	return nil
+}
+
+func resourceAwsIotRoleAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.UpdateRoleAliasInput{
+		CredentialDurationSeconds: aws.Int64(int64(d.Get("credential_duration").(int))),
+		RoleAlias:                 aws.String(d.Id()),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating IoT Role Alias: %s", params)
+	_, err := conn.UpdateRoleAlias(params)
+	if err != nil {
+		return fmt.Errorf("Error updating IoT Role Alias: %s", err)
+	}
+
+	return resourceAwsIotRoleAliasRead(d, meta)
+}
+
+func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.DeleteRoleAliasInput{
+		RoleAlias: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Deleting IoT Role Alias: %s", params)
+
+	_, err := conn.DeleteRoleAlias(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Role Alias: %s", err)
+	}
+
+	return nil
+}
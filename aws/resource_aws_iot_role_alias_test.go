@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotRoleAlias_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotRoleAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotRoleAliasConfig(rName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotRoleAliasExists("aws_iot_role_alias.test"),
+					resource.TestCheckResourceAttr("aws_iot_role_alias.test", "alias", rName),
+					resource.TestCheckResourceAttr("aws_iot_role_alias.test", "credential_duration", "3600"),
+					resource.TestCheckResourceAttrPair("aws_iot_role_alias.test", "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestMatchResourceAttr("aws_iot_role_alias.test", "arn", regexp.MustCompile(`:rolealias/`+rName+`$`)),
+				),
+			},
+			{
+				Config: testAccAWSIotRoleAliasConfig(rName, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotRoleAliasExists("aws_iot_role_alias.test"),
+					resource.TestCheckResourceAttr("aws_iot_role_alias.test", "credential_duration", "1800"),
+				),
+			},
+			{
+				ResourceName:      "aws_iot_role_alias.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotRoleAliasExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Role Alias ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).IoT()
+		_, err := conn.DescribeRoleAlias(&iot.DescribeRoleAliasInput{
+			RoleAlias: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSIotRoleAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_role_alias" {
+			continue
+		}
+
+		_, err := conn.DescribeRoleAlias(&iot.DescribeRoleAliasInput{
+			RoleAlias: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("IoT Role Alias %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotRoleAliasConfig(rName string, credentialDuration int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "credentials.iot.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iot_role_alias" "test" {
+  alias               = "%s"
+  role_arn            = "${aws_iam_role.test.arn}"
+  credential_duration = %d
+}
+`, rName, rName, credentialDuration)
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotThingPrincipalAttachment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingPrincipalAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingPrincipalAttachmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingPrincipalAttachmentExists("aws_iot_thing_principal_attachment.test"),
+					resource.TestCheckResourceAttr("aws_iot_thing_principal_attachment.test", "thing", rName),
+					resource.TestCheckResourceAttrPair("aws_iot_thing_principal_attachment.test", "principal", "aws_iot_certificate.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingPrincipalAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing Principal Attachment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).IoT()
+		found, err := iotThingHasPrincipal(conn, rs.Primary.Attributes["thing"], rs.Primary.Attributes["principal"])
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("IoT Thing Principal Attachment %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotThingPrincipalAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_principal_attachment" {
+			continue
+		}
+
+		found, err := iotThingHasPrincipal(conn, rs.Primary.Attributes["thing"], rs.Primary.Attributes["principal"])
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("IoT Thing Principal Attachment %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingPrincipalAttachmentConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_certificate" "test" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+
+resource "aws_iot_thing" "test" {
+  name = "%s"
+}
+
+resource "aws_iot_thing_principal_attachment" "test" {
+  thing     = "${aws_iot_thing.test.name}"
+  principal = "${aws_iot_certificate.test.arn}"
+}
+`, rName)
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotThing_basic(t *testing.T) {
+	var thing iot.DescribeThingOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingExists("aws_iot_thing.test", &thing),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "name", rName),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.%", "0"),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "thing_type_name", ""),
+					resource.TestCheckResourceAttrSet("aws_iot_thing.test", "arn"),
+					resource.TestCheckResourceAttrSet("aws_iot_thing.test", "default_client_id"),
+					resource.TestCheckResourceAttrSet("aws_iot_thing.test", "version"),
+				),
+			},
+			{
+				ResourceName:      "aws_iot_thing.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThing_full(t *testing.T) {
+	var thing iot.DescribeThingOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	thingTypeName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingConfig_full(rName, thingTypeName, "42"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingExists("aws_iot_thing.test", &thing),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.%", "3"),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.One", "11111"),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.Answer", "42"),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "thing_type_name", thingTypeName),
+				),
+			},
+			{
+				Config: testAccAWSIotThingConfig_full(rName, thingTypeName, "differentOne"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingExists("aws_iot_thing.test", &thing),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.%", "3"),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.Answer", "differentOne"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingExists("aws_iot_thing.test", &thing),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "attributes.%", "0"),
+					resource.TestCheckResourceAttr("aws_iot_thing.test", "thing_type_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingExists(n string, thing *iot.DescribeThingOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).IoT()
+		resp, err := conn.DescribeThing(&iot.DescribeThingInput{
+			ThingName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*thing = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotThingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing" {
+			continue
+		}
+
+		_, err := conn.DescribeThing(&iot.DescribeThingInput{
+			ThingName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("IoT Thing %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccAWSIotThingConfig_full(rName, thingTypeName, answer string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_type" "test" {
+  name = "%s"
+}
+
+resource "aws_iot_thing" "test" {
+  name = "%s"
+
+  attributes {
+    One    = "11111"
+    Two    = "TwoTwo"
+    Answer = "%s"
+  }
+
+  thing_type_name = "${aws_iot_thing_type.test.name}"
+}
+`, thingTypeName, rName, answer)
+}
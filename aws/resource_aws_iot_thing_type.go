@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotThingType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotThingTypeCreate,
+		Read:   resourceAwsIotThingTypeRead,
+		Update: resourceAwsIotThingTypeUpdate,
+		Delete: resourceAwsIotThingTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2028),
+						},
+						"searchable_attributes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 3,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+			},
+			"deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotThingTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.CreateThingTypeInput{
+		ThingTypeName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("properties"); ok {
+		params.ThingTypeProperties = expandIotThingTypeProperties(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating IoT Thing Type: %s", params)
+	out, err := conn.CreateThingType(params)
+	if err != nil {
+		return fmt.Errorf("Error creating IoT Thing Type: %s", err)
+	}
+
+	d.SetId(*out.ThingTypeName)
+
+	if d.Get("deprecated").(bool) {
+		params := &iot.DeprecateThingTypeInput{
+			ThingTypeName: aws.String(d.Id()),
+			UndoDeprecate: aws.Bool(false),
+		}
+
+		log.Printf("[DEBUG] Deprecating IoT Thing Type: %s", params)
+		_, err := conn.DeprecateThingType(params)
+		if err != nil {
+			return fmt.Errorf("Error deprecating IoT Thing Type: %s", err)
+		}
+	}
+
+	return resourceAwsIotThingTypeRead(d, meta)
+}
+
+func resourceAwsIotThingTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.DescribeThingTypeInput{
+		ThingTypeName: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Reading IoT Thing Type: %s", params)
+	out, err := conn.DescribeThingType(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Thing Type (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", out.ThingTypeName)
+	d.Set("arn", out.ThingTypeArn)
+
+	if out.ThingTypeMetadata != nil {
+		d.Set("deprecated", out.ThingTypeMetadata.Deprecated)
+	}
+
+	if err := d.Set("properties", flattenIotThingTypeProperties(out.ThingTypeProperties)); err != nil {
+		return fmt.Errorf("Error setting properties: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotThingTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	if d.HasChange("deprecated") {
+		params := &iot.DeprecateThingTypeInput{
+			ThingTypeName: aws.String(d.Id()),
+			UndoDeprecate: aws.Bool(!d.Get("deprecated").(bool)),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Thing Type deprecation: %s", params)
+		_, err := conn.DeprecateThingType(params)
+		if err != nil {
+			return fmt.Errorf("Error updating IoT Thing Type deprecation: %s", err)
+		}
+	}
+
+	return resourceAwsIotThingTypeRead(d, meta)
+}
+
+func resourceAwsIotThingTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	// A thing type must be deprecated before it can be deleted.
+	_, err := conn.DeprecateThingType(&iot.DeprecateThingTypeInput{
+		ThingTypeName: aws.String(d.Id()),
+		UndoDeprecate: aws.Bool(false),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deprecating IoT Thing Type: %s", err)
+	}
+
+	params := &iot.DeleteThingTypeInput{
+		ThingTypeName: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Deleting IoT Thing Type: %s", params)
+
+	// The deletion is rejected until five minutes after the deprecation.
+	err = resource.Retry(6*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteThingType(params)
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeInvalidRequestException, "Please wait for 5 minutes after deprecation") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Thing Type: %s", err)
+	}
+
+	return nil
+}
+
+func expandIotThingTypeProperties(l []interface{}) *iot.ThingTypeProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	properties := &iot.ThingTypeProperties{
+		SearchableAttributes: expandStringSet(m["searchable_attributes"].(*schema.Set)),
+	}
+	if v, ok := m["description"]; ok && v.(string) != "" {
+		properties.ThingTypeDescription = aws.String(v.(string))
+	}
+
+	return properties
+}
+
+func flattenIotThingTypeProperties(p *iot.ThingTypeProperties) []map[string]interface{} {
+	if p == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"description":           aws.StringValue(p.ThingTypeDescription),
+			"searchable_attributes": flattenStringList(p.SearchableAttributes),
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotThingType_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "name", rName),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "deprecated", "false"),
+					resource.TestCheckResourceAttrSet("aws_iot_thing_type.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_iot_thing_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingType_full(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingTypeConfig_full(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "properties.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "properties.0.description", "MyDescription"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "properties.0.searchable_attributes.#", "3"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "deprecated", "true"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingTypeConfig_full(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iot_thing_type.test", "deprecated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_type" {
+			continue
+		}
+
+		_, err := conn.DescribeThingType(&iot.DescribeThingTypeInput{
+			ThingTypeName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("IoT Thing Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_type" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccAWSIotThingTypeConfig_full(rName string, deprecated bool) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_type" "test" {
+  name       = "%s"
+  deprecated = %t
+
+  properties {
+    description           = "MyDescription"
+    searchable_attributes = ["foo", "bar", "baz"]
+  }
+}
+`, rName, deprecated)
+}
@@ -0,0 +1,736 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotTopicRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotTopicRuleCreate,
+		Read:   resourceAwsIotTopicRuleRead,
+		Update: resourceAwsIotTopicRuleUpdate,
+		Delete: resourceAwsIotTopicRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIoTTopicRuleName,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"sql": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sql_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cloudwatch_alarm": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"state_reason": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"state_value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"OK",
+								"ALARM",
+								"INSUFFICIENT_DATA",
+							}, false),
+						},
+					},
+				},
+			},
+			"cloudwatch_metric": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_timestamp": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric_unit": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"dynamodb": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hash_key_field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"hash_key_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.DynamoKeyTypeString,
+								iot.DynamoKeyTypeNumber,
+							}, false),
+						},
+						"hash_key_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operation": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"payload_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"range_key_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"range_key_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.DynamoKeyTypeString,
+								iot.DynamoKeyTypeNumber,
+							}, false),
+						},
+						"range_key_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"elasticsearch": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"index": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"firehose": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivery_stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"separator": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"\n",
+								"\t",
+								"\r\n",
+								",",
+							}, false),
+						},
+					},
+				},
+			},
+			"kinesis": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"lambda": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"republish": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"s3": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"canned_acl": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.CannedAccessControlListPrivate,
+								iot.CannedAccessControlListPublicRead,
+								iot.CannedAccessControlListPublicReadWrite,
+								iot.CannedAccessControlListAwsExecRead,
+								iot.CannedAccessControlListAuthenticatedRead,
+								iot.CannedAccessControlListBucketOwnerRead,
+								iot.CannedAccessControlListBucketOwnerFullControl,
+								iot.CannedAccessControlListLogDeliveryWrite,
+							}, false),
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"sns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  iot.MessageFormatRaw,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.MessageFormatRaw,
+								iot.MessageFormatJson,
+							}, false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"target_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"sqs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"queue_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"use_base64": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotTopicRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.CreateTopicRuleInput{
+		RuleName:         aws.String(d.Get("name").(string)),
+		TopicRulePayload: expandIotTopicRulePayload(d),
+	}
+
+	log.Printf("[DEBUG] Creating IoT Topic Rule: %s", params)
+	_, err := conn.CreateTopicRule(params)
+	if err != nil {
+		return fmt.Errorf("Error creating IoT Topic Rule: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceAwsIotTopicRuleRead(d, meta)
+}
+
+func resourceAwsIotTopicRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.GetTopicRuleInput{
+		RuleName: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Reading IoT Topic Rule: %s", params)
+	out, err := conn.GetTopicRule(params)
+	if err != nil {
+		// A rule that does not exist is reported as unauthorized.
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") || isAWSErr(err, iot.ErrCodeUnauthorizedException, "") {
+			log.Printf("[WARN] IoT Topic Rule (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	rule := out.Rule
+
+	d.Set("arn", out.RuleArn)
+	d.Set("name", rule.RuleName)
+	d.Set("description", rule.Description)
+	d.Set("enabled", !aws.BoolValue(rule.RuleDisabled))
+	d.Set("sql", rule.Sql)
+	d.Set("sql_version", rule.AwsIotSqlVersion)
+
+	for k, v := range flattenIotTopicRuleActions(rule.Actions) {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.ReplaceTopicRuleInput{
+		RuleName:         aws.String(d.Id()),
+		TopicRulePayload: expandIotTopicRulePayload(d),
+	}
+
+	log.Printf("[DEBUG] Updating IoT Topic Rule: %s", params)
+	_, err := conn.ReplaceTopicRule(params)
+	if err != nil {
+		return fmt.Errorf("Error updating IoT Topic Rule: %s", err)
+	}
+
+	return resourceAwsIotTopicRuleRead(d, meta)
+}
+
+func resourceAwsIotTopicRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).IoT()
+
+	params := &iot.DeleteTopicRuleInput{
+		RuleName: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Deleting IoT Topic Rule: %s", params)
+
+	_, err := conn.DeleteTopicRule(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") || isAWSErr(err, iot.ErrCodeUnauthorizedException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Topic Rule: %s", err)
+	}
+
+	return nil
+}
+
+func expandIotTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
+	payload := &iot.TopicRulePayload{
+		Actions:          make([]*iot.Action, 0),
+		AwsIotSqlVersion: aws.String(d.Get("sql_version").(string)),
+		RuleDisabled:     aws.Bool(!d.Get("enabled").(bool)),
+		Sql:              aws.String(d.Get("sql").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		payload.Description = aws.String(v.(string))
+	}
+
+	for _, a := range d.Get("cloudwatch_alarm").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		payload.Actions = append(payload.Actions, &iot.Action{
+			CloudwatchAlarm: &iot.CloudwatchAlarmAction{
+				AlarmName:   aws.String(m["alarm_name"].(string)),
+				RoleArn:     aws.String(m["role_arn"].(string)),
+				StateReason: aws.String(m["state_reason"].(string)),
+				StateValue:  aws.String(m["state_value"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("cloudwatch_metric").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		action := &iot.CloudwatchMetricAction{
+			MetricName:      aws.String(m["metric_name"].(string)),
+			MetricNamespace: aws.String(m["metric_namespace"].(string)),
+			MetricUnit:      aws.String(m["metric_unit"].(string)),
+			MetricValue:     aws.String(m["metric_value"].(string)),
+			RoleArn:         aws.String(m["role_arn"].(string)),
+		}
+		if v := m["metric_timestamp"].(string); v != "" {
+			action.MetricTimestamp = aws.String(v)
+		}
+		payload.Actions = append(payload.Actions, &iot.Action{CloudwatchMetric: action})
+	}
+
+	for _, a := range d.Get("dynamodb").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		action := &iot.DynamoDBAction{
+			HashKeyField: aws.String(m["hash_key_field"].(string)),
+			HashKeyValue: aws.String(m["hash_key_value"].(string)),
+			RoleArn:      aws.String(m["role_arn"].(string)),
+			TableName:    aws.String(m["table_name"].(string)),
+		}
+		if v := m["hash_key_type"].(string); v != "" {
+			action.HashKeyType = aws.String(v)
+		}
+		if v := m["operation"].(string); v != "" {
+			action.Operation = aws.String(v)
+		}
+		if v := m["payload_field"].(string); v != "" {
+			action.PayloadField = aws.String(v)
+		}
+		if v := m["range_key_field"].(string); v != "" {
+			action.RangeKeyField = aws.String(v)
+		}
+		if v := m["range_key_type"].(string); v != "" {
+			action.RangeKeyType = aws.String(v)
+		}
+		if v := m["range_key_value"].(string); v != "" {
+			action.RangeKeyValue = aws.String(v)
+		}
+		payload.Actions = append(payload.Actions, &iot.Action{DynamoDB: action})
+	}
+
+	for _, a := range d.Get("elasticsearch").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		payload.Actions = append(payload.Actions, &iot.Action{
+			Elasticsearch: &iot.ElasticsearchAction{
+				Endpoint: aws.String(m["endpoint"].(string)),
+				Id:       aws.String(m["id"].(string)),
+				Index:    aws.String(m["index"].(string)),
+				RoleArn:  aws.String(m["role_arn"].(string)),
+				Type:     aws.String(m["type"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("firehose").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		action := &iot.FirehoseAction{
+			DeliveryStreamName: aws.String(m["delivery_stream_name"].(string)),
+			RoleArn:            aws.String(m["role_arn"].(string)),
+		}
+		if v := m["separator"].(string); v != "" {
+			action.Separator = aws.String(v)
+		}
+		payload.Actions = append(payload.Actions, &iot.Action{Firehose: action})
+	}
+
+	for _, a := range d.Get("kinesis").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		action := &iot.KinesisAction{
+			RoleArn:    aws.String(m["role_arn"].(string)),
+			StreamName: aws.String(m["stream_name"].(string)),
+		}
+		if v := m["partition_key"].(string); v != "" {
+			action.PartitionKey = aws.String(v)
+		}
+		payload.Actions = append(payload.Actions, &iot.Action{Kinesis: action})
+	}
+
+	for _, a := range d.Get("lambda").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		payload.Actions = append(payload.Actions, &iot.Action{
+			Lambda: &iot.LambdaAction{
+				FunctionArn: aws.String(m["function_arn"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("republish").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		payload.Actions = append(payload.Actions, &iot.Action{
+			Republish: &iot.RepublishAction{
+				RoleArn: aws.String(m["role_arn"].(string)),
+				Topic:   aws.String(m["topic"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("s3").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		action := &iot.S3Action{
+			BucketName: aws.String(m["bucket_name"].(string)),
+			Key:        aws.String(m["key"].(string)),
+			RoleArn:    aws.String(m["role_arn"].(string)),
+		}
+		if v := m["canned_acl"].(string); v != "" {
+			action.CannedAcl = aws.String(v)
+		}
+		payload.Actions = append(payload.Actions, &iot.Action{S3: action})
+	}
+
+	for _, a := range d.Get("sns").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		payload.Actions = append(payload.Actions, &iot.Action{
+			Sns: &iot.SnsAction{
+				MessageFormat: aws.String(m["message_format"].(string)),
+				RoleArn:       aws.String(m["role_arn"].(string)),
+				TargetArn:     aws.String(m["target_arn"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("sqs").(*schema.Set).List() {
+		m := a.(map[string]interface{})
+		payload.Actions = append(payload.Actions, &iot.Action{
+			Sqs: &iot.SqsAction{
+				QueueUrl:  aws.String(m["queue_url"].(string)),
+				RoleArn:   aws.String(m["role_arn"].(string)),
+				UseBase64: aws.Bool(m["use_base64"].(bool)),
+			},
+		})
+	}
+
+	return payload
+}
+
+// flattenIotTopicRuleActions groups the actions of a topic rule by the
+// attribute they are configured with.
+func flattenIotTopicRuleActions(actions []*iot.Action) map[string][]map[string]interface{} {
+	result := map[string][]map[string]interface{}{
+		"cloudwatch_alarm":  {},
+		"cloudwatch_metric": {},
+		"dynamodb":          {},
+		"elasticsearch":     {},
+		"firehose":          {},
+		"kinesis":           {},
+		"lambda":            {},
+		"republish":         {},
+		"s3":                {},
+		"sns":               {},
+		"sqs":               {},
+	}
+
+	for _, a := range actions {
+		if v := a.CloudwatchAlarm; v != nil {
+			result["cloudwatch_alarm"] = append(result["cloudwatch_alarm"], map[string]interface{}{
+				"alarm_name":   aws.StringValue(v.AlarmName),
+				"role_arn":     aws.StringValue(v.RoleArn),
+				"state_reason": aws.StringValue(v.StateReason),
+				"state_value":  aws.StringValue(v.StateValue),
+			})
+		}
+
+		if v := a.CloudwatchMetric; v != nil {
+			result["cloudwatch_metric"] = append(result["cloudwatch_metric"], map[string]interface{}{
+				"metric_name":      aws.StringValue(v.MetricName),
+				"metric_namespace": aws.StringValue(v.MetricNamespace),
+				"metric_timestamp": aws.StringValue(v.MetricTimestamp),
+				"metric_unit":      aws.StringValue(v.MetricUnit),
+				"metric_value":     aws.StringValue(v.MetricValue),
+				"role_arn":         aws.StringValue(v.RoleArn),
+			})
+		}
+
+		if v := a.DynamoDB; v != nil {
+			result["dynamodb"] = append(result["dynamodb"], map[string]interface{}{
+				"hash_key_field":  aws.StringValue(v.HashKeyField),
+				"hash_key_type":   aws.StringValue(v.HashKeyType),
+				"hash_key_value":  aws.StringValue(v.HashKeyValue),
+				"operation":       aws.StringValue(v.Operation),
+				"payload_field":   aws.StringValue(v.PayloadField),
+				"range_key_field": aws.StringValue(v.RangeKeyField),
+				"range_key_type":  aws.StringValue(v.RangeKeyType),
+				"range_key_value": aws.StringValue(v.RangeKeyValue),
+				"role_arn":        aws.StringValue(v.RoleArn),
+				"table_name":      aws.StringValue(v.TableName),
+			})
+		}
+
+		if v := a.Elasticsearch; v != nil {
+			result["elasticsearch"] = append(result["elasticsearch"], map[string]interface{}{
+				"endpoint": aws.StringValue(v.Endpoint),
+				"id":       aws.StringValue(v.Id),
+				"index":    aws.StringValue(v.Index),
+				"role_arn": aws.StringValue(v.RoleArn),
+				"type":     aws.StringValue(v.Type),
+			})
+		}
+
+		if v := a.Firehose; v != nil {
+			result["firehose"] = append(result["firehose"], map[string]interface{}{
+				"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+				"role_arn":             aws.StringValue(v.RoleArn),
+				"separator":            aws.StringValue(v.Separator),
+			})
+		}
+
+		if v := a.Kinesis; v != nil {
+			result["kinesis"] = append(result["kinesis"], map[string]interface{}{
+				"partition_key": aws.StringValue(v.PartitionKey),
+				"role_arn":      aws.StringValue(v.RoleArn),
+				"stream_name":   aws.StringValue(v.StreamName),
+			})
+		}
+
+		if v := a.Lambda; v != nil {
+			result["lambda"] = append(result["lambda"], map[string]interface{}{
+				"function_arn": aws.StringValue(v.FunctionArn),
+			})
+		}
+
+		if v := a.Republish; v != nil {
+			result["republish"] = append(result["republish"], map[string]interface{}{
+				"role_arn": aws.StringValue(v.RoleArn),
+				"topic":    aws.StringValue(v.Topic),
+			})
+		}
+
+		if v := a.S3; v != nil {
+			result["s3"] = append(result["s3"], map[string]interface{}{
+				"bucket_name": aws.StringValue(v.BucketName),
+				"canned_acl":  aws.StringValue(v.CannedAcl),
+				"key":         aws.StringValue(v.Key),
+				"role_arn":    aws.StringValue(v.RoleArn),
+			})
+		}
+
+		if v := a.Sns; v != nil {
+			result["sns"] = append(result["sns"], map[string]interface{}{
+				"message_format": aws.StringValue(v.MessageFormat),
+				"role_arn":       aws.StringValue(v.RoleArn),
+				"target_arn":     aws.StringValue(v.TargetArn),
+			})
+		}
+
+		if v := a.Sqs; v != nil {
+			result["sqs"] = append(result["sqs"], map[string]interface{}{
+				"queue_url":  aws.StringValue(v.QueueUrl),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"use_base64": aws.BoolValue(v.UseBase64),
+			})
+		}
+	}
+
+	return result
+}
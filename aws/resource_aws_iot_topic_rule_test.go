@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIoTTopicRule_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIoTTopicRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIoTTopicRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIoTTopicRuleExists("aws_iot_topic_rule.test"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "name", rName),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "description", "Example rule"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "sql", "SELECT * FROM 'topic/test'"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "sql_version", "2015-10-08"),
+					resource.TestCheckResourceAttrSet("aws_iot_topic_rule.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_iot_topic_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIoTTopicRule_actions(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIoTTopicRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIoTTopicRuleConfig_actions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIoTTopicRuleExists("aws_iot_topic_rule.test"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "cloudwatch_alarm.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "cloudwatch_metric.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "dynamodb.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "firehose.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "kinesis.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "republish.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "s3.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "sns.#", "1"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "sqs.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIoTTopicRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIoTTopicRuleExists("aws_iot_topic_rule.test"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "cloudwatch_alarm.#", "0"),
+					resource.TestCheckResourceAttr("aws_iot_topic_rule.test", "sqs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIoTTopicRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Topic Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).IoT()
+		_, err := conn.GetTopicRule(&iot.GetTopicRuleInput{
+			RuleName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSIoTTopicRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).IoT()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_topic_rule" {
+			continue
+		}
+
+		_, err := conn.GetTopicRule(&iot.GetTopicRuleInput{
+			RuleName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") || isAWSErr(err, iot.ErrCodeUnauthorizedException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("IoT Topic Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIoTTopicRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = "%s"
+  description = "Example rule"
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+}
+`, rName)
+}
+
+func testAccAWSIoTTopicRuleConfig_actions(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {
+  current = true
+}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "iot.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iot_topic_rule" "test" {
+  name        = "%s"
+  description = "Example rule"
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  cloudwatch_alarm {
+    alarm_name   = "myalarm"
+    role_arn     = "${aws_iam_role.test.arn}"
+    state_reason = "test"
+    state_value  = "OK"
+  }
+
+  cloudwatch_metric {
+    metric_name      = "FakeData"
+    metric_namespace = "FakeData"
+    metric_value     = "FakeData"
+    metric_unit      = "Count"
+    role_arn         = "${aws_iam_role.test.arn}"
+  }
+
+  dynamodb {
+    hash_key_field = "hash_key_field"
+    hash_key_value = "hash_key_value"
+    payload_field  = "payload_field"
+    role_arn       = "${aws_iam_role.test.arn}"
+    table_name     = "table_name"
+  }
+
+  firehose {
+    delivery_stream_name = "mystream"
+    role_arn             = "${aws_iam_role.test.arn}"
+  }
+
+  kinesis {
+    stream_name = "mystream"
+    role_arn    = "${aws_iam_role.test.arn}"
+  }
+
+  republish {
+    role_arn = "${aws_iam_role.test.arn}"
+    topic    = "mytopic"
+  }
+
+  s3 {
+    bucket_name = "mybucket"
+    key         = "mykey"
+    role_arn    = "${aws_iam_role.test.arn}"
+  }
+
+  sns {
+    message_format = "RAW"
+    role_arn       = "${aws_iam_role.test.arn}"
+    target_arn     = "arn:aws:sns:${data.aws_region.current.name}:123456789012:my_corporate_topic"
+  }
+
+  sqs {
+    queue_url  = "https://sqs.${data.aws_region.current.name}.amazonaws.com/123456789012/my_corporate_queue"
+    role_arn   = "${aws_iam_role.test.arn}"
+    use_base64 = false
+  }
+}
+`, rName, rName)
+}
@@ -1069,7 +1069,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		return validateError
 	}
 
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).Firehose()
 
 	sn := d.Get("name").(string)
 
@@ -1197,7 +1197,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 		return validateError
 	}
 
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).Firehose()
 
 	sn := d.Get("name").(string)
 
@@ -1241,7 +1241,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).Firehose()
 
 	resp, err := conn.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
 		DeliveryStreamName: aws.String(d.Get("name").(string)),
@@ -1268,7 +1268,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsKinesisFirehoseDeliveryStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).Firehose()
 
 	sn := d.Get("name").(string)
 	_, err := conn.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{
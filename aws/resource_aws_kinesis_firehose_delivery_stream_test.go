@@ -366,7 +366,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, stream *firehose.
 			return fmt.Errorf("No Kinesis Firehose ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).Firehose()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -491,7 +491,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 		if rs.Type != "aws_kinesis_firehose_delivery_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).Firehose()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -510,7 +510,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 }
 
 func testAccCheckFirehoseLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).Lambda()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
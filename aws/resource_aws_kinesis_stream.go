@@ -31,6 +31,8 @@ func resourceAwsKinesisStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -87,7 +89,8 @@ func resourceAwsKinesisStream() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -99,7 +102,7 @@ func resourceAwsKinesisStreamImport(
 }
 
 func resourceAwsKinesisStreamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).Kinesis()
 	sn := d.Get("name").(string)
 	createOpts := &kinesis.CreateStreamInput{
 		ShardCount: aws.Int64(int64(d.Get("shard_count").(int))),
@@ -149,7 +152,7 @@ func resourceAwsKinesisStreamCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).Kinesis()
 
 	d.Partial(true)
 	if err := setTagsKinesis(conn, d); err != nil {
@@ -157,6 +160,7 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	if err := updateKinesisShardCount(conn, d); err != nil {
@@ -177,7 +181,7 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).Kinesis()
 	sn := d.Get("name").(string)
 
 	state, err := readKinesisStreamState(conn, sn)
@@ -212,14 +216,14 @@ func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving tags for Stream: %s. %s", sn, err)
 	} else {
-		d.Set("tags", tagsToMapKinesis(tagsResp.Tags))
+		setTagsAll(d, meta, tagsToMapKinesis(tagsResp.Tags))
 	}
 
 	return nil
 }
 
 func resourceAwsKinesisStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).Kinesis()
 	sn := d.Get("name").(string)
 	_, err := conn.DeleteStream(&kinesis.DeleteStreamInput{
 		StreamName: aws.String(sn),
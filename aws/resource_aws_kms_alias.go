@@ -58,7 +58,7 @@ func resourceAwsKmsAlias() *schema.Resource {
 }
 
 func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -90,7 +90,7 @@ func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	var alias *kms.AliasListEntry
 	var err error
@@ -117,7 +117,7 @@ func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	if d.HasChange("target_key_id") {
 		err := resourceAwsKmsAliasTargetUpdate(conn, d)
@@ -144,7 +144,7 @@ func resourceAwsKmsAliasTargetUpdate(conn *kms.KMS, d *schema.ResourceData) erro
 }
 
 func resourceAwsKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	req := &kms.DeleteAliasInput{
 		AliasName: aws.String(d.Id()),
@@ -90,7 +90,7 @@ func TestAccAWSKmsAlias_multiple(t *testing.T) {
 }
 
 func testAccCheckAWSKmsAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+	conn := testAccProvider.Meta().(*AWSClient).KMS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_alias" {
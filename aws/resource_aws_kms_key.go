@@ -25,6 +25,8 @@ func resourceAwsKmsKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -82,13 +84,14 @@ func resourceAwsKmsKey() *schema.Resource {
 					return
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	// Allow aws to chose default values if we don't pass them
 	var req kms.CreateKeyInput
@@ -101,7 +104,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
@@ -129,7 +132,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -202,13 +205,13 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
 	}
 	tagList := tOut.(*kms.ListResourceTagsOutput)
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
+	setTagsAll(d, meta, tagsToMapKMS(tagList.Tags))
 
 	return nil
 }
 
 func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	// We expect new keys to be enabled already
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) && !d.IsNewResource() {
@@ -415,7 +418,7 @@ func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData) error {
 }
 
 func resourceAwsKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -439,7 +442,7 @@ func resourceAwsKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 }
 
 func resourceAwsKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).KMS()
 	keyId := d.Get("key_id").(string)
 
 	req := &kms.ScheduleKeyDeletionInput{
@@ -115,7 +115,7 @@ func TestAccAWSLambdaEventSourceMapping_disappears(t *testing.T) {
 
 func testAccCheckAWSLambdaEventSourceMappingDisappears(conf *lambda.EventSourceMappingConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).Lambda()
 
 		params := &lambda.DeleteEventSourceMappingInput{
 			UUID: conf.UUID,
@@ -148,7 +148,7 @@ func testAccCheckAWSLambdaEventSourceMappingDisappears(conf *lambda.EventSourceM
 }
 
 func testAccCheckLambdaEventSourceMappingDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).Lambda()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_event_source_mapping" {
@@ -181,7 +181,7 @@ func testAccCheckAwsLambdaEventSourceMappingExists(n string, mapping *lambda.Eve
 			return fmt.Errorf("Lambda event source mapping ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).Lambda()
 
 		params := &lambda.GetEventSourceMappingInput{
 			UUID: aws.String(rs.Primary.ID),
@@ -397,7 +397,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *LambdaPolicyStateme
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).Lambda()
 
 		// IAM is eventually consistent
 		var foundStatement *LambdaPolicyStatement
@@ -429,7 +429,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *LambdaPolicyStateme
 }
 
 func testAccCheckAWSLambdaPermissionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).Lambda()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_permission" {
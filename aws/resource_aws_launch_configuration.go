@@ -284,8 +284,8 @@ func resourceAwsLaunchConfiguration() *schema.Resource {
 }
 
 func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
+	ec2conn := meta.(*AWSClient).EC2()
 
 	createLaunchConfigurationOpts := autoscaling.CreateLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(d.Get("name").(string)),
@@ -491,8 +491,8 @@ func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
+	ec2conn := meta.(*AWSClient).EC2()
 
 	describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 		LaunchConfigurationNames: []*string{aws.String(d.Id())},
@@ -540,7 +540,7 @@ func resourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).AutoScaling()
 
 	log.Printf("[DEBUG] Launch Configuration destroy: %v", d.Id())
 	_, err := autoscalingconn.DeleteLaunchConfiguration(
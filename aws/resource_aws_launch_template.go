@@ -0,0 +1,1146 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// launchTemplateDataKeys are the arguments making up the launch template
+// data. Changing any of them creates a new version of the launch template.
+var launchTemplateDataKeys = []string{
+	"description",
+	"block_device_mappings",
+	"credit_specification",
+	"disable_api_termination",
+	"ebs_optimized",
+	"elastic_gpu_specifications",
+	"iam_instance_profile",
+	"image_id",
+	"instance_initiated_shutdown_behavior",
+	"instance_market_options",
+	"instance_type",
+	"kernel_id",
+	"key_name",
+	"monitoring",
+	"network_interfaces",
+	"placement",
+	"ram_disk_id",
+	"security_group_names",
+	"tag_specifications",
+	"user_data",
+	"vpc_security_group_ids",
+}
+
+func resourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLaunchTemplateCreate,
+		Read:   resourceAwsLaunchTemplateRead,
+		Update: resourceAwsLaunchTemplateUpdate,
+		Delete: resourceAwsLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsLaunchTemplateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateLaunchTemplateName,
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLaunchTemplateNamePrefix,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(255),
+			},
+
+			"default_version": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"update_default_version"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+
+			"update_default_version": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"default_version"},
+			},
+
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.VolumeTypeStandard,
+											ec2.VolumeTypeIo1,
+											ec2.VolumeTypeGp2,
+											ec2.VolumeTypeSc1,
+											ec2.VolumeTypeSt1,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"standard", "unlimited"}, false),
+						},
+					},
+				},
+			},
+
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"elastic_gpu_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.name"},
+							ValidateFunc:  validateArn,
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.arn"},
+						},
+					},
+				},
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ShutdownBehaviorStop,
+					ec2.ShutdownBehaviorTerminate,
+				}, false),
+			},
+
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{ec2.MarketTypeSpot}, false),
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.InstanceInterruptionBehaviorHibernate,
+											ec2.InstanceInterruptionBehaviorStop,
+											ec2.InstanceInterruptionBehaviorTerminate,
+										}, false),
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.SpotInstanceTypeOneTime,
+											ec2.SpotInstanceTypePersistent,
+										}, false),
+									},
+									"valid_until": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateRFC3339TimeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv6_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"placement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"spread_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.TenancyDedicated,
+								ec2.TenancyDefault,
+								ec2.TenancyHost,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"security_group_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"vpc_security_group_ids"},
+			},
+
+			"vpc_security_group_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"security_group_names"},
+			},
+
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ResourceTypeInstance,
+								ec2.ResourceTypeVolume,
+							}, false),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+// resourceAwsLaunchTemplateCustomizeDiff plans the new latest_version, and
+// default_version when update_default_version is set, of a launch template
+// whose data changes.
+func resourceAwsLaunchTemplateCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := setTagsAllDiff(diff, meta); err != nil {
+		return err
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, k := range launchTemplateDataKeys {
+		if diff.HasChange(k) {
+			if err := diff.SetNewComputed("latest_version"); err != nil {
+				return err
+			}
+			if diff.Get("update_default_version").(bool) {
+				return diff.SetNewComputed("default_version")
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	var ltName string
+	if v, ok := d.GetOk("name"); ok {
+		ltName = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		ltName = resource.PrefixedUniqueId(v.(string))
+	} else {
+		ltName = resource.UniqueId()
+	}
+
+	// The first version of a new launch template is its default one
+	if v, ok := d.GetOk("default_version"); ok && v.(int) != 1 {
+		return fmt.Errorf("default_version of a new Launch Template can only be 1, got %d", v.(int))
+	}
+
+	ltData, err := buildLaunchTemplateData(d)
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateName: aws.String(ltName),
+		LaunchTemplateData: ltData,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Launch Template: %s", input)
+	resp, err := conn.CreateLaunchTemplate(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Launch Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.LaunchTemplate.LaunchTemplateId))
+	log.Printf("[INFO] Launch Template ID: %s", d.Id())
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.EC2()
+
+	log.Printf("[DEBUG] Reading Launch Template %s", d.Id())
+	resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") ||
+			isAWSErr(err, "InvalidLaunchTemplateId.Malformed", "") {
+			log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Launch Template %s: %s", d.Id(), err)
+	}
+	if len(resp.LaunchTemplates) == 0 {
+		log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lt := resp.LaunchTemplates[0]
+
+	version := strconv.FormatInt(aws.Int64Value(lt.LatestVersionNumber), 10)
+	dltv, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(d.Id()),
+		Versions:         []*string{aws.String(version)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading version %s of Launch Template %s: %s", version, d.Id(), err)
+	}
+	if len(dltv.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("Version %s of Launch Template %s not found", version, d.Id())
+	}
+
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("arn", arn.ARN{
+		Partition: client.Partition(),
+		Region:    client.region,
+		Service:   "ec2",
+		AccountID: client.AccountID(),
+		Resource:  fmt.Sprintf("launch-template/%s", d.Id()),
+	}.String())
+	if err := setTagsAll(d, meta, tagsToMap(lt.Tags)); err != nil {
+		return err
+	}
+
+	ltv := dltv.LaunchTemplateVersions[0]
+	d.Set("description", ltv.VersionDescription)
+
+	return setLaunchTemplateData(d, ltv.LaunchTemplateData)
+}
+
+func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	d.Partial(true)
+
+	latestVersion := int64(d.Get("latest_version").(int))
+
+	if launchTemplateDataChanged(d) {
+		ltData, err := buildLaunchTemplateData(d)
+		if err != nil {
+			return err
+		}
+
+		input := &ec2.CreateLaunchTemplateVersionInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			LaunchTemplateId:   aws.String(d.Id()),
+			LaunchTemplateData: ltData,
+		}
+		if v, ok := d.GetOk("description"); ok {
+			input.VersionDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Launch Template version: %s", input)
+		resp, err := conn.CreateLaunchTemplateVersion(input)
+		if err != nil {
+			return fmt.Errorf("Error creating version of Launch Template %s: %s", d.Id(), err)
+		}
+		latestVersion = aws.Int64Value(resp.LaunchTemplateVersion.VersionNumber)
+
+		for _, k := range launchTemplateDataKeys {
+			d.SetPartial(k)
+		}
+	}
+
+	var defaultVersion string
+	if d.Get("update_default_version").(bool) {
+		if latestVersion != int64(d.Get("default_version").(int)) {
+			defaultVersion = strconv.FormatInt(latestVersion, 10)
+		}
+	} else if d.HasChange("default_version") {
+		defaultVersion = strconv.Itoa(d.Get("default_version").(int))
+	}
+
+	if defaultVersion != "" {
+		log.Printf("[DEBUG] Setting default version of Launch Template %s to %s", d.Id(), defaultVersion)
+		_, err := conn.ModifyLaunchTemplate(&ec2.ModifyLaunchTemplateInput{
+			ClientToken:      aws.String(resource.UniqueId()),
+			LaunchTemplateId: aws.String(d.Id()),
+			DefaultVersion:   aws.String(defaultVersion),
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting default version of Launch Template %s: %s", d.Id(), err)
+		}
+
+		d.SetPartial("default_version")
+		d.SetPartial("update_default_version")
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	} else {
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	log.Printf("[DEBUG] Deleting Launch Template: %s", d.Id())
+	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Launch Template %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// launchTemplateDataChanged returns whether any of the launch template data
+// arguments changed.
+func launchTemplateDataChanged(d *schema.ResourceData) bool {
+	for _, k := range launchTemplateDataKeys {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildLaunchTemplateData returns the launch template data configured in a
+// launch template resource.
+func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
+	opts := &ec2.RequestLaunchTemplateData{
+		DisableApiTermination: aws.Bool(d.Get("disable_api_termination").(bool)),
+		EbsOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		opts.ImageId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_initiated_shutdown_behavior"); ok {
+		opts.InstanceInitiatedShutdownBehavior = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("kernel_id"); ok {
+		opts.KernelId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("key_name"); ok {
+		opts.KeyName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("ram_disk_id"); ok {
+		opts.RamDiskId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("user_data"); ok {
+		opts.UserData = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_group_names"); ok {
+		opts.SecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		opts.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	for _, v := range d.Get("block_device_mappings").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		opts.BlockDeviceMappings = append(opts.BlockDeviceMappings, expandLaunchTemplateBlockDeviceMapping(v.(map[string]interface{})))
+	}
+
+	if v := d.Get("credit_specification").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		opts.CreditSpecification = &ec2.CreditSpecificationRequest{
+			CpuCredits: aws.String(m["cpu_credits"].(string)),
+		}
+	}
+
+	for _, v := range d.Get("elastic_gpu_specifications").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		opts.ElasticGpuSpecifications = append(opts.ElasticGpuSpecifications, &ec2.ElasticGpuSpecification{
+			Type: aws.String(m["type"].(string)),
+		})
+	}
+
+	if v := d.Get("iam_instance_profile").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		profile := &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+		if v := m["arn"].(string); v != "" {
+			profile.Arn = aws.String(v)
+		}
+		if v := m["name"].(string); v != "" {
+			profile.Name = aws.String(v)
+		}
+		opts.IamInstanceProfile = profile
+	}
+
+	if v := d.Get("instance_market_options").([]interface{}); len(v) > 0 && v[0] != nil {
+		marketOptions, err := expandLaunchTemplateInstanceMarketOptions(v[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		opts.InstanceMarketOptions = marketOptions
+	}
+
+	if v := d.Get("monitoring").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		opts.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{
+			Enabled: aws.Bool(m["enabled"].(bool)),
+		}
+	}
+
+	for _, v := range d.Get("network_interfaces").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		opts.NetworkInterfaces = append(opts.NetworkInterfaces, expandLaunchTemplateNetworkInterface(v.(map[string]interface{})))
+	}
+
+	if v := d.Get("placement").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		placement := &ec2.LaunchTemplatePlacementRequest{}
+		if v := m["affinity"].(string); v != "" {
+			placement.Affinity = aws.String(v)
+		}
+		if v := m["availability_zone"].(string); v != "" {
+			placement.AvailabilityZone = aws.String(v)
+		}
+		if v := m["group_name"].(string); v != "" {
+			placement.GroupName = aws.String(v)
+		}
+		if v := m["host_id"].(string); v != "" {
+			placement.HostId = aws.String(v)
+		}
+		if v := m["spread_domain"].(string); v != "" {
+			placement.SpreadDomain = aws.String(v)
+		}
+		if v := m["tenancy"].(string); v != "" {
+			placement.Tenancy = aws.String(v)
+		}
+		opts.Placement = placement
+	}
+
+	for _, v := range d.Get("tag_specifications").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		spec := &ec2.LaunchTemplateTagSpecificationRequest{
+			Tags: tagsFromMap(m["tags"].(map[string]interface{})),
+		}
+		if v := m["resource_type"].(string); v != "" {
+			spec.ResourceType = aws.String(v)
+		}
+		opts.TagSpecifications = append(opts.TagSpecifications, spec)
+	}
+
+	return opts, nil
+}
+
+func expandLaunchTemplateBlockDeviceMapping(m map[string]interface{}) *ec2.LaunchTemplateBlockDeviceMappingRequest {
+	mapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{}
+
+	if v := m["device_name"].(string); v != "" {
+		mapping.DeviceName = aws.String(v)
+	}
+	if v := m["no_device"].(string); v != "" {
+		mapping.NoDevice = aws.String(v)
+	}
+	if v := m["virtual_name"].(string); v != "" {
+		mapping.VirtualName = aws.String(v)
+	}
+
+	if v := m["ebs"].([]interface{}); len(v) > 0 && v[0] != nil {
+		ebs := v[0].(map[string]interface{})
+		mapping.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+			DeleteOnTermination: aws.Bool(ebs["delete_on_termination"].(bool)),
+		}
+		// Leaving encrypted unset lets the volume inherit the encryption
+		// of its snapshot
+		if ebs["encrypted"].(bool) {
+			mapping.Ebs.Encrypted = aws.Bool(true)
+		}
+		if v := ebs["iops"].(int); v > 0 {
+			mapping.Ebs.Iops = aws.Int64(int64(v))
+		}
+		if v := ebs["kms_key_id"].(string); v != "" {
+			mapping.Ebs.KmsKeyId = aws.String(v)
+		}
+		if v := ebs["snapshot_id"].(string); v != "" {
+			mapping.Ebs.SnapshotId = aws.String(v)
+		}
+		if v := ebs["volume_size"].(int); v > 0 {
+			mapping.Ebs.VolumeSize = aws.Int64(int64(v))
+		}
+		if v := ebs["volume_type"].(string); v != "" {
+			mapping.Ebs.VolumeType = aws.String(v)
+		}
+	}
+
+	return mapping
+}
+
+func expandLaunchTemplateInstanceMarketOptions(m map[string]interface{}) (*ec2.LaunchTemplateInstanceMarketOptionsRequest, error) {
+	options := &ec2.LaunchTemplateInstanceMarketOptionsRequest{}
+
+	if v := m["market_type"].(string); v != "" {
+		options.MarketType = aws.String(v)
+	}
+
+	if v := m["spot_options"].([]interface{}); len(v) > 0 && v[0] != nil {
+		so := v[0].(map[string]interface{})
+		spotOptions := &ec2.LaunchTemplateSpotMarketOptionsRequest{}
+		if v := so["block_duration_minutes"].(int); v > 0 {
+			spotOptions.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+		if v := so["instance_interruption_behavior"].(string); v != "" {
+			spotOptions.InstanceInterruptionBehavior = aws.String(v)
+		}
+		if v := so["max_price"].(string); v != "" {
+			spotOptions.MaxPrice = aws.String(v)
+		}
+		if v := so["spot_instance_type"].(string); v != "" {
+			spotOptions.SpotInstanceType = aws.String(v)
+		}
+		if v := so["valid_until"].(string); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing valid_until: %s", err)
+			}
+			spotOptions.ValidUntil = aws.Time(t)
+		}
+		options.SpotOptions = spotOptions
+	}
+
+	return options, nil
+}
+
+func expandLaunchTemplateNetworkInterface(m map[string]interface{}) *ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	ni := &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+		DeleteOnTermination: aws.Bool(m["delete_on_termination"].(bool)),
+		DeviceIndex:         aws.Int64(int64(m["device_index"].(int))),
+	}
+
+	// A public IP address can't be requested for an existing network
+	// interface, so the argument is only sent when set
+	if m["associate_public_ip_address"].(bool) {
+		ni.AssociatePublicIpAddress = aws.Bool(true)
+	}
+	if v := m["description"].(string); v != "" {
+		ni.Description = aws.String(v)
+	}
+	if v := m["network_interface_id"].(string); v != "" {
+		ni.NetworkInterfaceId = aws.String(v)
+	}
+	if v := m["private_ip_address"].(string); v != "" {
+		ni.PrivateIpAddress = aws.String(v)
+	}
+	if v := m["subnet_id"].(string); v != "" {
+		ni.SubnetId = aws.String(v)
+	}
+	if v := m["security_groups"].(*schema.Set); v.Len() > 0 {
+		ni.Groups = expandStringSet(v)
+	}
+	if v := m["ipv6_address_count"].(int); v > 0 {
+		ni.Ipv6AddressCount = aws.Int64(int64(v))
+	}
+	for _, v := range m["ipv6_addresses"].(*schema.Set).List() {
+		ni.Ipv6Addresses = append(ni.Ipv6Addresses, &ec2.InstanceIpv6AddressRequest{
+			Ipv6Address: aws.String(v.(string)),
+		})
+	}
+	for _, v := range m["ipv4_addresses"].(*schema.Set).List() {
+		ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, &ec2.PrivateIpAddressSpecification{
+			PrivateIpAddress: aws.String(v.(string)),
+		})
+	}
+	if v := m["ipv4_address_count"].(int); v > 0 {
+		ni.SecondaryPrivateIpAddressCount = aws.Int64(int64(v))
+	}
+
+	return ni
+}
+
+// setLaunchTemplateData saves the data of a launch template version in the
+// arguments of a launch template resource or data source.
+func setLaunchTemplateData(d *schema.ResourceData, ltData *ec2.ResponseLaunchTemplateData) error {
+	if ltData == nil {
+		ltData = &ec2.ResponseLaunchTemplateData{}
+	}
+
+	d.Set("disable_api_termination", ltData.DisableApiTermination)
+	d.Set("ebs_optimized", ltData.EbsOptimized)
+	d.Set("image_id", ltData.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", ltData.InstanceType)
+	d.Set("kernel_id", ltData.KernelId)
+	d.Set("key_name", ltData.KeyName)
+	d.Set("ram_disk_id", ltData.RamDiskId)
+	d.Set("user_data", ltData.UserData)
+
+	if err := d.Set("security_group_names", flattenStringList(ltData.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_group_names: %s", err)
+	}
+	if err := d.Set("vpc_security_group_ids", flattenStringList(ltData.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %s", err)
+	}
+	if err := d.Set("block_device_mappings", flattenLaunchTemplateBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
+		return fmt.Errorf("error setting block_device_mappings: %s", err)
+	}
+
+	var creditSpecification []interface{}
+	if v := ltData.CreditSpecification; v != nil {
+		creditSpecification = append(creditSpecification, map[string]interface{}{
+			"cpu_credits": aws.StringValue(v.CpuCredits),
+		})
+	}
+	if err := d.Set("credit_specification", creditSpecification); err != nil {
+		return fmt.Errorf("error setting credit_specification: %s", err)
+	}
+
+	var elasticGpuSpecifications []interface{}
+	for _, v := range ltData.ElasticGpuSpecifications {
+		elasticGpuSpecifications = append(elasticGpuSpecifications, map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		})
+	}
+	if err := d.Set("elastic_gpu_specifications", elasticGpuSpecifications); err != nil {
+		return fmt.Errorf("error setting elastic_gpu_specifications: %s", err)
+	}
+
+	var iamInstanceProfile []interface{}
+	if v := ltData.IamInstanceProfile; v != nil {
+		iamInstanceProfile = append(iamInstanceProfile, map[string]interface{}{
+			"arn":  aws.StringValue(v.Arn),
+			"name": aws.StringValue(v.Name),
+		})
+	}
+	if err := d.Set("iam_instance_profile", iamInstanceProfile); err != nil {
+		return fmt.Errorf("error setting iam_instance_profile: %s", err)
+	}
+
+	if err := d.Set("instance_market_options", flattenLaunchTemplateInstanceMarketOptions(ltData.InstanceMarketOptions)); err != nil {
+		return fmt.Errorf("error setting instance_market_options: %s", err)
+	}
+
+	var monitoring []interface{}
+	if v := ltData.Monitoring; v != nil {
+		monitoring = append(monitoring, map[string]interface{}{
+			"enabled": aws.BoolValue(v.Enabled),
+		})
+	}
+	if err := d.Set("monitoring", monitoring); err != nil {
+		return fmt.Errorf("error setting monitoring: %s", err)
+	}
+
+	if err := d.Set("network_interfaces", flattenLaunchTemplateNetworkInterfaces(ltData.NetworkInterfaces)); err != nil {
+		return fmt.Errorf("error setting network_interfaces: %s", err)
+	}
+
+	var placement []interface{}
+	if v := ltData.Placement; v != nil {
+		placement = append(placement, map[string]interface{}{
+			"affinity":          aws.StringValue(v.Affinity),
+			"availability_zone": aws.StringValue(v.AvailabilityZone),
+			"group_name":        aws.StringValue(v.GroupName),
+			"host_id":           aws.StringValue(v.HostId),
+			"spread_domain":     aws.StringValue(v.SpreadDomain),
+			"tenancy":           aws.StringValue(v.Tenancy),
+		})
+	}
+	if err := d.Set("placement", placement); err != nil {
+		return fmt.Errorf("error setting placement: %s", err)
+	}
+
+	var tagSpecifications []interface{}
+	for _, v := range ltData.TagSpecifications {
+		tagSpecifications = append(tagSpecifications, map[string]interface{}{
+			"resource_type": aws.StringValue(v.ResourceType),
+			"tags":          tagsToMap(v.Tags),
+		})
+	}
+	if err := d.Set("tag_specifications", tagSpecifications); err != nil {
+		return fmt.Errorf("error setting tag_specifications: %s", err)
+	}
+
+	return nil
+}
+
+func flattenLaunchTemplateBlockDeviceMappings(mappings []*ec2.LaunchTemplateBlockDeviceMapping) []interface{} {
+	var result []interface{}
+	for _, v := range mappings {
+		mapping := map[string]interface{}{
+			"device_name":  aws.StringValue(v.DeviceName),
+			"no_device":    aws.StringValue(v.NoDevice),
+			"virtual_name": aws.StringValue(v.VirtualName),
+		}
+		if v.Ebs != nil {
+			mapping["ebs"] = []interface{}{map[string]interface{}{
+				"delete_on_termination": aws.BoolValue(v.Ebs.DeleteOnTermination),
+				"encrypted":             aws.BoolValue(v.Ebs.Encrypted),
+				"iops":                  int(aws.Int64Value(v.Ebs.Iops)),
+				"kms_key_id":            aws.StringValue(v.Ebs.KmsKeyId),
+				"snapshot_id":           aws.StringValue(v.Ebs.SnapshotId),
+				"volume_size":           int(aws.Int64Value(v.Ebs.VolumeSize)),
+				"volume_type":           aws.StringValue(v.Ebs.VolumeType),
+			}}
+		}
+		result = append(result, mapping)
+	}
+	return result
+}
+
+func flattenLaunchTemplateInstanceMarketOptions(options *ec2.LaunchTemplateInstanceMarketOptions) []interface{} {
+	if options == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"market_type": aws.StringValue(options.MarketType),
+	}
+	if so := options.SpotOptions; so != nil {
+		spotOptions := map[string]interface{}{
+			"block_duration_minutes":         int(aws.Int64Value(so.BlockDurationMinutes)),
+			"instance_interruption_behavior": aws.StringValue(so.InstanceInterruptionBehavior),
+			"max_price":                      aws.StringValue(so.MaxPrice),
+			"spot_instance_type":             aws.StringValue(so.SpotInstanceType),
+		}
+		if so.ValidUntil != nil {
+			spotOptions["valid_until"] = aws.TimeValue(so.ValidUntil).Format(time.RFC3339)
+		}
+		m["spot_options"] = []interface{}{spotOptions}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenLaunchTemplateNetworkInterfaces(nis []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification) []interface{} {
+	var result []interface{}
+	for _, v := range nis {
+		var ipv6Addresses, ipv4Addresses []interface{}
+		for _, a := range v.Ipv6Addresses {
+			ipv6Addresses = append(ipv6Addresses, aws.StringValue(a.Ipv6Address))
+		}
+		for _, a := range v.PrivateIpAddresses {
+			ipv4Addresses = append(ipv4Addresses, aws.StringValue(a.PrivateIpAddress))
+		}
+
+		result = append(result, map[string]interface{}{
+			"associate_public_ip_address": aws.BoolValue(v.AssociatePublicIpAddress),
+			"delete_on_termination":       aws.BoolValue(v.DeleteOnTermination),
+			"description":                 aws.StringValue(v.Description),
+			"device_index":                int(aws.Int64Value(v.DeviceIndex)),
+			"security_groups":             schema.NewSet(schema.HashString, flattenStringList(v.Groups)),
+			"ipv6_address_count":          int(aws.Int64Value(v.Ipv6AddressCount)),
+			"ipv6_addresses":              schema.NewSet(schema.HashString, ipv6Addresses),
+			"network_interface_id":        aws.StringValue(v.NetworkInterfaceId),
+			"private_ip_address":          aws.StringValue(v.PrivateIpAddress),
+			"ipv4_addresses":              schema.NewSet(schema.HashString, ipv4Addresses),
+			"ipv4_address_count":          int(aws.Int64Value(v.SecondaryPrivateIpAddressCount)),
+			"subnet_id":                   aws.StringValue(v.SubnetId),
+		})
+	}
+	return result
+}
@@ -0,0 +1,324 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLaunchTemplate_importBasic(t *testing.T) {
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_default_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_basic(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "name", fmt.Sprintf("tf-lt-%d", rInt)),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+					resource.TestCheckResourceAttrSet(resName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_data(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_data(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.ebs.0.volume_size", "20"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "unlimited"),
+					resource.TestCheckResourceAttr(resName, "iam_instance_profile.#", "1"),
+					resource.TestCheckResourceAttr(resName, "image_id", "ami-12a3b456"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr(resName, "monitoring.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.0.associate_public_ip_address", "true"),
+					resource.TestCheckResourceAttr(resName, "placement.0.availability_zone", "us-west-2b"),
+					resource.TestCheckResourceAttr(resName, "tag_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resName, "tag_specifications.0.tags.Name", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_update(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.micro", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.nano", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.nano"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.small", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "3"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.small"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_tags(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					testAccCheckTags(&template.Tags, "foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_tagsUpdate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					testAccCheckTags(&template.Tags, "foo", ""),
+					testAccCheckTags(&template.Tags, "bar", "baz"),
+					// Tags don't create new versions of the launch template
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Launch Template ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.LaunchTemplates) != 1 || *resp.LaunchTemplates[0].LaunchTemplateId != rs.Primary.ID {
+			return fmt.Errorf("Launch Template not found")
+		}
+
+		*t = *resp.LaunchTemplates[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_launch_template" {
+			continue
+		}
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err == nil {
+			if len(resp.LaunchTemplates) != 0 && *resp.LaunchTemplates[0].LaunchTemplateId == rs.Primary.ID {
+				return fmt.Errorf("Launch Template still exists")
+			}
+		}
+
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLaunchTemplateConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-lt-%d"
+
+  tags {
+    foo = "bar"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSLaunchTemplateConfig_tagsUpdate(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-lt-%d"
+
+  tags {
+    bar = "baz"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSLaunchTemplateConfig_versions(rInt int, instanceType string, updateDefaultVersion bool) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "tf-lt-%d"
+  instance_type = "%s"
+
+  update_default_version = %t
+}
+`, rInt, instanceType, updateDefaultVersion)
+}
+
+func testAccAWSLaunchTemplateConfig_data(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-lt-%d"
+
+  block_device_mappings {
+    device_name = "test"
+
+    ebs {
+      volume_size = 20
+    }
+  }
+
+  credit_specification {
+    cpu_credits = "unlimited"
+  }
+
+  disable_api_termination = true
+
+  ebs_optimized = false
+
+  iam_instance_profile {
+    name = "test"
+  }
+
+  image_id = "ami-12a3b456"
+
+  instance_initiated_shutdown_behavior = "terminate"
+
+  instance_market_options {
+    market_type = "spot"
+  }
+
+  instance_type = "t2.micro"
+
+  kernel_id = "aki-a12bc3de"
+
+  key_name = "test"
+
+  monitoring {
+    enabled = true
+  }
+
+  network_interfaces {
+    associate_public_ip_address = true
+    network_interface_id        = "eni-123456ab"
+    ipv4_address_count          = 2
+  }
+
+  placement {
+    availability_zone = "us-west-2b"
+  }
+
+  ram_disk_id = "ari-a12bc3de"
+
+  vpc_security_group_ids = ["sg-12a3b45c"]
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags {
+      Name = "test"
+    }
+  }
+}
+`, rInt)
+}
@@ -24,8 +24,8 @@ func resourceAwsLb() *schema.Resource {
 		Read:   resoureAwsLbRead,
 		Update: resourceAwsLbUpdate,
 		Delete: resourceAwsLbDelete,
-		// Subnets are ForceNew for Network Load Balancers
-		CustomizeDiff: customizeDiffNLBSubnets,
+		// Plans tags_all; subnets are ForceNew for Network Load Balancers
+		CustomizeDiff: resourceAwsLbCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -188,13 +188,14 @@ func resourceAwsLb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -209,7 +210,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
 		Type: aws.String(d.Get("load_balancer_type").(string)),
-		Tags: tagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapELBv2(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -292,7 +293,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resoureAwsLbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 	lbArn := d.Id()
 
 	describeLbOpts := &elbv2.DescribeLoadBalancersInput{
@@ -314,11 +315,19 @@ func resoureAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to find ALB: %#v", describeResp.LoadBalancers)
 	}
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	if err := flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0]); err != nil {
+		return err
+	}
+
+	tags, err := describeElbV2Tags(elbconn, d.Id())
+	if err != nil {
+		return errwrap.Wrapf("Error retrieving ALB Tags: {{err}}", err)
+	}
+	return setTagsAll(d, meta, tags)
 }
 
 func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	if !d.IsNewResource() {
 		if err := setElbV2Tags(elbconn, d); err != nil {
@@ -464,7 +473,7 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbDelete(d *schema.ResourceData, meta interface{}) error {
-	lbconn := meta.(*AWSClient).elbv2conn
+	lbconn := meta.(*AWSClient).ELBV2()
 
 	log.Printf("[INFO] Deleting ALB: %s", d.Id())
 
@@ -476,7 +485,7 @@ func resourceAwsLbDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error deleting ALB: %s", err)
 	}
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	err := cleanupLBNetworkInterfaces(conn, d.Id())
 	if err != nil {
@@ -617,7 +626,7 @@ func lbSuffixFromARN(arn *string) string {
 
 // flattenAwsLbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
 func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	d.Set("arn", lb.LoadBalancerArn)
 	d.Set("arn_suffix", lbSuffixFromARN(lb.LoadBalancerArn))
@@ -648,22 +657,6 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 	}
 	d.Set("subnet_mapping", subnetMappings)
 
-	respTags, err := elbconn.DescribeTags(&elbv2.DescribeTagsInput{
-		ResourceArns: []*string{lb.LoadBalancerArn},
-	})
-	if err != nil {
-		return errwrap.Wrapf("Error retrieving ALB Tags: {{err}}", err)
-	}
-
-	var et []*elbv2.Tag
-	if len(respTags.TagDescriptions) > 0 {
-		et = respTags.TagDescriptions[0].Tags
-	}
-
-	if err := d.Set("tags", tagsToMapELBv2(et)); err != nil {
-		log.Printf("[WARN] Error setting tags for AWS LB (%s): %s", d.Id(), err)
-	}
-
 	attributesResp, err := elbconn.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: aws.String(d.Id()),
 	})
@@ -707,6 +700,14 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 // Load balancers of type 'network' cannot have their subnets updated at
 // this time. If the type is 'network' and subnets have changed, mark the
 // diff as a ForceNew operation
+func resourceAwsLbCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if err := setTagsAllDiff(diff, v); err != nil {
+		return err
+	}
+
+	return customizeDiffNLBSubnets(diff, v)
+}
+
 func customizeDiffNLBSubnets(diff *schema.ResourceDiff, v interface{}) error {
 	// The current criteria for determining if the operation should be ForceNew:
 	// - lb of type "network"
@@ -56,7 +56,7 @@ func resourceAwsLBCookieStickinessPolicy() *schema.Resource {
 }
 
 func resourceAwsLBCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	// Provision the LBStickinessPolicy
 	lbspOpts := &elb.CreateLBCookieStickinessPolicyInput{
@@ -92,7 +92,7 @@ func resourceAwsLBCookieStickinessPolicyCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsLBCookieStickinessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	lbName, lbPort, policyName := resourceAwsLBCookieStickinessPolicyParseId(d.Id())
 
@@ -145,7 +145,7 @@ func resourceAwsLBCookieStickinessPolicyRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsLBCookieStickinessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	lbName, _, policyName := resourceAwsLBCookieStickinessPolicyParseId(d.Id())
 
@@ -86,7 +86,7 @@ func resourceAwsLbListener() *schema.Resource {
 }
 
 func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	lbArn := d.Get("load_balancer_arn").(string)
 
@@ -153,7 +153,7 @@ func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	resp, err := elbconn.DescribeListeners(&elbv2.DescribeListenersInput{
 		ListenerArns: []*string{aws.String(d.Id())},
@@ -199,7 +199,7 @@ func resourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	params := &elbv2.ModifyListenerInput{
 		ListenerArn: aws.String(d.Id()),
@@ -240,7 +240,7 @@ func resourceAwsLbListenerUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLbListenerDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	_, err := elbconn.DeleteListener(&elbv2.DeleteListenerInput{
 		ListenerArn: aws.String(d.Id()),
@@ -81,7 +81,7 @@ func resourceAwsLbbListenerRule() *schema.Resource {
 }
 
 func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	params := &elbv2.CreateRuleInput{
 		ListenerArn: aws.String(d.Get("listener_arn").(string)),
@@ -127,7 +127,7 @@ func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	resp, err := elbconn.DescribeRules(&elbv2.DescribeRulesInput{
 		RuleArns: []*string{aws.String(d.Id())},
@@ -189,7 +189,7 @@ func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	d.Partial(true)
 
@@ -265,7 +265,7 @@ func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLbListenerRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).ELBV2()
 
 	_, err := elbconn.DeleteRule(&elbv2.DeleteRuleInput{
 		RuleArn: aws.String(d.Id()),
@@ -112,7 +112,7 @@ func testAccCheckAWSLBListenerExists(n string, res *elbv2.Listener) resource.Tes
 			return errors.New("No Listener ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).ELBV2()
 
 		describe, err := conn.DescribeListeners(&elbv2.DescribeListenersInput{
 			ListenerArns: []*string{aws.String(rs.Primary.ID)},
@@ -133,7 +133,7 @@ func testAccCheckAWSLBListenerExists(n string, res *elbv2.Listener) resource.Tes
 }
 
 func testAccCheckAWSLBListenerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).ELBV2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_listener" && rs.Type != "aws_alb_listener" {
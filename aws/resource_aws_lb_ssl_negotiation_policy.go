@@ -69,7 +69,7 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 }
 
 func resourceAwsLBSSLNegotiationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	// Provision the SSLNegotiationPolicy
 	lbspOpts := &elb.CreateLoadBalancerPolicyInput{
@@ -112,7 +112,7 @@ func resourceAwsLBSSLNegotiationPolicyCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsLBSSLNegotiationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	lbName, lbPort, policyName := resourceAwsLBSSLNegotiationPolicyParseId(d.Id())
 
@@ -152,7 +152,7 @@ func resourceAwsLBSSLNegotiationPolicyRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsLBSSLNegotiationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	lbName, _, policyName := resourceAwsLBSSLNegotiationPolicyParseId(d.Id())
 
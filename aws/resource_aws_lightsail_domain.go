@@ -30,7 +30,7 @@ func resourceAwsLightsailDomain() *schema.Resource {
 }
 
 func resourceAwsLightsailDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 	_, err := conn.CreateDomain(&lightsail.CreateDomainInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
 	})
@@ -45,7 +45,7 @@ func resourceAwsLightsailDomainCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLightsailDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 	resp, err := conn.GetDomain(&lightsail.GetDomainInput{
 		DomainName: aws.String(d.Id()),
 	})
@@ -67,7 +67,7 @@ func resourceAwsLightsailDomainRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsLightsailDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 	_, err := conn.DeleteDomain(&lightsail.DeleteDomainInput{
 		DomainName: aws.String(d.Id()),
 	})
@@ -38,7 +38,7 @@ func TestAccAWSLightsailDomain_disappears(t *testing.T) {
 
 	domainDestroy := func(*terraform.State) error {
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).Lightsail()
 		_, err := conn.DeleteDomain(&lightsail.DeleteDomainInput{
 			DomainName: aws.String(lightsailDomainName),
 		})
@@ -78,7 +78,7 @@ func testAccCheckAWSLightsailDomainExists(n string, domain *lightsail.Domain) re
 			return errors.New("No Lightsail Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).Lightsail()
 
 		resp, err := conn.GetDomain(&lightsail.GetDomainInput{
 			DomainName: aws.String(rs.Primary.ID),
@@ -103,7 +103,7 @@ func testAccCheckAWSLightsailDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).Lightsail()
 
 		resp, err := conn.GetDomain(&lightsail.GetDomainInput{
 			DomainName: aws.String(rs.Primary.ID),
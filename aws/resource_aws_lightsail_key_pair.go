@@ -76,7 +76,7 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 }
 
 func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 
 	var kName string
 	if v, ok := d.GetOk("name"); ok {
@@ -167,7 +167,7 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 
 	resp, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 		KeyPairName: aws.String(d.Id()),
@@ -194,7 +194,7 @@ func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLightsailKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 	resp, err := conn.DeleteKeyPair(&lightsail.DeleteKeyPairInput{
 		KeyPairName: aws.String(d.Id()),
 	})
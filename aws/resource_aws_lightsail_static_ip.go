@@ -38,7 +38,7 @@ func resourceAwsLightsailStaticIp() *schema.Resource {
 }
 
 func resourceAwsLightsailStaticIpCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Allocating Lightsail Static IP: %q", name)
@@ -56,7 +56,7 @@ func resourceAwsLightsailStaticIpCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsLightsailStaticIpRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Reading Lightsail Static IP: %q", name)
@@ -83,7 +83,7 @@ func resourceAwsLightsailStaticIpRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLightsailStaticIpDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).Lightsail()
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Deleting Lightsail Static IP: %q", name)
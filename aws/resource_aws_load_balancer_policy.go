@@ -58,7 +58,7 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 }
 
 func resourceAwsLoadBalancerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	attributes := []*elb.PolicyAttribute{}
 	if attributedata, ok := d.GetOk("policy_attribute"); ok {
@@ -90,7 +90,7 @@ func resourceAwsLoadBalancerPolicyCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsLoadBalancerPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(d.Id())
 
@@ -136,7 +136,7 @@ func resourceAwsLoadBalancerPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLoadBalancerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 	reassignments := Reassignment{}
 
 	loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(d.Id())
@@ -180,7 +180,7 @@ func resourceAwsLoadBalancerPolicyUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsLoadBalancerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).ELB()
 
 	loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(d.Id())
 
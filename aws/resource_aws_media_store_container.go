@@ -43,7 +43,7 @@ func resourceAwsMediaStoreContainer() *schema.Resource {
 }
 
 func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).MediaStore()
 
 	input := &mediastore.CreateContainerInput{
 		ContainerName: aws.String(d.Get("name").(string)),
@@ -72,7 +72,7 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).MediaStore()
 
 	input := &mediastore.DescribeContainerInput{
 		ContainerName: aws.String(d.Id()),
@@ -87,7 +87,7 @@ func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsMediaStoreContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).MediaStore()
 
 	input := &mediastore.DeleteContainerInput{
 		ContainerName: aws.String(d.Id()),
@@ -28,7 +28,7 @@ func TestAccAwsMediaStoreContainer_basic(t *testing.T) {
 }
 
 func testAccCheckAwsMediaStoreContainerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+	conn := testAccProvider.Meta().(*AWSClient).MediaStore()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_store_container" {
@@ -178,7 +178,7 @@ func resourceAwsMqBroker() *schema.Resource {
 }
 
 func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	name := d.Get("broker_name").(string)
 	requestId := resource.PrefixedUniqueId(fmt.Sprintf("tf-%s", name))
@@ -243,7 +243,7 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	log.Printf("[INFO] Reading MQ Broker: %s", d.Id())
 	out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
@@ -312,7 +312,7 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	if d.HasChange("configuration") {
 		_, err := conn.UpdateBroker(&mq.UpdateBrokerRequest{
@@ -369,7 +369,7 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	log.Printf("[INFO] Deleting MQ Broker: %s", d.Id())
 	_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
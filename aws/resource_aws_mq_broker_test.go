@@ -27,7 +27,7 @@ func testSweepMqBrokers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).mqconn
+	conn := client.(*AWSClient).MQ()
 
 	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
@@ -494,7 +494,7 @@ func TestAccAwsMqBroker_updateUsers(t *testing.T) {
 }
 
 func testAccCheckAwsMqBrokerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).MQ()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_broker" {
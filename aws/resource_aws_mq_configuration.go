@@ -72,7 +72,7 @@ func resourceAwsMqConfiguration() *schema.Resource {
 }
 
 func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	input := mq.CreateConfigurationRequest{
 		EngineType:    aws.String(d.Get("engine_type").(string)),
@@ -93,7 +93,7 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	log.Printf("[INFO] Reading MQ Configuration %s", d.Id())
 	out, err := conn.DescribeConfiguration(&mq.DescribeConfigurationInput{
@@ -134,7 +134,7 @@ func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsMqConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).MQ()
 
 	rawData := d.Get("data").(string)
 	data := base64.StdEncoding.EncodeToString([]byte(rawData))
@@ -72,7 +72,7 @@ func TestAccAwsMqConfiguration_withData(t *testing.T) {
 }
 
 func testAccCheckAwsMqConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).MQ()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_configuration" {
@@ -23,6 +23,8 @@ func resourceAwsNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -54,13 +56,14 @@ func resourceAwsNatGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Create the NAT Gateway
 	createOpts := &ec2.CreateNatGatewayInput{
@@ -97,7 +100,7 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Refresh the NAT Gateway state
 	ngRaw, state, err := NGStateRefreshFunc(conn, d.Id())()
@@ -129,13 +132,13 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_ip", address.PublicIp)
 
 	// Tags
-	d.Set("tags", tagsToMap(ng.Tags))
+	setTagsAll(d, meta, tagsToMap(ng.Tags))
 
 	return nil
 }
 
 func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Turn on partial mode
 	d.Partial(true)
@@ -144,13 +147,14 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsNatGatewayRead(d, meta)
 }
 
 func resourceAwsNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	deleteOpts := &ec2.DeleteNatGatewayInput{
 		NatGatewayId: aws.String(d.Id()),
 	}
@@ -90,7 +90,7 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 }
 
 func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	protocol := d.Get("protocol").(string)
 	p, protocolErr := strconv.Atoi(protocol)
@@ -223,7 +223,7 @@ func resourceAwsNetworkAclRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	params := &ec2.DeleteNetworkAclEntryInput{
 		NetworkAclId: aws.String(d.Get("network_acl_id").(string)),
@@ -241,7 +241,7 @@ func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func findNetworkAclRule(d *schema.ResourceData, meta interface{}) (*ec2.NetworkAclEntry, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	filters := make([]*ec2.Filter, 0, 2)
 	ruleNumberFilter := &ec2.Filter{
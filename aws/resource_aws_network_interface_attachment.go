@@ -51,7 +51,7 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 }
 
 func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	device_index := d.Get("device_index").(int)
 	instance_id := d.Get("instance_id").(string)
@@ -93,7 +93,7 @@ func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
@@ -133,7 +133,7 @@ func resourceAwsNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
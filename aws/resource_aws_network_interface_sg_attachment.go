@@ -38,7 +38,7 @@ func resourceAwsNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 	sgID := d.Get("security_group_id").(string)
 	interfaceID := d.Get("network_interface_id").(string)
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	// Fetch the network interface we will be working with.
 	iface, err := fetchNetworkInterface(conn, interfaceID)
@@ -78,7 +78,7 @@ func resourceAwsNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 
 	log.Printf("[DEBUG] Checking association of security group %s to network interface ID %s", sgID, interfaceID)
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	iface, err := fetchNetworkInterface(conn, interfaceID)
 	if err != nil {
@@ -105,7 +105,7 @@ func resourceAwsNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Removing security group %s from interface ID %s", sgID, interfaceID)
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	iface, err := fetchNetworkInterface(conn, interfaceID)
 	if err != nil {
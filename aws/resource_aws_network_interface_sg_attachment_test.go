@@ -48,7 +48,7 @@ func TestAccAwsNetworkInterfaceSGAttachment(t *testing.T) {
 
 func checkSecurityGroupAttached(attr string, expected bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		interfaceID := s.Modules[0].Resources["aws_instance.instance"].Primary.Attributes[attr]
 		sgID := s.Modules[0].Resources["aws_security_group.sg"].Primary.ID
@@ -172,7 +172,7 @@ const sgRaceCheckCount = 4
 
 func checkSecurityGroupAttachmentRace() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		interfaceID := s.Modules[0].Resources["aws_network_interface.interface"].Primary.ID
 		for i := 0; i < sgRaceCheckCount; i++ {
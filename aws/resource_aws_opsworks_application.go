@@ -258,7 +258,7 @@ func resourceAwsOpsworksApplicationValidate(d *schema.ResourceData) error {
 }
 
 func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.DescribeAppsInput{
 		AppIds: []*string{
@@ -297,7 +297,7 @@ func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsOpsworksApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	err := resourceAwsOpsworksApplicationValidate(d)
 	if err != nil {
@@ -346,7 +346,7 @@ func resourceAwsOpsworksApplicationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	err := resourceAwsOpsworksApplicationValidate(d)
 	if err != nil {
@@ -390,7 +390,7 @@ func resourceAwsOpsworksApplicationUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.DeleteAppInput{
 		AppId: aws.String(d.Id()),
@@ -155,7 +155,7 @@ func testAccCheckAWSOpsworksCustomLayerExists(
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).OpsWorks()
 
 		params := &opsworks.DescribeLayersInput{
 			LayerIds: []*string{aws.String(rs.Primary.ID)},
@@ -237,7 +237,7 @@ func testAccCheckAWSOpsworksCreateLayerAttributes(
 }
 
 func testAccCheckAwsOpsworksCustomLayerDestroy(s *terraform.State) error {
-	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	opsworksconn := testAccProvider.Meta().(*AWSClient).OpsWorks()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_custom_layer" {
 			continue
@@ -511,7 +511,7 @@ func resourceAwsOpsworksInstanceValidate(d *schema.ResourceData) error {
 }
 
 func resourceAwsOpsworksInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.DescribeInstancesInput{
 		InstanceIds: []*string{
@@ -628,7 +628,7 @@ func resourceAwsOpsworksInstanceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsOpsworksInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	err := resourceAwsOpsworksInstanceValidate(d)
 	if err != nil {
@@ -791,7 +791,7 @@ func resourceAwsOpsworksInstanceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsOpsworksInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	err := resourceAwsOpsworksInstanceValidate(d)
 	if err != nil {
@@ -872,7 +872,7 @@ func resourceAwsOpsworksInstanceUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsOpsworksInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	if v, ok := d.GetOk("status"); ok && v.(string) != "stopped" {
 		err := stopOpsworksInstance(d, meta, true, d.Timeout(schema.TimeoutDelete))
@@ -909,7 +909,7 @@ func resourceAwsOpsworksInstanceImport(
 }
 
 func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool, timeout time.Duration) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	instanceId := d.Id()
 
@@ -947,7 +947,7 @@ func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool,
 }
 
 func stopOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool, timeout time.Duration) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	instanceId := d.Id()
 
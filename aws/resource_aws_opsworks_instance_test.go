@@ -158,7 +158,7 @@ func testAccCheckAWSOpsworksInstanceExists(
 			return fmt.Errorf("No Opsworks Instance is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).OpsWorks()
 
 		params := &opsworks.DescribeInstancesInput{
 			InstanceIds: []*string{&rs.Primary.ID},
@@ -209,7 +209,7 @@ func testAccCheckAWSOpsworksInstanceAttributes(
 }
 
 func testAccCheckAwsOpsworksInstanceDestroy(s *terraform.State) error {
-	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	opsworksconn := testAccProvider.Meta().(*AWSClient).OpsWorks()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_instance" {
 			continue
@@ -71,7 +71,7 @@ func resourceAwsOpsworksPermissionDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.DescribePermissionsInput{
 		IamUserArn: aws.String(d.Get("user_arn").(string)),
@@ -118,7 +118,7 @@ func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsOpsworksSetPermission(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.SetPermissionInput{
 		AllowSudo:  aws.Bool(d.Get("allow_sudo").(bool)),
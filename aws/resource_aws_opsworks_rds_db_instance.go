@@ -44,7 +44,7 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 }
 
 func resourceAwsOpsworksRdsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	d.Partial(true)
 
@@ -93,7 +93,7 @@ func resourceAwsOpsworksRdsDbInstanceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsOpsworksRdsDbInstanceDeregister(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.DeregisterRdsDbInstanceInput{
 		RdsDbInstanceArn: aws.String(d.Get("rds_db_instance_arn").(string)),
@@ -129,7 +129,7 @@ func resourceAwsOpsworksRdsDbInstanceDeregister(d *schema.ResourceData, meta int
 }
 
 func resourceAwsOpsworksRdsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.DescribeRdsDbInstancesInput{
 		StackId: aws.String(d.Get("stack_id").(string)),
@@ -166,7 +166,7 @@ func resourceAwsOpsworksRdsDbInstanceRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksRdsDbInstanceRegister(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	req := &opsworks.RegisterRdsDbInstanceInput{
 		StackId:          aws.String(d.Get("stack_id").(string)),
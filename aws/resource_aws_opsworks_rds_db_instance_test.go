@@ -84,7 +84,7 @@ func testAccCheckAWSOpsworksRdsDbExists(
 			return fmt.Errorf("Rds Db stack id is missing, should be set.")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).OpsWorks()
 
 		params := &opsworks.DescribeRdsDbInstancesInput{
 			StackId: aws.String(rs.Primary.Attributes["stack_id"]),
@@ -119,7 +119,7 @@ func testAccCheckAWSOpsworksCreateRdsDbAttributes(
 }
 
 func testAccCheckAwsOpsworksRdsDbDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*AWSClient).opsworksconn
+	client := testAccProvider.Meta().(*AWSClient).OpsWorks()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_rds_db_instance" {
@@ -28,6 +28,8 @@ func resourceAwsOpsworksStack() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"agent_version": {
 				Type:     schema.TypeString,
@@ -171,7 +173,8 @@ func resourceAwsOpsworksStack() *schema.Resource {
 				Default:  "Layer_Dependent",
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"use_custom_cookbooks": {
 				Type:     schema.TypeBool,
@@ -266,7 +269,7 @@ func resourceAwsOpsworksSetStackCustomCookbooksSource(d *schema.ResourceData, v
 }
 
 func resourceAwsOpsworksStackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 	var conErr error
 	if v := d.Get("stack_endpoint").(string); v != "" {
 		client, conErr = opsworksConnForRegion(v, meta)
@@ -375,7 +378,7 @@ func resourceAwsOpsworksStackRead(d *schema.ResourceData, meta interface{}) erro
 //  - https://github.com/hashicorp/terraform/pull/12688
 //  - https://github.com/hashicorp/terraform/issues/12842
 func opsworksConnForRegion(region string, meta interface{}) (*opsworks.OpsWorks, error) {
-	originalConn := meta.(*AWSClient).opsworksconn
+	originalConn := meta.(*AWSClient).OpsWorks()
 
 	// Regions are the same, no need to reconfigure
 	if originalConn.Config.Region != nil && *originalConn.Config.Region == region {
@@ -402,7 +405,7 @@ func opsworksConnForRegion(region string, meta interface{}) (*opsworks.OpsWorks,
 }
 
 func resourceAwsOpsworksStackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 
 	err := resourceAwsOpsworksStackValidate(d)
 	if err != nil {
@@ -486,7 +489,7 @@ func resourceAwsOpsworksStackCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 	var conErr error
 	if v := d.Get("stack_endpoint").(string); v != "" {
 		client, conErr = opsworksConnForRegion(v, meta)
@@ -533,10 +536,10 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
+		Partition: meta.(*AWSClient).Partition(),
 		Region:    meta.(*AWSClient).region,
 		Service:   "opsworks",
-		AccountID: meta.(*AWSClient).accountid,
+		AccountID: meta.(*AWSClient).AccountID(),
 		Resource:  fmt.Sprintf("stack/%s/", d.Id()),
 	}
 
@@ -565,7 +568,7 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsOpsworksStackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).OpsWorks()
 	var conErr error
 	if v := d.Get("stack_endpoint").(string); v != "" {
 		client, conErr = opsworksConnForRegion(v, meta)
@@ -72,7 +72,7 @@ func testAccCheckAWSOpsworksUserProfileExists(
 			return fmt.Errorf("User Profile user arn is missing, should be set.")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).OpsWorks()
 
 		params := &opsworks.DescribeUserProfilesInput{
 			IamUserArns: []*string{aws.String(rs.Primary.Attributes["user_arn"])},
@@ -103,7 +103,7 @@ func testAccCheckAWSOpsworksUserProfileExists(
 }
 
 func testAccCheckAwsOpsworksUserProfileDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*AWSClient).opsworksconn
+	client := testAccProvider.Meta().(*AWSClient).OpsWorks()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_user_profile" {
@@ -29,7 +29,7 @@ func TestAccAWSPlacementGroup_basic(t *testing.T) {
 }
 
 func testAccCheckAWSPlacementGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_placement_group" {
@@ -63,7 +63,7 @@ func testAccCheckAWSPlacementGroupExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Placement Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		_, err := conn.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{
 			GroupNames: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -86,7 +86,7 @@ func testAccCheckAWSDestroyPlacementGroup(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Placement Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		_, err := conn.DeletePlacementGroup(&ec2.DeletePlacementGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
 		})
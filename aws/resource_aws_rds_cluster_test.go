@@ -293,7 +293,7 @@ func testAccCheckAWSClusterDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Group
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).RDS()
 		var err error
 		resp, err := conn.DescribeDBClusters(
 			&rds.DescribeDBClustersInput{
@@ -331,9 +331,9 @@ func testAccCheckAWSClusterSnapshot(rInt int) resource.TestCheckFunc {
 			snapshot_identifier := fmt.Sprintf("tf-acctest-rdscluster-snapshot-%d", rInt)
 
 			awsClient := testAccProvider.Meta().(*AWSClient)
-			conn := awsClient.rdsconn
+			conn := awsClient.RDS()
 
-			arn, arnErr := buildRDSClusterARN(snapshot_identifier, awsClient.partition, awsClient.accountid, awsClient.region)
+			arn, arnErr := buildRDSClusterARN(snapshot_identifier, awsClient.Partition(), awsClient.AccountID(), awsClient.region)
 			tagsARN := strings.Replace(arn, ":cluster:", ":snapshot:", 1)
 			if arnErr != nil {
 				return fmt.Errorf("Error building ARN for tags check with ARN (%s): %s", tagsARN, arnErr)
@@ -387,7 +387,7 @@ func testAccCheckAWSClusterExists(n string, v *rds.DBCluster) resource.TestCheck
 			return fmt.Errorf("No DB Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).RDS()
 		resp, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -423,7 +423,7 @@ func testAccCheckAWSRedshiftClusterDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Group
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).Redshift()
 		var err error
 		resp, err := conn.DescribeClusters(
 			&redshift.DescribeClustersInput{
@@ -460,10 +460,10 @@ func testAccCheckAWSRedshiftClusterSnapshot(rInt int) resource.TestCheckFunc {
 			var err error
 
 			// Try and delete the snapshot before we check for the cluster not found
-			conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+			conn := testAccProvider.Meta().(*AWSClient).Redshift()
 
 			snapshot_identifier := fmt.Sprintf("tf-acctest-snapshot-%d", rInt)
-			arn, err := buildRedshiftARN(snapshot_identifier, testAccProvider.Meta().(*AWSClient).partition, testAccProvider.Meta().(*AWSClient).accountid, testAccProvider.Meta().(*AWSClient).region)
+			arn, err := buildRedshiftARN(snapshot_identifier, testAccProvider.Meta().(*AWSClient).Partition(), testAccProvider.Meta().(*AWSClient).AccountID(), testAccProvider.Meta().(*AWSClient).region)
 			tagsARN := strings.Replace(arn, ":cluster:", ":snapshot:", 1)
 			if err != nil {
 				return fmt.Errorf("Error building ARN for tags check with ARN (%s): %s", tagsARN, err)
@@ -517,7 +517,7 @@ func testAccCheckAWSRedshiftClusterExists(n string, v *redshift.Cluster) resourc
 			return fmt.Errorf("No Redshift Cluster Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).Redshift()
 		resp, err := conn.DescribeClusters(&redshift.DescribeClustersInput{
 			ClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -71,7 +71,7 @@ func resourceAwsRedshiftSecurityGroup() *schema.Resource {
 }
 
 func resourceAwsRedshiftSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).Redshift()
 
 	var err error
 	var errs []error
@@ -155,7 +155,7 @@ func resourceAwsRedshiftSecurityGroupRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsRedshiftSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).Redshift()
 
 	if d.HasChange("ingress") {
 		o, n := d.GetChange("ingress")
@@ -204,7 +204,7 @@ func resourceAwsRedshiftSecurityGroupUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsRedshiftSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).Redshift()
 
 	log.Printf("[DEBUG] Redshift Security Group destroy: %v", d.Id())
 	opts := redshift.DeleteClusterSecurityGroupInput{
@@ -226,7 +226,7 @@ func resourceAwsRedshiftSecurityGroupDelete(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsRedshiftSecurityGroupRetrieve(d *schema.ResourceData, meta interface{}) (*redshift.ClusterSecurityGroup, error) {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).Redshift()
 
 	opts := redshift.DescribeClusterSecurityGroupsInput{
 		ClusterSecurityGroupName: aws.String(d.Id()),
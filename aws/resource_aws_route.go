@@ -104,7 +104,7 @@ func resourceAwsRoute() *schema.Resource {
 }
 
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	var numTargets int
 	var setTarget string
 	allowedTargets := []string{
@@ -241,7 +241,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	routeTableId := d.Get("route_table_id").(string)
 
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
@@ -275,7 +275,7 @@ func resourceAwsRouteSetResourceData(d *schema.ResourceData, route *ec2.Route) {
 }
 
 func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	var numTargets int
 	var setTarget string
 
@@ -364,7 +364,7 @@ func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	deleteOpts := &ec2.DeleteRouteInput{
 		RouteTableId: aws.String(d.Get("route_table_id").(string)),
@@ -409,7 +409,7 @@ func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	routeTableId := d.Get("route_table_id").(string)
 
 	findOpts := &ec2.DescribeRouteTablesInput{
@@ -38,7 +38,7 @@ func resourceAwsRoute53DelegationSet() *schema.Resource {
 }
 
 func resourceAwsRoute53DelegationSetCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).Route53()
 
 	callerRef := resource.UniqueId()
 	if v, ok := d.GetOk("reference_name"); ok {
@@ -64,7 +64,7 @@ func resourceAwsRoute53DelegationSetCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsRoute53DelegationSetRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).Route53()
 
 	input := &route53.GetReusableDelegationSetInput{
 		Id: aws.String(cleanDelegationSetId(d.Id())),
@@ -85,7 +85,7 @@ func resourceAwsRoute53DelegationSetRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRoute53DelegationSetDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).Route53()
 
 	input := &route53.DeleteReusableDelegationSetInput{
 		Id: aws.String(cleanDelegationSetId(d.Id())),
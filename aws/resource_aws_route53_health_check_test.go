@@ -176,7 +176,7 @@ func TestAccAWSRoute53HealthCheck_withSNI(t *testing.T) {
 }
 
 func testAccCheckRoute53HealthCheckDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).r53conn
+	conn := testAccProvider.Meta().(*AWSClient).Route53()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_health_check" {
@@ -205,7 +205,7 @@ func testAccCheckRoute53HealthCheckDestroy(s *terraform.State) error {
 
 func testAccCheckRoute53HealthCheckExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).Route53()
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
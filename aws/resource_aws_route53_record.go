@@ -252,7 +252,7 @@ func resourceAwsRoute53RecordUpdate(d *schema.ResourceData, meta interface{}) er
 
 	// Otherwise we delete the existing record and create a new record within
 	// a transactional change
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).Route53()
 	zone := cleanZoneID(d.Get("zone_id").(string))
 
 	var err error
@@ -361,7 +361,7 @@ func resourceAwsRoute53RecordUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsRoute53RecordCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).Route53()
 	zone := cleanZoneID(d.Get("zone_id").(string))
 
 	var err error
@@ -586,7 +586,7 @@ func resourceAwsRoute53RecordRead(d *schema.ResourceData, meta interface{}) erro
 // If there are other errors, it returns nil a nil recordset and passes on the
 // error.
 func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceRecordSet, error) {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).Route53()
 	// Scan for a
 	zone := cleanZoneID(d.Get("zone_id").(string))
 
@@ -635,7 +635,7 @@ func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceReco
 }
 
 func resourceAwsRoute53RecordDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).Route53()
 	// Get the records
 	rec, err := findRecord(d, meta)
 	if err != nil {
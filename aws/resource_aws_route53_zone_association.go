@@ -42,7 +42,7 @@ func resourceAwsRoute53ZoneAssociation() *schema.Resource {
 }
 
 func resourceAwsRoute53ZoneAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).Route53()
 
 	req := &route53.AssociateVPCWithHostedZoneInput{
 		HostedZoneId: aws.String(d.Get("zone_id").(string)),
@@ -90,7 +90,7 @@ func resourceAwsRoute53ZoneAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsRoute53ZoneAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).Route53()
 	zone_id, vpc_id := resourceAwsRoute53ZoneAssociationParseId(d.Id())
 	zone, err := r53.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(zone_id)})
 	if err != nil {
@@ -119,7 +119,7 @@ func resourceAwsRoute53ZoneAssociationUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsRoute53ZoneAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).Route53()
 	zone_id, vpc_id := resourceAwsRoute53ZoneAssociationParseId(d.Id())
 	log.Printf("[DEBUG] Deleting Route53 Private Zone (%s) association (VPC: %s)",
 		zone_id, vpc_id)
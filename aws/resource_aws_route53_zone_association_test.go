@@ -78,7 +78,7 @@ func testAccCheckRoute53ZoneAssociationDestroyWithProviders(providers *[]*schema
 }
 
 func testAccCheckRoute53ZoneAssociationDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).Route53()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone_association" {
 			continue
@@ -134,7 +134,7 @@ func testAccCheckRoute53ZoneAssociationExistsWithProvider(s *terraform.State, n
 
 	zone_id, vpc_id := resourceAwsRoute53ZoneAssociationParseId(rs.Primary.ID)
 
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).Route53()
 	resp, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(zone_id)})
 	if err != nil {
 		return fmt.Errorf("Hosted zone err: %v", err)
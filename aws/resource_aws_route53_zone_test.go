@@ -230,7 +230,7 @@ func testAccCheckRoute53ZoneDestroyWithProviders(providers *[]*schema.Provider)
 }
 
 func testAccCheckRoute53ZoneDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).Route53()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone" {
 			continue
@@ -260,7 +260,7 @@ func testAccCreateRandomRoute53RecordsInZoneIdWithProviders(providers *[]*schema
 }
 
 func testAccCreateRandomRoute53RecordsInZoneId(provider *schema.Provider, zone *route53.GetHostedZoneOutput, recordsCount int) error {
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).Route53()
 
 	var changes []*route53.Change
 	if recordsCount > 100 {
@@ -327,7 +327,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(s *terraform.State, n string, zon
 		return fmt.Errorf("No hosted zone ID is set")
 	}
 
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).Route53()
 	resp, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(rs.Primary.ID)})
 	if err != nil {
 		return fmt.Errorf("Hosted zone err: %v", err)
@@ -384,7 +384,7 @@ func testAccCheckRoute53ZoneAssociatesWithVpc(n string, zone *route53.GetHostedZ
 
 func testAccLoadTagsR53(zone *route53.GetHostedZoneOutput, td *route53.ResourceTagSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).Route53()
 
 		zone := cleanZoneID(*zone.HostedZone.Id)
 		req := &route53.ListTagsForResourceInput{
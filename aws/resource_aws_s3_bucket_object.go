@@ -26,6 +26,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 		Update: resourceAwsS3BucketObjectPut,
 		Delete: resourceAwsS3BucketObjectDelete,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -119,7 +121,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"website_redirect": {
 				Type:     schema.TypeString,
@@ -130,7 +133,7 @@ func resourceAwsS3BucketObject() *schema.Resource {
 }
 
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).S3()
 
 	restricted := meta.(*AWSClient).IsChinaCloud()
 
@@ -198,7 +201,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		if restricted {
 			return fmt.Errorf("This region does not allow for tags on S3 objects")
 		}
@@ -229,7 +232,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).S3()
 
 	restricted := meta.(*AWSClient).IsChinaCloud()
 
@@ -265,7 +268,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		kmsconn := meta.(*AWSClient).kmsconn
+		kmsconn := meta.(*AWSClient).KMS()
 		kmsresp, err := kmsconn.DescribeKey(&kms.DescribeKeyInput{
 			KeyId: aws.String("alias/aws/s3"),
 		})
@@ -296,14 +299,14 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return fmt.Errorf("Failed to get object tags (bucket: %s, key: %s): %s", bucket, key, err)
 		}
-		d.Set("tags", tagsToMapS3(tagResp.TagSet))
+		setTagsAll(d, meta, tagsToMapS3(tagResp.TagSet))
 	}
 
 	return nil
 }
 
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).S3()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
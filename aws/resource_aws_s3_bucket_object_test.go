@@ -203,7 +203,7 @@ func testAccCheckAWSS3BucketObjectVersionIdDiffers(first, second *s3.GetObjectOu
 }
 
 func testAccCheckAWSS3BucketObjectDestroy(s *terraform.State) error {
-	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+	s3conn := testAccProvider.Meta().(*AWSClient).S3()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_object" {
@@ -234,7 +234,7 @@ func testAccCheckAWSS3BucketObjectExists(n string, obj *s3.GetObjectOutput) reso
 			return fmt.Errorf("No S3 Bucket Object ID is set")
 		}
 
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).S3()
 		out, err := s3conn.GetObject(
 			&s3.GetObjectInput{
 				Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
@@ -351,7 +351,7 @@ func TestAccAWSS3BucketObject_acl(t *testing.T) {
 func testAccCheckAWSS3BucketObjectAcl(n string, expectedPerms []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[n]
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).S3()
 
 		out, err := s3conn.GetObjectAcl(&s3.GetObjectAclInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -479,7 +479,7 @@ func TestResourceAWSS3BucketObjectStorageClass_validation(t *testing.T) {
 func testAccCheckAWSS3BucketObjectStorageClass(n, expectedClass string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[n]
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).S3()
 
 		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -509,7 +509,7 @@ func testAccCheckAWSS3BucketObjectStorageClass(n, expectedClass string) resource
 func testAccCheckAWSS3BucketObjectSSE(n, expectedSSE string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[n]
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).S3()
 
 		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -37,7 +37,7 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 }
 
 func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).S3()
 
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
@@ -71,7 +71,7 @@ func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).S3()
 
 	log.Printf("[DEBUG] S3 bucket policy, read for bucket: %s", d.Id())
 	pol, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
@@ -90,7 +90,7 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsS3BucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).S3()
 
 	bucket := d.Get("bucket").(string)
 
@@ -30,7 +30,7 @@ func TestAccAwsServiceDiscoveryPrivateDnsNamespace_basic(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).ServiceDiscovery()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_private_dns_namespace" {
@@ -28,6 +28,8 @@ func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -53,12 +55,13 @@ func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateServiceCatalogPortfolioProviderName,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).ServiceCatalog()
 	input := servicecatalog.CreatePortfolioInput{
 		AcceptLanguage: aws.String("en"),
 	}
@@ -75,7 +78,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := []*servicecatalog.Tag{}
 		t := v.(map[string]interface{})
 		for k, v := range t {
@@ -99,7 +102,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).ServiceCatalog()
 	input := servicecatalog.DescribePortfolioInput{
 		AcceptLanguage: aws.String("en"),
 	}
@@ -127,12 +130,12 @@ func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interfa
 	for _, tag := range resp.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	d.Set("tags", tags)
+	setTagsAll(d, meta, tags)
 	return nil
 }
 
 func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).ServiceCatalog()
 	input := servicecatalog.UpdatePortfolioInput{
 		AcceptLanguage: aws.String("en"),
 		Id:             aws.String(d.Id()),
@@ -158,8 +161,8 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if d.HasChange("tags") {
-		currentTags, requiredTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		currentTags, requiredTags := d.GetChange("tags_all")
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
@@ -211,7 +214,7 @@ func tagUpdates(requriedTags, currentTags map[string]interface{}) ([]*servicecat
 }
 
 func resourceAwsServiceCatalogPortfolioDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).ServiceCatalog()
 	input := servicecatalog.DeletePortfolioInput{}
 	input.Id = aws.String(d.Id())
 
@@ -47,7 +47,7 @@ func testAccCheckAwsSESDomainDkimExists(n string) resource.TestCheckFunc {
 		}
 
 		domain := rs.Primary.ID
-		conn := testAccProvider.Meta().(*AWSClient).sesConn
+		conn := testAccProvider.Meta().(*AWSClient).SES()
 
 		params := &ses.GetIdentityDkimAttributesInput{
 			Identities: []*string{
@@ -37,7 +37,7 @@ func resourceAwsSesDomainIdentity() *schema.Resource {
 }
 
 func resourceAwsSesDomainIdentityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).SES()
 
 	domainName := d.Get("domain").(string)
 
@@ -56,7 +56,7 @@ func resourceAwsSesDomainIdentityCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSesDomainIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).SES()
 
 	domainName := d.Id()
 	d.Set("domain", domainName)
@@ -80,13 +80,13 @@ func resourceAwsSesDomainIdentityRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
-	d.Set("arn", fmt.Sprintf("arn:%s:ses:%s:%s:identity/%s", meta.(*AWSClient).partition, meta.(*AWSClient).region, meta.(*AWSClient).accountid, d.Id()))
+	d.Set("arn", fmt.Sprintf("arn:%s:ses:%s:%s:identity/%s", meta.(*AWSClient).Partition(), meta.(*AWSClient).region, meta.(*AWSClient).AccountID(), d.Id()))
 	d.Set("verification_token", verificationAttrs.VerificationToken)
 	return nil
 }
 
 func resourceAwsSesDomainIdentityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).SES()
 
 	domainName := d.Get("domain").(string)
 
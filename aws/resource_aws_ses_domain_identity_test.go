@@ -35,7 +35,7 @@ func TestAccAwsSESDomainIdentity_basic(t *testing.T) {
 }
 
 func testAccCheckAwsSESDomainIdentityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sesConn
+	conn := testAccProvider.Meta().(*AWSClient).SES()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ses_domain_identity" {
@@ -74,7 +74,7 @@ func testAccCheckAwsSESDomainIdentityExists(n string) resource.TestCheckFunc {
 		}
 
 		domain := rs.Primary.ID
-		conn := testAccProvider.Meta().(*AWSClient).sesConn
+		conn := testAccProvider.Meta().(*AWSClient).SES()
 
 		params := &ses.GetIdentityVerificationAttributesInput{
 			Identities: []*string{
@@ -101,9 +101,9 @@ func testAccCheckAwsSESDomainIdentityArn(n string, domain string) resource.TestC
 
 		expected := fmt.Sprintf(
 			"arn:%s:ses:%s:%s:identity/%s",
-			testAccProvider.Meta().(*AWSClient).partition,
+			testAccProvider.Meta().(*AWSClient).Partition(),
 			testAccProvider.Meta().(*AWSClient).region,
-			testAccProvider.Meta().(*AWSClient).accountid,
+			testAccProvider.Meta().(*AWSClient).AccountID(),
 			domain)
 
 		if rs.Primary.Attributes["arn"] != expected {
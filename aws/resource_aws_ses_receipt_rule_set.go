@@ -29,7 +29,7 @@ func resourceAwsSesReceiptRuleSet() *schema.Resource {
 }
 
 func resourceAwsSesReceiptRuleSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).SES()
 
 	ruleSetName := d.Get("rule_set_name").(string)
 
@@ -66,7 +66,7 @@ func resourceAwsSesReceiptRuleSetRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSesReceiptRuleSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).SES()
 
 	log.Printf("[DEBUG] SES Delete Receipt Rule Set: %s", d.Id())
 	_, err := conn.DeleteReceiptRuleSet(&ses.DeleteReceiptRuleSetInput{
@@ -81,7 +81,7 @@ func resourceAwsSesReceiptRuleSetDelete(d *schema.ResourceData, meta interface{}
 }
 
 func findRuleSet(name string, token *string, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).SES()
 
 	ruleSetExists := false
 
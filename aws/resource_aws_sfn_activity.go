@@ -38,7 +38,7 @@ func resourceAwsSfnActivity() *schema.Resource {
 }
 
 func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 	log.Print("[DEBUG] Creating Step Function Activity")
 
 	params := &sfn.CreateActivityInput{
@@ -56,7 +56,7 @@ func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 	log.Printf("[DEBUG] Reading Step Function Activity: %s", d.Id())
 
 	sm, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
@@ -80,7 +80,7 @@ func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSfnActivityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 	log.Printf("[DEBUG] Deleting Step Functions Activity: %s", d.Id())
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -44,7 +44,7 @@ func testAccCheckAWSSfnActivityExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Step Function ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sfnconn
+		conn := testAccProvider.Meta().(*AWSClient).SFN()
 
 		_, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
 			ActivityArn: aws.String(rs.Primary.ID),
@@ -59,7 +59,7 @@ func testAccCheckAWSSfnActivityExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSfnActivityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sfnconn
+	conn := testAccProvider.Meta().(*AWSClient).SFN()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sfn_activity" {
@@ -57,7 +57,7 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 }
 
 func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 	log.Print("[DEBUG] Creating Step Function State Machine")
 
 	params := &sfn.CreateStateMachineInput{
@@ -96,7 +96,7 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 	log.Printf("[DEBUG] Reading Step Function State Machine: %s", d.Id())
 
 	sm, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
@@ -126,7 +126,7 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 
 	params := &sfn.UpdateStateMachineInput{
 		StateMachineArn: aws.String(d.Id()),
@@ -149,7 +149,7 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSfnStateMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).SFN()
 	log.Printf("[DEBUG] Deleting Step Function State Machine: %s", d.Id())
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsShieldProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProtectionCreate,
+		Read:   resourceAwsShieldProtectionRead,
+		Delete: resourceAwsShieldProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Shield()
+
+	input := &shield.CreateProtectionInput{
+		Name:        aws.String(d.Get("name").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Shield Protection: %s", input)
+	resp, err := conn.CreateProtection(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Shield Protection %s: %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(resp.ProtectionId))
+
+	return resourceAwsShieldProtectionRead(d, meta)
+}
+
+func resourceAwsShieldProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Shield()
+
+	resp, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+		ProtectionId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Shield Protection (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Shield Protection %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", resp.Protection.Name)
+	d.Set("resource_arn", resp.Protection.ResourceArn)
+
+	return nil
+}
+
+func resourceAwsShieldProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).Shield()
+
+	log.Printf("[DEBUG] Deleting Shield Protection: %s", d.Id())
+	_, err := conn.DeleteProtection(&shield.DeleteProtectionInput{
+		ProtectionId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Shield Protection %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldProtection_eip(t *testing.T) {
+	var protection shield.Protection
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_shield_protection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSShieldProtectionConfig_eip(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionExists(resourceName, &protection),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "resource_arn", regexp.MustCompile(`:eip-allocation/eipalloc-`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtection_route53(t *testing.T) {
+	var protection shield.Protection
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_shield_protection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSShieldProtectionConfig_route53(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionExists(resourceName, &protection),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "resource_arn", regexp.MustCompile(`:hostedzone/`)),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckAWSShield skips the test unless the account has an active
+// Shield Advanced subscription, which protections require.
+func testAccPreCheckAWSShield(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).Shield()
+
+	resp, err := conn.GetSubscriptionState(&shield.GetSubscriptionStateInput{})
+	if err != nil {
+		t.Fatalf("Error reading Shield Subscription state: %s", err)
+	}
+
+	if aws.StringValue(resp.SubscriptionState) != shield.SubscriptionStateActive {
+		t.Skip("Shield Advanced subscription is not active")
+	}
+}
+
+func testAccCheckAWSShieldProtectionExists(n string, v *shield.Protection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Protection ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).Shield()
+		resp, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp.Protection
+		return nil
+	}
+}
+
+func testAccCheckAWSShieldProtectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).Shield()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection" {
+			continue
+		}
+
+		_, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Shield Protection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSShieldProtectionConfig_eip(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {
+  current = true
+}
+
+resource "aws_eip" "test" {
+  vpc = true
+}
+
+resource "aws_shield_protection" "test" {
+  name         = "%s"
+  resource_arn = "arn:aws:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSShieldProtectionConfig_route53(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%s.com"
+}
+
+resource "aws_shield_protection" "test" {
+  name         = "%s"
+  resource_arn = "arn:aws:route53:::hostedzone/${aws_route53_zone.test.zone_id}"
+}
+`, rName, rName)
+}
@@ -33,7 +33,7 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 }
 
 func resourceAwsSnapshotCreateVolumePermissionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -41,7 +41,7 @@ func resourceAwsSnapshotCreateVolumePermissionExists(d *schema.ResourceData, met
 }
 
 func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -84,7 +84,7 @@ func resourceAwsSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -36,7 +36,7 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 
 func testAccAWSSnapshotCreateVolumePermissionExists(accountId, snapshotId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		if has, err := hasCreateVolumePermission(conn, *snapshotId, *accountId); err != nil {
 			return err
 		} else if !has {
@@ -48,7 +48,7 @@ func testAccAWSSnapshotCreateVolumePermissionExists(accountId, snapshotId *strin
 
 func testAccAWSSnapshotCreateVolumePermissionDestroyed(accountId, snapshotId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		if has, err := hasCreateVolumePermission(conn, *snapshotId, *accountId); err != nil {
 			return err
 		} else if has {
@@ -72,7 +72,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 }
 
 func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).SNS()
 
 	name := d.Get("name").(string)
 
@@ -111,7 +111,7 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 						AttributeName:  aws.String(attrKey),
 						AttributeValue: aws.String(n.(string)),
 					}
-					conn := meta.(*AWSClient).snsconn
+					conn := meta.(*AWSClient).SNS()
 					// Retry the update in the event of an eventually consistent style of
 					// error, where say an IAM resource is successfully created but not
 					// actually available. See https://github.com/hashicorp/terraform/issues/3660
@@ -128,7 +128,7 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).SNS()
 
 	attributeOutput, err := snsconn.GetTopicAttributes(&sns.GetTopicAttributesInput{
 		TopicArn: aws.String(d.Id()),
@@ -185,7 +185,7 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).SNS()
 
 	log.Printf("[DEBUG] SNS Delete Topic: %s", d.Id())
 	_, err := snsconn.DeleteTopic(&sns.DeleteTopicInput{
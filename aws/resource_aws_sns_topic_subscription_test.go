@@ -70,7 +70,7 @@ func TestAccAWSSNSTopicSubscription_autoConfirmingSecuredEndpoint(t *testing.T)
 }
 
 func testAccCheckAWSSNSTopicSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).SNS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic" {
@@ -109,7 +109,7 @@ func testAccCheckAWSSNSTopicSubscriptionExists(n string) resource.TestCheckFunc
 			return fmt.Errorf("No SNS subscription with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).SNS()
 
 		params := &sns.GetSubscriptionAttributesInput{
 			SubscriptionArn: aws.String(rs.Primary.ID),
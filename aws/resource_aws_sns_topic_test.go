@@ -33,6 +33,40 @@ func TestAccAWSSNSTopic_basic(t *testing.T) {
 	})
 }
 
+func TestAWSSNSTopic_mockApi(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	topics := newAwsMockSns(api)
+
+	rName := acctest.RandString(10)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("SNS topics", topics.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig() + testAccAWSSNSTopicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sns_topic.test_topic", "name", "terraform-test-topic-"+rName),
+					resource.TestCheckResourceAttr("aws_sns_topic.test_topic", "arn",
+						fmt.Sprintf("arn:aws:sns:us-east-1:%s:terraform-test-topic-%s", awsMockAccountId, rName)),
+				),
+			},
+			{
+				Config: api.ProviderConfig() + testAccAWSSNSTopicConfig_withDisplayName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sns_topic.test_topic", "display_name", "Terraform Test Topic"),
+				),
+			},
+			{
+				Config:            api.ProviderConfig() + testAccAWSSNSTopicConfig_withDisplayName(rName),
+				ResourceName:      "aws_sns_topic.test_topic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSNSTopic_policy(t *testing.T) {
 	rName := acctest.RandString(10)
 	expectedPolicy := `{"Statement":[{"Sid":"Stmt1445931846145","Effect":"Allow","Principal":{"AWS":"*"},"Action":"sns:Publish","Resource":"arn:aws:sns:us-west-2::example"}],"Version":"2012-10-17","Id":"Policy1445931846145"}`
@@ -126,7 +160,7 @@ func testAccCheckAWSNSTopicHasPolicy(n string, expectedPolicyText string) resour
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).SNS()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -168,7 +202,7 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).SNS()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -198,7 +232,7 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 }
 
 func testAccCheckAWSSNSTopicDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).SNS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic" {
@@ -235,7 +269,7 @@ func testAccCheckAWSSNSTopicExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).SNS()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -379,3 +413,12 @@ EOF
 }
 `, r)
 }
+
+func testAccAWSSNSTopicConfig_withDisplayName(r string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+  name         = "terraform-test-topic-%s"
+  display_name = "Terraform Test Topic"
+}
+`, r)
+}
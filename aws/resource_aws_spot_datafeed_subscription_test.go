@@ -47,7 +47,7 @@ func testAccAWSSpotDatafeedSubscription_basic(t *testing.T) {
 
 func testAccCheckAWSSpotDatafeedSubscriptionDisappears(subscription *ec2.SpotDatafeedSubscription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		_, err := conn.DeleteSpotDatafeedSubscription(&ec2.DeleteSpotDatafeedSubscriptionInput{})
 		if err != nil {
@@ -101,7 +101,7 @@ func testAccCheckAWSSpotDatafeedSubscriptionExists(n string, subscription *ec2.S
 			return fmt.Errorf("No policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		resp, err := conn.DescribeSpotDatafeedSubscription(&ec2.DescribeSpotDatafeedSubscriptionInput{})
 		if err != nil {
@@ -115,7 +115,7 @@ func testAccCheckAWSSpotDatafeedSubscriptionExists(n string, subscription *ec2.S
 }
 
 func testAccCheckAWSSpotDatafeedSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_datafeed_subscription" {
@@ -453,7 +453,7 @@ func testAccCheckAWSSpotFleetRequestExists(
 			return errors.New("No Spot fleet request with that id exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		params := &ec2.DescribeSpotFleetRequestsInput{
 			SpotFleetRequestIds: []*string{&rs.Primary.ID},
@@ -521,7 +521,7 @@ func testAccCheckAWSSpotFleetRequest_PlacementAttributes(
 }
 
 func testAccCheckAWSSpotFleetRequestDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_fleet_request" {
@@ -44,6 +44,8 @@ func resourceAwsSqsQueue() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -122,13 +124,14 @@ func resourceAwsSqsQueue() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).SQS()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -197,7 +200,7 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).SQS()
 
 	if err := setTagsSQS(sqsconn, d); err != nil {
 		return err
@@ -238,7 +241,7 @@ func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).SQS()
 
 	attributeOutput, err := sqsconn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
 		QueueUrl:       aws.String(d.Id()),
@@ -298,19 +301,17 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("fifo_queue", d.Get("fifo_queue").(bool))
 	d.Set("content_based_deduplication", d.Get("content_based_deduplication").(bool))
 
-	listTagsOutput, err := sqsconn.ListQueueTags(&sqs.ListQueueTagsInput{
-		QueueUrl: aws.String(d.Id()),
-	})
+	tags, err := sqsTagsAdapter{conn: sqsconn, queueUrl: d.Id()}.ListTags()
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapGeneric(listTagsOutput.Tags))
+	setTagsAll(d, meta, tags.Map())
 
 	return nil
 }
 
 func resourceAwsSqsQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).SQS()
 
 	log.Printf("[DEBUG] SQS Delete Queue: %s", d.Id())
 	_, err := sqsconn.DeleteQueue(&sqs.DeleteQueueInput{
@@ -337,38 +338,39 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 
 }
 
-func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k, _ := range remove {
-				keys = append(keys, aws.String(k))
-			}
+// sqsTagsAdapter updates the tags of an SQS queue.
+type sqsTagsAdapter struct {
+	conn     *sqs.SQS
+	queueUrl string
+}
 
-			_, err := conn.UntagQueue(&sqs.UntagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				TagKeys:  keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-
-			_, err := conn.TagQueue(&sqs.TagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				Tags:     create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+func (a sqsTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListQueueTags(&sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(a.queueUrl),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return newKeyValueTagsGeneric(resp.Tags).IgnoreAws(), nil
+}
+
+func (a sqsTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagQueue(&sqs.TagQueueInput{
+		QueueUrl: aws.String(a.queueUrl),
+		Tags:     tags.GenericTags(),
+	})
+	return err
+}
+
+func (a sqsTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagQueue(&sqs.UntagQueueInput{
+		QueueUrl: aws.String(a.queueUrl),
+		TagKeys:  aws.StringSlice(keys),
+	})
+	return err
+}
+
+func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
+	return updateTagsAll(d, sqsTagsAdapter{conn: conn, queueUrl: d.Id()})
 }
@@ -39,7 +39,7 @@ func resourceAwsSqsQueuePolicy() *schema.Resource {
 }
 
 func resourceAwsSqsQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).SQS()
 	url := d.Get("queue_url").(string)
 
 	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
@@ -58,7 +58,7 @@ func resourceAwsSqsQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSqsQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).SQS()
 
 	out, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
 		QueueUrl:       aws.String(d.Id()),
@@ -88,7 +88,7 @@ func resourceAwsSqsQueuePolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSqsQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).SQS()
 
 	log.Printf("[DEBUG] Deleting SQS Queue Policy of %s", d.Id())
 	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
@@ -79,6 +79,188 @@ func TestAccAWSSQSQueue_tags(t *testing.T) {
 	})
 }
 
+func TestAWSSQSQueue_mockApi(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	queues := newAwsMockSqs(api)
+
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("SQS queues", queues.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig() + testAccAWSSQSConfigWithTags(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "name", queueName),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "visibility_timeout_seconds", "30"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Usage", "original"),
+				),
+			},
+			{
+				Config: api.ProviderConfig() + testAccAWSSQSConfigWithOverrides(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "delay_seconds", "90"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "visibility_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "0"),
+				),
+			},
+			{
+				Config:            api.ProviderConfig() + testAccAWSSQSConfigWithOverrides(queueName),
+				ResourceName:      "aws_sqs_queue.queue",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAWSSQSQueue_mockApiDefaultTags(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	queues := newAwsMockSqs(api)
+
+	defaultTags := `
+  default_tags {
+    tags {
+      Environment = "default"
+      Owner       = "terraform"
+    }
+  }
+`
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("SQS queues", queues.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig(defaultTags) + testAccAWSSQSConfigWithTags(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Environment", "production"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.%", "3"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.Environment", "production"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.Owner", "terraform"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.Usage", "original"),
+				),
+			},
+			{
+				Config: api.ProviderConfig(defaultTags) + testAccAWSSQSConfigWithOverrides(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "0"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.Environment", "default"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.Owner", "terraform"),
+				),
+			},
+			{
+				Config: api.ProviderConfig() + testAccAWSSQSConfigWithOverrides(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "0"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAWSSQSQueue_mockApiIgnoreTags(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	queues := newAwsMockSqs(api)
+
+	ignoreTags := `
+  ignore_tags {
+    keys         = ["CostCenter"]
+    key_prefixes = ["kubernetes.io/"]
+  }
+`
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("SQS queues", queues.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig(ignoreTags) + testAccAWSSQSConfigWithTags(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					queues.Tag(queueName, "kubernetes.io/cluster/test", "owned")
+					queues.Tag(queueName, "CostCenter", "1234")
+				},
+				Config: api.ProviderConfig(ignoreTags) + testAccAWSSQSConfigWithTagsChanged(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Usage", "changed"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags_all.%", "1"),
+					func(*terraform.State) error {
+						tags := queues.Tags(queueName)
+						if len(tags) != 3 || tags["kubernetes.io/cluster/test"] != "owned" || tags["CostCenter"] != "1234" {
+							return fmt.Errorf("ignored tags not kept: %#v", tags)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAWSSQSQueue_mockApiRegion(t *testing.T) {
+	api := newAwsMockApi(t)
+	defer api.Close()
+	queues := newAwsMockSqs(api)
+
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.Providers(),
+		CheckDestroy: testAccCheckAwsMockDestroyed("SQS queues", queues.Count),
+		Steps: []resource.TestStep{
+			{
+				Config: api.ProviderConfig() + testAccAWSSQSConfigWithRegion(queueName, "Usage = \"original\""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "region", "eu-west-1"),
+					resource.TestMatchResourceAttr("aws_sqs_queue.queue", "arn", regexp.MustCompile("^arn:aws:sqs:eu-west-1:")),
+					func(*terraform.State) error {
+						if region := queues.Region(queueName); region != "eu-west-1" {
+							return fmt.Errorf("queue created in region %q", region)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: api.ProviderConfig() + testAccAWSSQSConfigWithRegion(queueName, "Usage = \"changed\""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Usage", "changed"),
+				),
+			},
+			{
+				Config:            api.ProviderConfig() + testAccAWSSQSConfigWithRegion(queueName, "Usage = \"changed\""),
+				ResourceName:      "aws_sqs_queue.queue",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSQSQueueImportStateIdFunc("aws_sqs_queue.queue", "eu-west-1"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSQSQueueImportStateIdFunc(resourceName, region string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s@%s", rs.Primary.ID, region), nil
+	}
+}
+
 func TestAccAWSSQSQueue_namePrefix(t *testing.T) {
 	prefix := "acctest-sqs-queue"
 	resource.Test(t, resource.TestCase{
@@ -220,7 +402,7 @@ func TestAccAWSSQSQueue_ExpectContentBasedDeduplicationError(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	conn := testAccProvider.Meta().(*AWSClient).SQS()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -256,7 +438,7 @@ func testAccCheckAWSQSHasPolicy(n string, expectedPolicyText string) resource.Te
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).SQS()
 
 		params := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
@@ -314,7 +496,7 @@ func testAccCheckAWSSQSExistsWithDefaults(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).SQS()
 
 		params := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
@@ -381,7 +563,7 @@ func testAccCheckAWSSQSExistsWithOverrides(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).SQS()
 
 		params := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
@@ -475,6 +657,18 @@ resource "aws_sqs_queue" "queue" {
 }`, r)
 }
 
+func testAccAWSSQSConfigWithRegion(r, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name   = "%s"
+  region = "eu-west-1"
+
+  tags {
+    %s
+  }
+}`, r, tags)
+}
+
 func testAccAWSSQSConfigWithRedrive(name string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "my_queue" {
@@ -61,7 +61,7 @@ func resourceAwsSsmActivation() *schema.Resource {
 }
 
 func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] SSM activation create: %s", d.Id())
 
@@ -117,7 +117,7 @@ func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSsmActivationRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] Reading SSM Activation: %s", d.Id())
 
@@ -155,7 +155,7 @@ func resourceAwsSsmActivationRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmActivationDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] Deleting SSM Activation: %s", d.Id())
 
@@ -40,7 +40,7 @@ func testAccCheckAWSSSMActivationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SSM Activation ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 		_, err := conn.DescribeActivations(&ssm.DescribeActivationsInput{
 			Filters: []*ssm.DescribeActivationsFilter{
@@ -63,7 +63,7 @@ func testAccCheckAWSSSMActivationExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSSMActivationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_activation" {
@@ -95,7 +95,7 @@ func resourceAwsSsmAssociation() *schema.Resource {
 }
 
 func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] SSM association create: %s", d.Id())
 
@@ -147,7 +147,7 @@ func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] Reading SSM Association: %s", d.Id())
 
@@ -185,7 +185,7 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSsmAssocationUpdate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] SSM association update: %s", d.Id())
 
@@ -222,7 +222,7 @@ func resourceAwsSsmAssocationUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSsmAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] Deleting SSM Assosciation: %s", d.Id())
 
@@ -131,7 +131,7 @@ func resourceAwsSsmDocument() *schema.Resource {
 }
 
 func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Creating SSM Document: %s", d.Get("name").(string))
 
@@ -169,7 +169,7 @@ func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[DEBUG] Reading SSM Document: %s", d.Id())
 
@@ -287,7 +287,7 @@ func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmDocumentDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	if err := deleteDocumentPermissions(d, meta); err != nil {
 		return err
@@ -336,7 +336,7 @@ func resourceAwsSsmDocumentDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func setDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Setting permissions for document: %s", d.Id())
 	permission := d.Get("permissions").(map[string]interface{})
@@ -363,7 +363,7 @@ func setDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
 }
 
 func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Getting permissions for document: %s", d.Id())
 
@@ -407,7 +407,7 @@ func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[strin
 }
 
 func deleteDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Removing permissions from document: %s", d.Id())
 
@@ -439,7 +439,7 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 
 	newDefaultVersion := d.Get("default_version").(string)
 
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 	updated, err := ssmconn.UpdateDocument(updateDocInput)
 
 	if isAWSErr(err, "DuplicateDocumentContent", "") {
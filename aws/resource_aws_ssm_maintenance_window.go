@@ -52,7 +52,7 @@ func resourceAwsSsmMaintenanceWindow() *schema.Resource {
 }
 
 func resourceAwsSsmMaintenanceWindowCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	params := &ssm.CreateMaintenanceWindowInput{
 		Name:     aws.String(d.Get("name").(string)),
@@ -72,7 +72,7 @@ func resourceAwsSsmMaintenanceWindowCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSsmMaintenanceWindowUpdate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	params := &ssm.UpdateMaintenanceWindowInput{
 		WindowId: aws.String(d.Id()),
@@ -109,7 +109,7 @@ func resourceAwsSsmMaintenanceWindowUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSsmMaintenanceWindowRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	params := &ssm.GetMaintenanceWindowInput{
 		WindowId: aws.String(d.Id()),
@@ -131,7 +131,7 @@ func resourceAwsSsmMaintenanceWindowRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSsmMaintenanceWindowDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Deleting SSM Maintenance Window: %s", d.Id())
 
@@ -58,7 +58,7 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 }
 
 func resourceAwsSsmMaintenanceWindowTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Registering SSM Maintenance Window Target")
 
@@ -83,7 +83,7 @@ func resourceAwsSsmMaintenanceWindowTargetCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsSsmMaintenanceWindowTargetRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	params := &ssm.DescribeMaintenanceWindowTargetsInput{
 		WindowId: aws.String(d.Get("window_id").(string)),
@@ -125,7 +125,7 @@ func resourceAwsSsmMaintenanceWindowTargetRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsSsmMaintenanceWindowTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Deregistering SSM Maintenance Window Target: %s", d.Id())
 
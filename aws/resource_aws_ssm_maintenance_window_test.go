@@ -65,7 +65,7 @@ func testAccCheckAWSSSMMaintenanceWindowExists(n string) resource.TestCheckFunc
 			return fmt.Errorf("No SSM Maintenance Window ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 		resp, err := conn.DescribeMaintenanceWindows(&ssm.DescribeMaintenanceWindowsInput{
 			Filters: []*ssm.MaintenanceWindowFilter{
@@ -90,7 +90,7 @@ func testAccCheckAWSSSMMaintenanceWindowExists(n string) resource.TestCheckFunc
 }
 
 func testAccCheckAWSSSMMaintenanceWindowDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_maintenance_window" {
@@ -193,7 +193,7 @@ func testAccCheckAWSSSMParameterExists(n string, param *ssm.Parameter) resource.
 			return fmt.Errorf("No SSM Parameter ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 		paramInput := &ssm.GetParametersInput{
 			Names: []*string{
@@ -219,7 +219,7 @@ func testAccCheckAWSSSMParameterExists(n string, param *ssm.Parameter) resource.
 
 func testAccCheckAWSSSMParameterDisappears(param *ssm.Parameter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 		paramInput := &ssm.DeleteParameterInput{
 			Name: param.Name,
@@ -235,7 +235,7 @@ func testAccCheckAWSSSMParameterDisappears(param *ssm.Parameter) resource.TestCh
 }
 
 func testAccCheckAWSSSMParameterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).SSM()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_parameter" {
@@ -30,7 +30,7 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 }
 
 func resourceAwsSsmPatchGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	params := &ssm.RegisterPatchBaselineForPatchGroupInput{
 		BaselineId: aws.String(d.Get("baseline_id").(string)),
@@ -47,7 +47,7 @@ func resourceAwsSsmPatchGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSsmPatchGroupRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	params := &ssm.DescribePatchGroupsInput{}
 
@@ -77,7 +77,7 @@ func resourceAwsSsmPatchGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmPatchGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).SSM()
 
 	log.Printf("[INFO] Deleting SSM Patch Group: %s", d.Id())
 
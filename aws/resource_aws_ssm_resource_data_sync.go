@@ -57,7 +57,7 @@ func resourceAwsSsmResourceDataSync() *schema.Resource {
 }
 
 func resourceAwsSsmResourceDataSyncCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssmconn
+	conn := meta.(*AWSClient).SSM()
 
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		input := &ssm.CreateResourceDataSyncInput{
@@ -83,7 +83,7 @@ func resourceAwsSsmResourceDataSyncCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsSsmResourceDataSyncRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssmconn
+	conn := meta.(*AWSClient).SSM()
 
 	syncItem, err := findResourceDataSyncItem(conn, d.Get("name").(string))
 	if err != nil {
@@ -98,7 +98,7 @@ func resourceAwsSsmResourceDataSyncRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSsmResourceDataSyncDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssmconn
+	conn := meta.(*AWSClient).SSM()
 
 	input := &ssm.DeleteResourceDataSyncInput{
 		SyncName: aws.String(d.Get("name").(string)),
@@ -54,7 +54,7 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 }
 
 func resourceAwsVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	name := d.Get("device_name").(string)
 	iID := d.Get("instance_id").(string)
 	vID := d.Get("volume_id").(string)
@@ -172,7 +172,7 @@ func volumeAttachmentStateRefreshFunc(conn *ec2.EC2, name, volumeID, instanceID
 }
 
 func resourceAwsVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	request := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(d.Get("volume_id").(string))},
@@ -206,7 +206,7 @@ func resourceAwsVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	if _, ok := d.GetOk("skip_destroy"); ok {
 		log.Printf("[INFO] Found skip_destroy to be true, removing attachment %q from state", d.Id())
@@ -69,7 +69,7 @@ func TestAccAWSVolumeAttachment_attachStopped(t *testing.T) {
 	var v ec2.Volume
 
 	stopInstance := func() {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 		_, err := conn.StopInstances(&ec2.StopInstancesInput{
 			InstanceIds: []*string{aws.String(*i.InstanceId)},
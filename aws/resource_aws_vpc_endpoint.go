@@ -3,12 +3,25 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// VPC endpoint states, which the EC2 API returns in lower camel case
+	// unlike the values of the SDK State enum.
+	vpcEndpointStatePending           = "pending"
+	vpcEndpointStatePendingAcceptance = "pendingAcceptance"
+	vpcEndpointStateAvailable         = "available"
+	vpcEndpointStateDeleting          = "deleting"
+	vpcEndpointStateDeleted           = "deleted"
 )
 
 func resourceAwsVpcEndpoint() *schema.Resource {
@@ -21,6 +34,12 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
 				Type:         schema.TypeString,
@@ -37,11 +56,29 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"vpc_endpoint_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.VpcEndpointTypeGateway,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.VpcEndpointTypeGateway,
+					ec2.VpcEndpointTypeInterface,
+				}, false),
+			},
 			"service_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"auto_accept": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"route_table_ids": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -49,6 +86,47 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"network_interface_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dns_entry": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"prefix_list_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,10 +141,16 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 }
 
 func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	input := &ec2.CreateVpcEndpointInput{
-		VpcId:       aws.String(d.Get("vpc_id").(string)),
-		ServiceName: aws.String(d.Get("service_name").(string)),
+		VpcId:           aws.String(d.Get("vpc_id").(string)),
+		VpcEndpointType: aws.String(d.Get("vpc_endpoint_type").(string)),
+		ServiceName:     aws.String(d.Get("service_name").(string)),
+	}
+
+	if d.Get("vpc_endpoint_type").(string) == ec2.VpcEndpointTypeInterface {
+		// Private DNS names are only supported by interface endpoints
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
 	}
 
 	if v, ok := d.GetOk("route_table_ids"); ok {
@@ -76,6 +160,14 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("subnet_ids"); ok {
+		input.SubnetIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("policy"); ok {
 		policy, err := normalizeJsonString(v)
 		if err != nil {
@@ -93,11 +185,19 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*output.VpcEndpoint.VpcEndpointId)
 
+	if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if err := vpcEndpointAccept(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
 func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	input := &ec2.DescribeVpcEndpointsInput{
 		VpcEndpointIds: []*string{aws.String(d.Id())},
 	}
@@ -127,67 +227,48 @@ func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 	vpce := output.VpcEndpoints[0]
 
-	// A VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
-	// The prefix list ID can be used in security groups, so retrieve it to support that capability.
-	prefixListServiceName := *vpce.ServiceName
-	prefixListInput := &ec2.DescribePrefixListsInput{
-		Filters: []*ec2.Filter{
-			{Name: aws.String("prefix-list-name"), Values: []*string{aws.String(prefixListServiceName)}},
-		},
+	switch aws.StringValue(vpce.State) {
+	case vpcEndpointStateDeleting, vpcEndpointStateDeleted:
+		log.Printf("[WARN] VPC Endpoint (%s) is %s, removing from state", d.Id(), aws.StringValue(vpce.State))
+		d.SetId("")
+		return nil
 	}
 
-	log.Printf("[DEBUG] Reading VPC Endpoint prefix list: %s", prefixListServiceName)
-	prefixListsOutput, err := conn.DescribePrefixLists(prefixListInput)
+	return vpcEndpointAttributes(d, vpce, conn)
+}
 
-	if err != nil {
-		_, ok := err.(awserr.Error)
-		if !ok {
-			return fmt.Errorf("Error reading VPC Endpoint prefix list: %s", err.Error())
+func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	if d.HasChange("auto_accept") {
+		if err := vpcEndpointAccept(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
-	if len(prefixListsOutput.PrefixLists) != 1 {
-		return fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", prefixListServiceName)
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
 	}
+	modify := false
 
-	policy, err := normalizeJsonString(*vpce.PolicyDocument)
-	if err != nil {
-		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	if d.HasChange("route_table_ids") {
+		input.AddRouteTableIds, input.RemoveRouteTableIds = vpcEndpointSetChanges(d, "route_table_ids")
+		modify = true
 	}
 
-	d.Set("vpc_id", vpce.VpcId)
-	d.Set("policy", policy)
-	d.Set("service_name", vpce.ServiceName)
-	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
-		return err
+	if d.HasChange("subnet_ids") {
+		input.AddSubnetIds, input.RemoveSubnetIds = vpcEndpointSetChanges(d, "subnet_ids")
+		modify = true
 	}
-	pl := prefixListsOutput.PrefixLists[0]
-	d.Set("prefix_list_id", pl.PrefixListId)
-	d.Set("cidr_blocks", aws.StringValueSlice(pl.Cidrs))
-
-	return nil
-}
 
-func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
-	input := &ec2.ModifyVpcEndpointInput{
-		VpcEndpointId: aws.String(d.Id()),
+	if d.HasChange("security_group_ids") {
+		input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = vpcEndpointSetChanges(d, "security_group_ids")
+		modify = true
 	}
 
-	if d.HasChange("route_table_ids") {
-		o, n := d.GetChange("route_table_ids")
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-
-		add := expandStringList(ns.Difference(os).List())
-		if len(add) > 0 {
-			input.AddRouteTableIds = add
-		}
-
-		remove := expandStringList(os.Difference(ns).List())
-		if len(remove) > 0 {
-			input.RemoveRouteTableIds = remove
-		}
+	if d.HasChange("private_dns_enabled") {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
+		modify = true
 	}
 
 	if d.HasChange("policy") {
@@ -196,20 +277,27 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
 		}
 		input.PolicyDocument = aws.String(policy)
+		modify = true
 	}
 
-	log.Printf("[DEBUG] Updating VPC Endpoint: %#v", input)
-	_, err := conn.ModifyVpcEndpoint(input)
-	if err != nil {
-		return fmt.Errorf("Error updating VPC Endpoint: %s", err)
+	if modify {
+		log.Printf("[DEBUG] Updating VPC Endpoint: %#v", input)
+		_, err := conn.ModifyVpcEndpoint(input)
+		if err != nil {
+			return fmt.Errorf("Error updating VPC Endpoint: %s", err)
+		}
+		log.Printf("[DEBUG] VPC Endpoint %q updated", d.Id())
+
+		if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
-	log.Printf("[DEBUG] VPC Endpoint %q updated", input.VpcEndpointId)
 
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
 func resourceAwsVPCEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	input := &ec2.DeleteVpcEndpointsInput{
 		VpcEndpointIds: []*string{aws.String(d.Id())},
 	}
@@ -230,8 +318,222 @@ func resourceAwsVPCEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{vpcEndpointStateAvailable, vpcEndpointStatePending, vpcEndpointStatePendingAcceptance, vpcEndpointStateDeleting},
+		Target:     []string{vpcEndpointStateDeleted},
+		Refresh:    vpcEndpointStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %s to delete: %s", d.Id(), err)
+	}
+
 	log.Printf("[DEBUG] VPC Endpoint %q deleted", d.Id())
 	d.SetId("")
 
 	return nil
 }
+
+// vpcEndpointAccept accepts the connection of a VPC endpoint to a service of
+// the same account requiring acceptance when auto_accept is set, and waits
+// for the endpoint to be available.
+func vpcEndpointAccept(conn *ec2.EC2, d *schema.ResourceData, timeout time.Duration) error {
+	if !d.Get("auto_accept").(bool) {
+		return nil
+	}
+
+	vpce, state, err := vpcEndpointStateRefresh(conn, d.Id())()
+	if err != nil {
+		return err
+	}
+	if state != vpcEndpointStatePendingAcceptance {
+		return nil
+	}
+
+	svcName := aws.StringValue(vpce.(*ec2.VpcEndpoint).ServiceName)
+	svcs, err := conn.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"service-name": svcName,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Endpoint Service %s: %s", svcName, err)
+	}
+	if len(svcs.ServiceConfigurations) != 1 {
+		return fmt.Errorf("VPC Endpoint Service %s not found in this account, it can't be auto accepted", svcName)
+	}
+
+	log.Printf("[DEBUG] Accepting VPC Endpoint %s", d.Id())
+	_, err = conn.AcceptVpcEndpointConnections(&ec2.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      svcs.ServiceConfigurations[0].ServiceId,
+		VpcEndpointIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return fmt.Errorf("Error accepting VPC Endpoint %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{vpcEndpointStatePendingAcceptance, vpcEndpointStatePending},
+		Target:     []string{vpcEndpointStateAvailable},
+		Refresh:    vpcEndpointStateRefresh(conn, d.Id()),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %s to be accepted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// vpcEndpointWaitUntilAvailable waits for a VPC endpoint to be available, or
+// to be waiting for its acceptance by the owner of the service.
+func vpcEndpointWaitUntilAvailable(conn *ec2.EC2, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{vpcEndpointStatePending},
+		Target:     []string{vpcEndpointStateAvailable, vpcEndpointStatePendingAcceptance},
+		Refresh:    vpcEndpointStateRefresh(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %s to become available: %s", id, err)
+	}
+
+	return nil
+}
+
+func vpcEndpointStateRefresh(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Reading VPC Endpoint: %s", id)
+		resp, err := conn.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			VpcEndpointIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+				return false, vpcEndpointStateDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.VpcEndpoints) == 0 {
+			return false, vpcEndpointStateDeleted, nil
+		}
+
+		vpce := resp.VpcEndpoints[0]
+		state := aws.StringValue(vpce.State)
+		if state == "" {
+			state = vpcEndpointStatePending
+		}
+		return vpce, state, nil
+	}
+}
+
+// vpcEndpointSetChanges returns the elements added to and removed from a set
+// of IDs of a VPC endpoint.
+func vpcEndpointSetChanges(d *schema.ResourceData, key string) ([]*string, []*string) {
+	o, n := d.GetChange(key)
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	var add, remove []*string
+	if v := ns.Difference(os); v.Len() > 0 {
+		add = expandStringSet(v)
+	}
+	if v := os.Difference(ns); v.Len() > 0 {
+		remove = expandStringSet(v)
+	}
+
+	return add, remove
+}
+
+// vpcEndpointAttributes saves a VPC endpoint in the attributes of the
+// aws_vpc_endpoint resource or data source.
+func vpcEndpointAttributes(d *schema.ResourceData, vpce *ec2.VpcEndpoint, conn *ec2.EC2) error {
+	d.Set("state", vpce.State)
+	d.Set("vpc_id", vpce.VpcId)
+	d.Set("service_name", vpce.ServiceName)
+	d.Set("private_dns_enabled", vpce.PrivateDnsEnabled)
+
+	// Endpoints created before interface endpoints were introduced don't
+	// report their type
+	vpceType := aws.StringValue(vpce.VpcEndpointType)
+	if vpceType == "" {
+		vpceType = ec2.VpcEndpointTypeGateway
+	}
+	d.Set("vpc_endpoint_type", vpceType)
+
+	policy := ""
+	if vpce.PolicyDocument != nil {
+		var err error
+		policy, err = normalizeJsonString(*vpce.PolicyDocument)
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+	}
+	d.Set("policy", policy)
+
+	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
+		return err
+	}
+	if err := d.Set("subnet_ids", aws.StringValueSlice(vpce.SubnetIds)); err != nil {
+		return err
+	}
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(vpce.NetworkInterfaceIds)); err != nil {
+		return err
+	}
+
+	var securityGroupIds []string
+	for _, group := range vpce.Groups {
+		securityGroupIds = append(securityGroupIds, aws.StringValue(group.GroupId))
+	}
+	if err := d.Set("security_group_ids", securityGroupIds); err != nil {
+		return err
+	}
+
+	var dnsEntries []interface{}
+	for _, entry := range vpce.DnsEntries {
+		dnsEntries = append(dnsEntries, map[string]interface{}{
+			"dns_name":       aws.StringValue(entry.DnsName),
+			"hosted_zone_id": aws.StringValue(entry.HostedZoneId),
+		})
+	}
+	if err := d.Set("dns_entry", dnsEntries); err != nil {
+		return err
+	}
+
+	// Only gateway endpoints are associated with a prefix list, whose ID can
+	// be used in security groups
+	if vpceType != ec2.VpcEndpointTypeGateway {
+		d.Set("prefix_list_id", "")
+		d.Set("cidr_blocks", nil)
+		return nil
+	}
+
+	prefixListServiceName := *vpce.ServiceName
+	prefixListInput := &ec2.DescribePrefixListsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("prefix-list-name"), Values: []*string{aws.String(prefixListServiceName)}},
+		},
+	}
+
+	log.Printf("[DEBUG] Reading VPC Endpoint prefix list: %s", prefixListServiceName)
+	prefixListsOutput, err := conn.DescribePrefixLists(prefixListInput)
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Endpoint prefix list: %s", err.Error())
+	}
+
+	if len(prefixListsOutput.PrefixLists) != 1 {
+		return fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", prefixListServiceName)
+	}
+
+	pl := prefixListsOutput.PrefixLists[0]
+	d.Set("prefix_list_id", pl.PrefixListId)
+	d.Set("cidr_blocks", aws.StringValueSlice(pl.Cidrs))
+
+	return nil
+}
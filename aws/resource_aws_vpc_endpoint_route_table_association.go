@@ -36,7 +36,7 @@ func resourceAwsVpcEndpointRouteTableAssociation() *schema.Resource {
 }
 
 func resourceAwsVPCEndpointRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	rtId := d.Get("route_table_id").(string)
 
@@ -67,7 +67,7 @@ func resourceAwsVPCEndpointRouteTableAssociationCreate(d *schema.ResourceData, m
 }
 
 func resourceAwsVPCEndpointRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	rtId := d.Get("route_table_id").(string)
 
@@ -102,7 +102,7 @@ func resourceAwsVPCEndpointRouteTableAssociationRead(d *schema.ResourceData, met
 }
 
 func resourceAwsVPCEndpointRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	rtId := d.Get("route_table_id").(string)
 
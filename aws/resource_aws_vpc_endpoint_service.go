@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointServiceCreate,
+		Read:   resourceAwsVpcEndpointServiceRead,
+		Update: resourceAwsVpcEndpointServiceUpdate,
+		Delete: resourceAwsVpcEndpointServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceptance_required": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"network_load_balancer_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"allowed_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_endpoint_dns_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	input := &ec2.CreateVpcEndpointServiceConfigurationInput{
+		AcceptanceRequired:      aws.Bool(d.Get("acceptance_required").(bool)),
+		NetworkLoadBalancerArns: expandStringSet(d.Get("network_load_balancer_arns").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Creating VPC Endpoint Service configuration: %#v", input)
+	resp, err := conn.CreateVpcEndpointServiceConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC Endpoint Service configuration: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.ServiceConfiguration.ServiceId))
+
+	if err := vpcEndpointServiceWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("allowed_principals"); ok && v.(*schema.Set).Len() > 0 {
+		_, err := conn.ModifyVpcEndpointServicePermissions(&ec2.ModifyVpcEndpointServicePermissionsInput{
+			ServiceId:            aws.String(d.Id()),
+			AddAllowedPrincipals: expandStringSet(v.(*schema.Set)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding VPC Endpoint Service allowed principals: %s", err)
+		}
+	}
+
+	return resourceAwsVpcEndpointServiceRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	svcCfgRaw, state, err := vpcEndpointServiceStateRefresh(conn, d.Id())()
+	if err != nil {
+		return err
+	}
+	if state == ec2.ServiceStateDeleted {
+		log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	svcCfg := svcCfgRaw.(*ec2.ServiceConfiguration)
+	d.Set("acceptance_required", svcCfg.AcceptanceRequired)
+	if err := d.Set("network_load_balancer_arns", aws.StringValueSlice(svcCfg.NetworkLoadBalancerArns)); err != nil {
+		return fmt.Errorf("error setting network_load_balancer_arns: %s", err)
+	}
+	d.Set("state", svcCfg.ServiceState)
+	d.Set("service_name", svcCfg.ServiceName)
+	if len(svcCfg.ServiceType) > 0 {
+		d.Set("service_type", svcCfg.ServiceType[0].ServiceType)
+	}
+	if err := d.Set("availability_zones", aws.StringValueSlice(svcCfg.AvailabilityZones)); err != nil {
+		return fmt.Errorf("error setting availability_zones: %s", err)
+	}
+	d.Set("private_dns_name", svcCfg.PrivateDnsName)
+	if err := d.Set("base_endpoint_dns_names", aws.StringValueSlice(svcCfg.BaseEndpointDnsNames)); err != nil {
+		return fmt.Errorf("error setting base_endpoint_dns_names: %s", err)
+	}
+
+	principals, err := vpcEndpointServiceAllowedPrincipals(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Endpoint Service permissions %s: %s", d.Id(), err)
+	}
+	if err := d.Set("allowed_principals", principals); err != nil {
+		return fmt.Errorf("error setting allowed_principals: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcEndpointServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	if d.HasChange("acceptance_required") || d.HasChange("network_load_balancer_arns") {
+		input := &ec2.ModifyVpcEndpointServiceConfigurationInput{
+			ServiceId:          aws.String(d.Id()),
+			AcceptanceRequired: aws.Bool(d.Get("acceptance_required").(bool)),
+		}
+		input.AddNetworkLoadBalancerArns, input.RemoveNetworkLoadBalancerArns = vpcEndpointSetChanges(d, "network_load_balancer_arns")
+
+		log.Printf("[DEBUG] Updating VPC Endpoint Service configuration: %#v", input)
+		if _, err := conn.ModifyVpcEndpointServiceConfiguration(input); err != nil {
+			return fmt.Errorf("Error updating VPC Endpoint Service configuration: %s", err)
+		}
+
+		if err := vpcEndpointServiceWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("allowed_principals") {
+		input := &ec2.ModifyVpcEndpointServicePermissionsInput{
+			ServiceId: aws.String(d.Id()),
+		}
+		input.AddAllowedPrincipals, input.RemoveAllowedPrincipals = vpcEndpointSetChanges(d, "allowed_principals")
+
+		log.Printf("[DEBUG] Updating VPC Endpoint Service permissions: %#v", input)
+		if _, err := conn.ModifyVpcEndpointServicePermissions(input); err != nil {
+			return fmt.Errorf("Error updating VPC Endpoint Service permissions: %s", err)
+		}
+	}
+
+	return resourceAwsVpcEndpointServiceRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	log.Printf("[DEBUG] Deleting VPC Endpoint Service: %s", d.Id())
+	resp, err := conn.DeleteVpcEndpointServiceConfigurations(&ec2.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC Endpoint Service: %s", err)
+	}
+	if err := unsuccessfulItemsError(resp.Unsuccessful); err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC Endpoint Service: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
+		Target:     []string{ec2.ServiceStateDeleted},
+		Refresh:    vpcEndpointServiceStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint Service %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func vpcEndpointServiceWaitUntilAvailable(conn *ec2.EC2, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.ServiceStatePending},
+		Target:     []string{ec2.ServiceStateAvailable},
+		Refresh:    vpcEndpointServiceStateRefresh(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint Service %s to become available: %s", id, err)
+	}
+
+	return nil
+}
+
+func vpcEndpointServiceStateRefresh(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Reading VPC Endpoint Service configuration: %s", id)
+		resp, err := conn.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{
+			ServiceIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+				return false, ec2.ServiceStateDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error reading VPC Endpoint Service configuration %s: %s", id, err)
+		}
+
+		if len(resp.ServiceConfigurations) == 0 {
+			return false, ec2.ServiceStateDeleted, nil
+		}
+
+		svcCfg := resp.ServiceConfigurations[0]
+		state := aws.StringValue(svcCfg.ServiceState)
+		if state == ec2.ServiceStateFailed {
+			return nil, state, fmt.Errorf("VPC Endpoint Service %s is in a failed state", id)
+		}
+		return svcCfg, state, nil
+	}
+}
+
+// vpcEndpointServiceAllowedPrincipals returns the ARNs of the principals
+// allowed to connect to a VPC endpoint service.
+func vpcEndpointServiceAllowedPrincipals(conn *ec2.EC2, id string) ([]string, error) {
+	var principals []string
+
+	input := &ec2.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(id),
+	}
+	for {
+		resp, err := conn.DescribeVpcEndpointServicePermissions(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range resp.AllowedPrincipals {
+			principals = append(principals, aws.StringValue(p.Principal))
+		}
+
+		if resp.NextToken == nil {
+			return principals, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+// unsuccessfulItemsError returns an error of the first item an EC2 batch
+// operation failed on, or nil if none did.
+func unsuccessfulItemsError(items []*ec2.UnsuccessfulItem) error {
+	for _, item := range items {
+		if item.Error != nil {
+			return awserr.New(aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message), nil)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointServiceAllowedPrincipal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointServiceAllowedPrincipalCreate,
+		Read:   resourceAwsVpcEndpointServiceAllowedPrincipalRead,
+		Delete: resourceAwsVpcEndpointServiceAllowedPrincipalDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	arn := d.Get("principal_arn").(string)
+
+	log.Printf("[DEBUG] Adding %s to the allowed principals of VPC Endpoint Service %s", arn, svcId)
+	_, err := conn.ModifyVpcEndpointServicePermissions(&ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:            aws.String(svcId),
+		AddAllowedPrincipals: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding VPC Endpoint Service allowed principal: %s", err)
+	}
+
+	d.SetId(vpcEndpointServiceAllowedPrincipalId(svcId, arn))
+
+	return resourceAwsVpcEndpointServiceAllowedPrincipalRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	arn := d.Get("principal_arn").(string)
+
+	principals, err := vpcEndpointServiceAllowedPrincipals(conn, svcId)
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing allowed principal %s from state", svcId, arn)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading VPC Endpoint Service permissions %s: %s", svcId, err)
+	}
+
+	for _, principal := range principals {
+		if principal == arn {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Principal %s is not allowed by VPC Endpoint Service %s, removing from state", arn, svcId)
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsVpcEndpointServiceAllowedPrincipalDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).EC2()
+
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	arn := d.Get("principal_arn").(string)
+
+	log.Printf("[DEBUG] Removing %s from the allowed principals of VPC Endpoint Service %s", arn, svcId)
+	_, err := conn.ModifyVpcEndpointServicePermissions(&ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:               aws.String(svcId),
+		RemoveAllowedPrincipals: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing VPC Endpoint Service allowed principal: %s", err)
+	}
+
+	return nil
+}
+
+func vpcEndpointServiceAllowedPrincipalId(svcId, arn string) string {
+	return fmt.Sprintf("a-%s%d", svcId, hashcode.String(arn))
+}
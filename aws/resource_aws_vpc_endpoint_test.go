@@ -87,7 +87,7 @@ func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 
 	// reach out and DELETE the VPC Endpoint outside of Terraform
 	testDestroy := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		input := &ec2.DeleteVpcEndpointsInput{
 			VpcEndpointIds: []*string{endpoint.VpcEndpointId},
 		}
@@ -116,8 +116,65 @@ func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpoint_interfaceBasic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.ec2",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithoutSubnet,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "0"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "false"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpoint_interfaceWithSubnet(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.ec2",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithSubnet,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithSubnetModified,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "network_interface_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).EC2()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint" {
@@ -136,7 +193,7 @@ func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
 			}
 			return err
 		}
-		if len(resp.VpcEndpoints) > 0 {
+		if len(resp.VpcEndpoints) > 0 && aws.StringValue(resp.VpcEndpoints[0].State) != "deleted" {
 			return fmt.Errorf("VPC Endpoints still exist.")
 		}
 
@@ -157,7 +214,7 @@ func testAccCheckVpcEndpointExists(n string, endpoint *ec2.VpcEndpoint) resource
 			return fmt.Errorf("No VPC Endpoint ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).EC2()
 		input := &ec2.DescribeVpcEndpointsInput{
 			VpcEndpointIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -305,3 +362,80 @@ resource "aws_vpc_endpoint" "second-private-s3" {
     service_name = "com.amazonaws.us-west-2.s3"
 }
 `
+
+const testAccVpcEndpointConfig_interfaceWithoutSubnet = `
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-interface-wo-subnet"
+  }
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id             = "${aws_vpc.foo.id}"
+  vpc_endpoint_type  = "Interface"
+  service_name       = "com.amazonaws.us-west-2.ec2"
+  security_group_ids = ["${aws_security_group.sg1.id}"]
+}
+`
+
+const testAccVpcEndpointConfig_interfaceWithSubnetBase = `
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-interface-w-subnet"
+  }
+}
+
+resource "aws_subnet" "sn1" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.0.0/17"
+  availability_zone = "us-west-2a"
+}
+
+resource "aws_subnet" "sn2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.128.0/17"
+  availability_zone = "us-west-2b"
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+`
+
+const testAccVpcEndpointConfig_interfaceWithSubnet = testAccVpcEndpointConfig_interfaceWithSubnetBase + `
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id              = "${aws_vpc.foo.id}"
+  vpc_endpoint_type   = "Interface"
+  service_name        = "com.amazonaws.us-west-2.ec2"
+  subnet_ids          = ["${aws_subnet.sn1.id}"]
+  security_group_ids  = ["${aws_security_group.sg1.id}"]
+  private_dns_enabled = false
+}
+`
+
+const testAccVpcEndpointConfig_interfaceWithSubnetModified = testAccVpcEndpointConfig_interfaceWithSubnetBase + `
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id              = "${aws_vpc.foo.id}"
+  vpc_endpoint_type   = "Interface"
+  service_name        = "com.amazonaws.us-west-2.ec2"
+  subnet_ids          = ["${aws_subnet.sn1.id}", "${aws_subnet.sn2.id}"]
+  security_group_ids  = ["${aws_security_group.sg1.id}", "${aws_security_group.sg2.id}"]
+  private_dns_enabled = true
+}
+`
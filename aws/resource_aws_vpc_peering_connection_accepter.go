@@ -15,6 +15,8 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 		Update: resourceAwsVPCPeeringUpdate,
 		Delete: resourceAwsVPCPeeringAccepterDelete,
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_peering_connection_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -49,6 +51,7 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
+			"tags_all":  tagsSchemaAll(),
 		},
 	}
 }
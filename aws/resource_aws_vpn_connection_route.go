@@ -39,7 +39,7 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 }
 
 func resourceAwsVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -79,7 +79,7 @@ func resourceAwsVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsVpnConnectionRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	cidrBlock, vpnConnectionId := resourceAwsVpnConnectionRouteParseId(d.Id())
 
@@ -96,7 +96,7 @@ func resourceAwsVpnConnectionRouteRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsVpnConnectionRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -31,7 +31,7 @@ func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
 }
 
 func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	gwID := d.Get("vpn_gateway_id").(string)
 	rtID := d.Get("route_table_id").(string)
@@ -50,7 +50,7 @@ func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta in
 }
 
 func resourceAwsVpnGatewayRoutePropagationDisable(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	gwID := d.Get("vpn_gateway_id").(string)
 	rtID := d.Get("route_table_id").(string)
@@ -69,7 +69,7 @@ func resourceAwsVpnGatewayRoutePropagationDisable(d *schema.ResourceData, meta i
 }
 
 func resourceAwsVpnGatewayRoutePropagationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).EC2()
 
 	gwID := d.Get("vpn_gateway_id").(string)
 	rtID := d.Get("route_table_id").(string)
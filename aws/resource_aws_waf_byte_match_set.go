@@ -66,7 +66,7 @@ func resourceAwsWafByteMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafByteMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	log.Printf("[INFO] Creating ByteMatchSet: %s", d.Get("name").(string))
 
@@ -89,7 +89,7 @@ func resourceAwsWafByteMatchSetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 	log.Printf("[INFO] Reading ByteMatchSet: %s", d.Get("name").(string))
 	params := &waf.GetByteMatchSetInput{
 		ByteMatchSetId: aws.String(d.Id()),
@@ -113,7 +113,7 @@ func resourceAwsWafByteMatchSetRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsWafByteMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	log.Printf("[INFO] Updating ByteMatchSet: %s", d.Get("name").(string))
 
@@ -130,7 +130,7 @@ func resourceAwsWafByteMatchSetUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafByteMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldTuples := d.Get("byte_match_tuples").(*schema.Set).List()
 	if len(oldTuples) > 0 {
@@ -174,58 +174,3 @@ func updateByteMatchSetResource(id string, oldT, newT []interface{}, conn *waf.W
 
 	return nil
 }
-
-func flattenWafByteMatchTuples(bmt []*waf.ByteMatchTuple) []interface{} {
-	out := make([]interface{}, len(bmt), len(bmt))
-	for i, t := range bmt {
-		m := make(map[string]interface{})
-
-		if t.FieldToMatch != nil {
-			m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
-		}
-		m["positional_constraint"] = *t.PositionalConstraint
-		m["target_string"] = string(t.TargetString)
-		m["text_transformation"] = *t.TextTransformation
-
-		out[i] = m
-	}
-	return out
-}
-
-func diffWafByteMatchSetTuples(oldT, newT []interface{}) []*waf.ByteMatchSetUpdate {
-	updates := make([]*waf.ByteMatchSetUpdate, 0)
-
-	for _, ot := range oldT {
-		tuple := ot.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.ByteMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			ByteMatchTuple: &waf.ByteMatchTuple{
-				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
-				TargetString:         []byte(tuple["target_string"].(string)),
-				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nt := range newT {
-		tuple := nt.(map[string]interface{})
-
-		updates = append(updates, &waf.ByteMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			ByteMatchTuple: &waf.ByteMatchTuple{
-				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
-				TargetString:         []byte(tuple["target_string"].(string)),
-				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
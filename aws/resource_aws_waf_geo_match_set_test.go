@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafGeoMatchSet_basic(t *testing.T) {
+	var v waf.GeoMatchSet
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
+	resourceName := "aws_waf_geo_match_set.geo_match_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafGeoMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafGeoMatchSetConfig(geoMatchSet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", geoMatchSet),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSWafGeoMatchSetConfig_changeConstraints(geoMatchSet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSWafGeoMatchSet_noConstraints(t *testing.T) {
+	var v waf.GeoMatchSet
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
+	resourceName := "aws_waf_geo_match_set.geo_match_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafGeoMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafGeoMatchSetConfig_noConstraints(geoMatchSet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", geoMatchSet),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafGeoMatchSetExists(n string, v *waf.GeoMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF GeoMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
+		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.GeoMatchSet.GeoMatchSetId == rs.Primary.ID {
+			*v = *resp.GeoMatchSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF GeoMatchSet (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafGeoMatchSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_waf_geo_match_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
+		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.GeoMatchSet.GeoMatchSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF GeoMatchSet %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, "WAFNonexistentItemException", "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafGeoMatchSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_geo_match_set" "geo_match_set" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "US"
+  }
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "CA"
+  }
+}
+`, name)
+}
+
+func testAccAWSWafGeoMatchSetConfig_changeConstraints(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_geo_match_set" "geo_match_set" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "RU"
+  }
+}
+`, name)
+}
+
+func testAccAWSWafGeoMatchSetConfig_noConstraints(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_geo_match_set" "geo_match_set" {
+  name = "%s"
+}
+`, name)
+}
@@ -44,7 +44,7 @@ func resourceAwsWafIPSet() *schema.Resource {
 }
 
 func resourceAwsWafIPSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	wr := newWafRetryer(conn, "global")
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -63,7 +63,7 @@ func resourceAwsWafIPSetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafIPSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	params := &waf.GetIPSetInput{
 		IPSetId: aws.String(d.Id()),
@@ -80,17 +80,7 @@ func resourceAwsWafIPSetRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	var descriptors []map[string]interface{}
-
-	for _, descriptor := range resp.IPSet.IPSetDescriptors {
-		d := map[string]interface{}{
-			"type":  *descriptor.Type,
-			"value": *descriptor.Value,
-		}
-		descriptors = append(descriptors, d)
-	}
-
-	d.Set("ip_set_descriptors", descriptors)
+	d.Set("ip_set_descriptors", flattenWafIpSetDescriptors(resp.IPSet.IPSetDescriptors))
 
 	d.Set("name", resp.IPSet.Name)
 
@@ -98,7 +88,7 @@ func resourceAwsWafIPSetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	if d.HasChange("ip_set_descriptors") {
 		o, n := d.GetChange("ip_set_descriptors")
@@ -114,7 +104,7 @@ func resourceAwsWafIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafIPSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldDescriptors := d.Get("ip_set_descriptors").(*schema.Set).List()
 
@@ -159,37 +149,3 @@ func updateWafIpSetDescriptors(id string, oldD, newD []interface{}, conn *waf.WA
 
 	return nil
 }
-
-func diffWafIpSetDescriptors(oldD, newD []interface{}) []*waf.IPSetUpdate {
-	updates := make([]*waf.IPSetUpdate, 0)
-
-	for _, od := range oldD {
-		descriptor := od.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newD, descriptor); contains {
-			newD = append(newD[:idx], newD[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.IPSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			IPSetDescriptor: &waf.IPSetDescriptor{
-				Type:  aws.String(descriptor["type"].(string)),
-				Value: aws.String(descriptor["value"].(string)),
-			},
-		})
-	}
-
-	for _, nd := range newD {
-		descriptor := nd.(map[string]interface{})
-
-		updates = append(updates, &waf.IPSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			IPSetDescriptor: &waf.IPSetDescriptor{
-				Type:  aws.String(descriptor["type"].(string)),
-				Value: aws.String(descriptor["value"].(string)),
-			},
-		})
-	}
-	return updates
-}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsWafRateBasedRule() *schema.Resource {
@@ -53,14 +54,15 @@ func resourceAwsWafRateBasedRule() *schema.Resource {
 						"type": &schema.Schema{
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								if value != "IPMatch" && value != "ByteMatch" && value != "SqlInjectionMatch" && value != "SizeConstraint" && value != "XssMatch" {
-									errors = append(errors, fmt.Errorf(
-										"%q must be one of IPMatch | ByteMatch | SqlInjectionMatch | SizeConstraint | XssMatch", k))
-								}
-								return
-							},
+							ValidateFunc: validation.StringInSlice([]string{
+								waf.PredicateTypeByteMatch,
+								waf.PredicateTypeGeoMatch,
+								waf.PredicateTypeIpmatch,
+								waf.PredicateTypeRegexMatch,
+								waf.PredicateTypeSizeConstraint,
+								waf.PredicateTypeSqlInjectionMatch,
+								waf.PredicateTypeXssMatch,
+							}, false),
 						},
 					},
 				},
@@ -85,7 +87,7 @@ func resourceAwsWafRateBasedRule() *schema.Resource {
 }
 
 func resourceAwsWafRateBasedRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	wr := newWafRetryer(conn, "global")
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -108,7 +110,7 @@ func resourceAwsWafRateBasedRuleCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRateBasedRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	params := &waf.GetRateBasedRuleInput{
 		RuleId: aws.String(d.Id()),
@@ -125,18 +127,7 @@ func resourceAwsWafRateBasedRuleRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	var predicates []map[string]interface{}
-
-	for _, predicateSet := range resp.Rule.MatchPredicates {
-		predicate := map[string]interface{}{
-			"negated": *predicateSet.Negated,
-			"type":    *predicateSet.Type,
-			"data_id": *predicateSet.DataId,
-		}
-		predicates = append(predicates, predicate)
-	}
-
-	d.Set("predicates", predicates)
+	d.Set("predicates", flattenWafPredicates(resp.Rule.MatchPredicates))
 	d.Set("name", resp.Rule.Name)
 	d.Set("metric_name", resp.Rule.MetricName)
 	d.Set("rate_key", resp.Rule.RateKey)
@@ -146,7 +137,7 @@ func resourceAwsWafRateBasedRuleRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsWafRateBasedRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	if d.HasChange("predicates") {
 		o, n := d.GetChange("predicates")
@@ -163,7 +154,7 @@ func resourceAwsWafRateBasedRuleUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRateBasedRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldPredicates := d.Get("predicates").(*schema.Set).List()
 	if len(oldPredicates) > 0 {
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegexPatternSet_basic(t *testing.T) {
+	var v waf.RegexPatternSet
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	resourceName := "aws_waf_regex_pattern_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegexPatternSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegexPatternSetConfig(patternSetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", patternSetName),
+					resource.TestCheckResourceAttr(resourceName, "regex_pattern_strings.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegexPatternSetConfig_changePatterns(patternSetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex_pattern_strings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestDiffWafRegexPatternSetPatternStrings(t *testing.T) {
+	testCases := []struct {
+		Old             []interface{}
+		New             []interface{}
+		ExpectedUpdates []*waf.RegexPatternSetUpdate
+	}{
+		{
+			// Unchanged
+			Old:             []interface{}{"one", "two"},
+			New:             []interface{}{"two", "one"},
+			ExpectedUpdates: []*waf.RegexPatternSetUpdate{},
+		},
+		{
+			// Replacement
+			Old: []interface{}{"one", "two"},
+			New: []interface{}{"one", "three"},
+			ExpectedUpdates: []*waf.RegexPatternSetUpdate{
+				{
+					Action:             aws.String(waf.ChangeActionDelete),
+					RegexPatternString: aws.String("two"),
+				},
+				{
+					Action:             aws.String(waf.ChangeActionInsert),
+					RegexPatternString: aws.String("three"),
+				},
+			},
+		},
+		{
+			// Removal of all patterns
+			Old: []interface{}{"one"},
+			New: []interface{}{},
+			ExpectedUpdates: []*waf.RegexPatternSetUpdate{
+				{
+					Action:             aws.String(waf.ChangeActionDelete),
+					RegexPatternString: aws.String("one"),
+				},
+			},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			updates := diffWafRegexPatternSetPatternStrings(tc.Old, tc.New)
+			if !reflect.DeepEqual(updates, tc.ExpectedUpdates) {
+				t.Fatalf("Regex pattern set updates don't match.\nGiven: %s\nExpected: %s",
+					updates, tc.ExpectedUpdates)
+			}
+		})
+	}
+}
+
+func testAccCheckAWSWafRegexPatternSetExists(n string, v *waf.RegexPatternSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regex Pattern Set ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
+		resp, err := conn.GetRegexPatternSet(&waf.GetRegexPatternSetInput{
+			RegexPatternSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.RegexPatternSet.RegexPatternSetId == rs.Primary.ID {
+			*v = *resp.RegexPatternSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regex Pattern Set (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegexPatternSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_waf_regex_pattern_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
+		resp, err := conn.GetRegexPatternSet(&waf.GetRegexPatternSetInput{
+			RegexPatternSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.RegexPatternSet.RegexPatternSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regex Pattern Set %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, "WAFNonexistentItemException", "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegexPatternSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["one", "two"]
+}
+`, name)
+}
+
+func testAccAWSWafRegexPatternSetConfig_changePatterns(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["three"]
+}
+`, name)
+}
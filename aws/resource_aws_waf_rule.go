@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsWafRule() *schema.Resource {
@@ -53,14 +54,15 @@ func resourceAwsWafRule() *schema.Resource {
 						"type": &schema.Schema{
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								if value != "IPMatch" && value != "ByteMatch" && value != "SqlInjectionMatch" && value != "SizeConstraint" && value != "XssMatch" {
-									errors = append(errors, fmt.Errorf(
-										"%q must be one of IPMatch | ByteMatch | SqlInjectionMatch | SizeConstraint | XssMatch", k))
-								}
-								return
-							},
+							ValidateFunc: validation.StringInSlice([]string{
+								waf.PredicateTypeByteMatch,
+								waf.PredicateTypeGeoMatch,
+								waf.PredicateTypeIpmatch,
+								waf.PredicateTypeRegexMatch,
+								waf.PredicateTypeSizeConstraint,
+								waf.PredicateTypeSqlInjectionMatch,
+								waf.PredicateTypeXssMatch,
+							}, false),
 						},
 					},
 				},
@@ -70,7 +72,7 @@ func resourceAwsWafRule() *schema.Resource {
 }
 
 func resourceAwsWafRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	wr := newWafRetryer(conn, "global")
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -91,7 +93,7 @@ func resourceAwsWafRuleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	params := &waf.GetRuleInput{
 		RuleId: aws.String(d.Id()),
@@ -108,18 +110,7 @@ func resourceAwsWafRuleRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	var predicates []map[string]interface{}
-
-	for _, predicateSet := range resp.Rule.Predicates {
-		predicate := map[string]interface{}{
-			"negated": *predicateSet.Negated,
-			"type":    *predicateSet.Type,
-			"data_id": *predicateSet.DataId,
-		}
-		predicates = append(predicates, predicate)
-	}
-
-	d.Set("predicates", predicates)
+	d.Set("predicates", flattenWafPredicates(resp.Rule.Predicates))
 	d.Set("name", resp.Rule.Name)
 	d.Set("metric_name", resp.Rule.MetricName)
 
@@ -127,7 +118,7 @@ func resourceAwsWafRuleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	if d.HasChange("predicates") {
 		o, n := d.GetChange("predicates")
@@ -143,7 +134,7 @@ func resourceAwsWafRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldPredicates := d.Get("predicates").(*schema.Set).List()
 	if len(oldPredicates) > 0 {
@@ -187,39 +178,3 @@ func updateWafRuleResource(id string, oldP, newP []interface{}, conn *waf.WAF) e
 
 	return nil
 }
-
-func diffWafRulePredicates(oldP, newP []interface{}) []*waf.RuleUpdate {
-	updates := make([]*waf.RuleUpdate, 0)
-
-	for _, op := range oldP {
-		predicate := op.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newP, predicate); contains {
-			newP = append(newP[:idx], newP[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.RuleUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			Predicate: &waf.Predicate{
-				Negated: aws.Bool(predicate["negated"].(bool)),
-				Type:    aws.String(predicate["type"].(string)),
-				DataId:  aws.String(predicate["data_id"].(string)),
-			},
-		})
-	}
-
-	for _, np := range newP {
-		predicate := np.(map[string]interface{})
-
-		updates = append(updates, &waf.RuleUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			Predicate: &waf.Predicate{
-				Negated: aws.Bool(predicate["negated"].(bool)),
-				Type:    aws.String(predicate["type"].(string)),
-				DataId:  aws.String(predicate["data_id"].(string)),
-			},
-		})
-	}
-	return updates
-}
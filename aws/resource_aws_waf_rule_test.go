@@ -204,9 +204,32 @@ func TestAccAWSWafRule_noPredicates(t *testing.T) {
 	})
 }
 
+func TestAccAWSWafRule_geoMatchSetPredicate(t *testing.T) {
+	var rule waf.Rule
+	ruleName := fmt.Sprintf("wafrule%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRuleConfig_geoMatchSetPredicate(ruleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRuleExists("aws_waf_rule.wafrule", &rule),
+					resource.TestCheckResourceAttr(
+						"aws_waf_rule.wafrule", "name", ruleName),
+					resource.TestCheckResourceAttr(
+						"aws_waf_rule.wafrule", "predicates.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSWafRuleDisappears(v *waf.Rule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
 
 		wr := newWafRetryer(conn, "global")
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -253,7 +276,7 @@ func testAccCheckAWSWafRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
 		resp, err := conn.GetRule(
 			&waf.GetRuleInput{
 				RuleId: aws.String(rs.Primary.ID),
@@ -289,7 +312,7 @@ func testAccCheckAWSWafRuleExists(n string, v *waf.Rule) resource.TestCheckFunc
 			return fmt.Errorf("No WAF Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).WAF()
 		resp, err := conn.GetRule(&waf.GetRuleInput{
 			RuleId: aws.String(rs.Primary.ID),
 		})
@@ -393,3 +416,27 @@ resource "aws_waf_rule" "wafrule" {
   metric_name = "%s"
 }`, name, name)
 }
+
+func testAccAWSWafRuleConfig_geoMatchSetPredicate(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_geo_match_set" "geo_match_set" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "US"
+  }
+}
+
+resource "aws_waf_rule" "wafrule" {
+  name        = "%s"
+  metric_name = "%s"
+
+  predicates {
+    data_id = "${aws_waf_geo_match_set.geo_match_set.id}"
+    negated = true
+    type    = "GeoMatch"
+  }
+}
+`, name, name, name)
+}
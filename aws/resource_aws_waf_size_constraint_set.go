@@ -65,7 +65,7 @@ func resourceAwsWafSizeConstraintSet() *schema.Resource {
 }
 
 func resourceAwsWafSizeConstraintSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	log.Printf("[INFO] Creating SizeConstraintSet: %s", d.Get("name").(string))
 
@@ -89,7 +89,7 @@ func resourceAwsWafSizeConstraintSetCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsWafSizeConstraintSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 	log.Printf("[INFO] Reading SizeConstraintSet: %s", d.Get("name").(string))
 	params := &waf.GetSizeConstraintSetInput{
 		SizeConstraintSetId: aws.String(d.Id()),
@@ -113,7 +113,7 @@ func resourceAwsWafSizeConstraintSetRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsWafSizeConstraintSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	if d.HasChange("size_constraints") {
 		o, n := d.GetChange("size_constraints")
@@ -129,7 +129,7 @@ func resourceAwsWafSizeConstraintSetUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsWafSizeConstraintSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldConstraints := d.Get("size_constraints").(*schema.Set).List()
 
@@ -174,56 +174,3 @@ func updateSizeConstraintSetResource(id string, oldS, newS []interface{}, conn *
 
 	return nil
 }
-
-func flattenWafSizeConstraints(sc []*waf.SizeConstraint) []interface{} {
-	out := make([]interface{}, len(sc), len(sc))
-	for i, c := range sc {
-		m := make(map[string]interface{})
-		m["comparison_operator"] = *c.ComparisonOperator
-		if c.FieldToMatch != nil {
-			m["field_to_match"] = flattenFieldToMatch(c.FieldToMatch)
-		}
-		m["size"] = *c.Size
-		m["text_transformation"] = *c.TextTransformation
-		out[i] = m
-	}
-	return out
-}
-
-func diffWafSizeConstraints(oldS, newS []interface{}) []*waf.SizeConstraintSetUpdate {
-	updates := make([]*waf.SizeConstraintSetUpdate, 0)
-
-	for _, os := range oldS {
-		constraint := os.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newS, constraint); contains {
-			newS = append(newS[:idx], newS[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.SizeConstraintSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			SizeConstraint: &waf.SizeConstraint{
-				FieldToMatch:       expandFieldToMatch(constraint["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				ComparisonOperator: aws.String(constraint["comparison_operator"].(string)),
-				Size:               aws.Int64(int64(constraint["size"].(int))),
-				TextTransformation: aws.String(constraint["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, ns := range newS {
-		constraint := ns.(map[string]interface{})
-
-		updates = append(updates, &waf.SizeConstraintSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			SizeConstraint: &waf.SizeConstraint{
-				FieldToMatch:       expandFieldToMatch(constraint["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				ComparisonOperator: aws.String(constraint["comparison_operator"].(string)),
-				Size:               aws.Int64(int64(constraint["size"].(int))),
-				TextTransformation: aws.String(constraint["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
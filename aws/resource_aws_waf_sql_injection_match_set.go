@@ -57,7 +57,7 @@ func resourceAwsWafSqlInjectionMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafSqlInjectionMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	log.Printf("[INFO] Creating SqlInjectionMatchSet: %s", d.Get("name").(string))
 
@@ -80,7 +80,7 @@ func resourceAwsWafSqlInjectionMatchSetCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsWafSqlInjectionMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 	log.Printf("[INFO] Reading SqlInjectionMatchSet: %s", d.Get("name").(string))
 	params := &waf.GetSqlInjectionMatchSetInput{
 		SqlInjectionMatchSetId: aws.String(d.Id()),
@@ -104,7 +104,7 @@ func resourceAwsWafSqlInjectionMatchSetRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsWafSqlInjectionMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	if d.HasChange("sql_injection_match_tuples") {
 		o, n := d.GetChange("sql_injection_match_tuples")
@@ -120,7 +120,7 @@ func resourceAwsWafSqlInjectionMatchSetUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsWafSqlInjectionMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldTuples := d.Get("sql_injection_match_tuples").(*schema.Set).List()
 
@@ -166,49 +166,3 @@ func updateSqlInjectionMatchSetResource(id string, oldT, newT []interface{}, con
 
 	return nil
 }
-
-func flattenWafSqlInjectionMatchTuples(ts []*waf.SqlInjectionMatchTuple) []interface{} {
-	out := make([]interface{}, len(ts), len(ts))
-	for i, t := range ts {
-		m := make(map[string]interface{})
-		m["text_transformation"] = *t.TextTransformation
-		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
-		out[i] = m
-	}
-
-	return out
-}
-
-func diffWafSqlInjectionMatchTuples(oldT, newT []interface{}) []*waf.SqlInjectionMatchSetUpdate {
-	updates := make([]*waf.SqlInjectionMatchSetUpdate, 0)
-
-	for _, od := range oldT {
-		tuple := od.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.SqlInjectionMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			SqlInjectionMatchTuple: &waf.SqlInjectionMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nd := range newT {
-		tuple := nd.(map[string]interface{})
-
-		updates = append(updates, &waf.SqlInjectionMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			SqlInjectionMatchTuple: &waf.SqlInjectionMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
@@ -89,7 +89,7 @@ func resourceAwsWafWebAcl() *schema.Resource {
 }
 
 func resourceAwsWafWebAclCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	wr := newWafRetryer(conn, "global")
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -111,7 +111,7 @@ func resourceAwsWafWebAclCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 	params := &waf.GetWebACLInput{
 		WebACLId: aws.String(d.Id()),
 	}
@@ -148,7 +148,7 @@ func resourceAwsWafWebAclUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 	err := updateWebAclResource(d, meta, waf.ChangeActionDelete)
 	if err != nil {
 		return fmt.Errorf("Error Removing WAF ACL Rules: %s", err)
@@ -171,7 +171,7 @@ func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func updateWebAclResource(d *schema.ResourceData, meta interface{}, ChangeAction string) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	wr := newWafRetryer(conn, "global")
 	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -206,37 +206,3 @@ func updateWebAclResource(d *schema.ResourceData, meta interface{}, ChangeAction
 	}
 	return nil
 }
-
-func expandDefaultAction(d *schema.ResourceData) *waf.WafAction {
-	set, ok := d.GetOk("default_action")
-	if !ok {
-		return nil
-	}
-
-	s := set.(*schema.Set).List()
-	if s == nil || len(s) == 0 {
-		return nil
-	}
-
-	if s[0] == nil {
-		log.Printf("[ERR] First element of Default Action is set to nil")
-		return nil
-	}
-
-	dA := s[0].(map[string]interface{})
-
-	return &waf.WafAction{
-		Type: aws.String(dA["type"].(string)),
-	}
-}
-
-func flattenDefaultAction(n *waf.WafAction) []map[string]interface{} {
-	if n == nil {
-		return nil
-	}
-
-	m := setMap(make(map[string]interface{}))
-
-	m.SetString("type", n.Type)
-	return m.MapList()
-}
@@ -58,7 +58,7 @@ func resourceAwsWafXssMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafXssMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	log.Printf("[INFO] Creating XssMatchSet: %s", d.Get("name").(string))
 
@@ -82,7 +82,7 @@ func resourceAwsWafXssMatchSetCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsWafXssMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 	log.Printf("[INFO] Reading XssMatchSet: %s", d.Get("name").(string))
 	params := &waf.GetXssMatchSetInput{
 		XssMatchSetId: aws.String(d.Id()),
@@ -106,7 +106,7 @@ func resourceAwsWafXssMatchSetRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsWafXssMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	if d.HasChange("xss_match_tuples") {
 		o, n := d.GetChange("xss_match_tuples")
@@ -122,7 +122,7 @@ func resourceAwsWafXssMatchSetUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsWafXssMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).WAF()
 
 	oldTuples := d.Get("xss_match_tuples").(*schema.Set).List()
 	if len(oldTuples) > 0 {
@@ -167,48 +167,3 @@ func updateXssMatchSetResource(id string, oldT, newT []interface{}, conn *waf.WA
 
 	return nil
 }
-
-func flattenWafXssMatchTuples(ts []*waf.XssMatchTuple) []interface{} {
-	out := make([]interface{}, len(ts), len(ts))
-	for i, t := range ts {
-		m := make(map[string]interface{})
-		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
-		m["text_transformation"] = *t.TextTransformation
-		out[i] = m
-	}
-	return out
-}
-
-func diffWafXssMatchSetTuples(oldT, newT []interface{}) []*waf.XssMatchSetUpdate {
-	updates := make([]*waf.XssMatchSetUpdate, 0)
-
-	for _, od := range oldT {
-		tuple := od.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.XssMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			XssMatchTuple: &waf.XssMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nd := range newT {
-		tuple := nd.(map[string]interface{})
-
-		updates = append(updates, &waf.XssMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			XssMatchTuple: &waf.XssMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
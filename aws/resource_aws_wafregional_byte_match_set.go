@@ -66,7 +66,7 @@ func resourceAwsWafRegionalByteMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafRegionalByteMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Creating ByteMatchSet: %s", d.Get("name").(string))
@@ -91,7 +91,7 @@ func resourceAwsWafRegionalByteMatchSetCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsWafRegionalByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 
 	log.Printf("[INFO] Reading ByteMatchSet: %s", d.Get("name").(string))
 
@@ -110,44 +110,14 @@ func resourceAwsWafRegionalByteMatchSetRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	d.Set("byte_match_tuple", flattenWafByteMatchTuplesWR(resp.ByteMatchSet.ByteMatchTuples))
+	d.Set("byte_match_tuple", flattenWafByteMatchTuples(resp.ByteMatchSet.ByteMatchTuples))
 	d.Set("name", resp.ByteMatchSet.Name)
 
 	return nil
 }
 
-func flattenWafByteMatchTuplesWR(in []*waf.ByteMatchTuple) []interface{} {
-	tuples := make([]interface{}, len(in), len(in))
-
-	for i, tuple := range in {
-		field_to_match := tuple.FieldToMatch
-		m := map[string]interface{}{
-			"type": *field_to_match.Type,
-		}
-
-		if field_to_match.Data == nil {
-			m["data"] = ""
-		} else {
-			m["data"] = *field_to_match.Data
-		}
-
-		var ms []map[string]interface{}
-		ms = append(ms, m)
-
-		tuple := map[string]interface{}{
-			"field_to_match":        ms,
-			"positional_constraint": *tuple.PositionalConstraint,
-			"target_string":         tuple.TargetString,
-			"text_transformation":   *tuple.TextTransformation,
-		}
-		tuples[i] = tuple
-	}
-
-	return tuples
-}
-
 func resourceAwsWafRegionalByteMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 	region := meta.(*AWSClient).region
 	log.Printf("[INFO] Updating ByteMatchSet: %s", d.Get("name").(string))
 
@@ -164,7 +134,7 @@ func resourceAwsWafRegionalByteMatchSetUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsWafRegionalByteMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Deleting ByteMatchSet: %s", d.Get("name").(string))
@@ -201,7 +171,7 @@ func updateByteMatchSetResourceWR(d *schema.ResourceData, oldT, newT []interface
 		req := &waf.UpdateByteMatchSetInput{
 			ChangeToken:    token,
 			ByteMatchSetId: aws.String(d.Id()),
-			Updates:        diffByteMatchSetTuple(oldT, newT),
+			Updates:        diffWafByteMatchSetTuples(oldT, newT),
 		}
 
 		return conn.UpdateByteMatchSet(req)
@@ -212,41 +182,3 @@ func updateByteMatchSetResourceWR(d *schema.ResourceData, oldT, newT []interface
 
 	return nil
 }
-
-func diffByteMatchSetTuple(oldT, newT []interface{}) []*waf.ByteMatchSetUpdate {
-	updates := make([]*waf.ByteMatchSetUpdate, 0)
-
-	for _, ot := range oldT {
-		tuple := ot.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.ByteMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			ByteMatchTuple: &waf.ByteMatchTuple{
-				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
-				TargetString:         []byte(tuple["target_string"].(string)),
-				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nt := range newT {
-		tuple := nt.(map[string]interface{})
-
-		updates = append(updates, &waf.ByteMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			ByteMatchTuple: &waf.ByteMatchTuple{
-				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
-				TargetString:         []byte(tuple["target_string"].(string)),
-				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalGeoMatchSet_basic(t *testing.T) {
+	var v waf.GeoMatchSet
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_geo_match_set.geo_match_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalGeoMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalGeoMatchSetConfig(geoMatchSet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", geoMatchSet),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalGeoMatchSetConfig_changeConstraints(geoMatchSet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSWafRegionalGeoMatchSet_noConstraints(t *testing.T) {
+	var v waf.GeoMatchSet
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_geo_match_set.geo_match_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalGeoMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalGeoMatchSetConfig_noConstraints(geoMatchSet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", geoMatchSet),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalGeoMatchSetExists(n string, v *waf.GeoMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional GeoMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.GeoMatchSet.GeoMatchSetId == rs.Primary.ID {
+			*v = *resp.GeoMatchSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional GeoMatchSet (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegionalGeoMatchSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_geo_match_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.GeoMatchSet.GeoMatchSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional GeoMatchSet %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegionalGeoMatchSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_geo_match_set" "geo_match_set" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "US"
+  }
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "CA"
+  }
+}
+`, name)
+}
+
+func testAccAWSWafRegionalGeoMatchSetConfig_changeConstraints(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_geo_match_set" "geo_match_set" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "RU"
+  }
+}
+`, name)
+}
+
+func testAccAWSWafRegionalGeoMatchSetConfig_noConstraints(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_geo_match_set" "geo_match_set" {
+  name = "%s"
+}
+`, name)
+}
@@ -45,7 +45,7 @@ func resourceAwsWafRegionalIPSet() *schema.Resource {
 }
 
 func resourceAwsWafRegionalIPSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 	region := meta.(*AWSClient).region
 
 	wr := newWafRegionalRetryer(conn, region)
@@ -65,7 +65,7 @@ func resourceAwsWafRegionalIPSetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRegionalIPSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 
 	params := &waf.GetIPSetInput{
 		IPSetId: aws.String(d.Id()),
@@ -82,28 +82,14 @@ func resourceAwsWafRegionalIPSetRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	d.Set("ip_set_descriptor", flattenWafIpSetDescriptorWR(resp.IPSet.IPSetDescriptors))
+	d.Set("ip_set_descriptor", flattenWafIpSetDescriptors(resp.IPSet.IPSetDescriptors))
 	d.Set("name", resp.IPSet.Name)
 
 	return nil
 }
 
-func flattenWafIpSetDescriptorWR(in []*waf.IPSetDescriptor) []interface{} {
-	descriptors := make([]interface{}, len(in), len(in))
-
-	for i, descriptor := range in {
-		d := map[string]interface{}{
-			"type":  *descriptor.Type,
-			"value": *descriptor.Value,
-		}
-		descriptors[i] = d
-	}
-
-	return descriptors
-}
-
 func resourceAwsWafRegionalIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 	region := meta.(*AWSClient).region
 
 	if d.HasChange("ip_set_descriptor") {
@@ -119,7 +105,7 @@ func resourceAwsWafRegionalIPSetUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRegionalIPSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).WAFRegional()
 	region := meta.(*AWSClient).region
 
 	oldD := d.Get("ip_set_descriptor").(*schema.Set).List()
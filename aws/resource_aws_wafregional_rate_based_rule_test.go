@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalRateBasedRule_basic(t *testing.T) {
+	var v waf.RateBasedRule
+	wafRuleName := fmt.Sprintf("wafrule%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_rate_based_rule.wafrule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalRateBasedRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalRateBasedRuleConfig(wafRuleName, 2000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRateBasedRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", wafRuleName),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", wafRuleName),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rate_key", "IP"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit", "2000"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalRateBasedRuleConfig(wafRuleName, 4000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRateBasedRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit", "4000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalRateBasedRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_rate_based_rule" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.Rule.RuleId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional Rate Based Rule %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalRateBasedRuleExists(n string, v *waf.RateBasedRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Rate Based Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.Rule.RuleId == rs.Primary.ID {
+			*v = *resp.Rule
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional Rate Based Rule (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccAWSWafRegionalRateBasedRuleConfig(name string, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "ipset" {
+  name = "%s"
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_wafregional_rate_based_rule" "wafrule" {
+  name        = "%s"
+  metric_name = "%s"
+  rate_key    = "IP"
+  rate_limit  = %d
+
+  predicate {
+    data_id = "${aws_wafregional_ipset.ipset.id}"
+    negated = false
+    type    = "IPMatch"
+  }
+}
+`, name, name, name, rateLimit)
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalRegexMatchSet_basic(t *testing.T) {
+	var v waf.RegexMatchSet
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_regex_match_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalRegexMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalRegexMatchSetConfig(matchSetName, patternSetName, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRegexMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", matchSetName),
+					resource.TestCheckResourceAttr(resourceName, "regex_match_tuple.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalRegexMatchSetConfig(matchSetName, patternSetName, "LOWERCASE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRegexMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex_match_tuple.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalRegexMatchSetExists(n string, v *waf.RegexMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Regex Match Set ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
+			RegexMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.RegexMatchSet.RegexMatchSetId == rs.Primary.ID {
+			*v = *resp.RegexMatchSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional Regex Match Set (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegionalRegexMatchSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_regex_match_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
+			RegexMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.RegexMatchSet.RegexMatchSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional Regex Match Set %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegionalRegexMatchSetConfig(matchSetName, patternSetName, transformation string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_regex_match_set" "test" {
+  name = "%s"
+
+  regex_match_tuple {
+    field_to_match {
+      data = "User-Agent"
+      type = "HEADER"
+    }
+
+    regex_pattern_set_id = "${aws_wafregional_regex_pattern_set.test.id}"
+    text_transformation  = "%s"
+  }
+}
+
+resource "aws_wafregional_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["one", "two"]
+}
+`, matchSetName, transformation, patternSetName)
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalRegexPatternSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalRegexPatternSetCreate,
+		Read:   resourceAwsWafRegionalRegexPatternSetRead,
+		Update: resourceAwsWafRegionalRegexPatternSetUpdate,
+		Delete: resourceAwsWafRegionalRegexPatternSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"regex_pattern_strings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalRegexPatternSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional Regex Pattern Set: %s", d.Get("name").(string))
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateRegexPatternSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		return conn.CreateRegexPatternSet(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional Regex Pattern Set: %s", err)
+	}
+	resp := out.(*waf.CreateRegexPatternSetOutput)
+
+	d.SetId(*resp.RegexPatternSet.RegexPatternSetId)
+
+	return resourceAwsWafRegionalRegexPatternSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalRegexPatternSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	log.Printf("[INFO] Reading WAF Regional Regex Pattern Set: %s", d.Get("name").(string))
+	params := &waf.GetRegexPatternSetInput{
+		RegexPatternSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetRegexPatternSet(params)
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional Regex Pattern Set (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.RegexPatternSet.Name)
+	d.Set("regex_pattern_strings", aws.StringValueSlice(resp.RegexPatternSet.RegexPatternStrings))
+
+	return nil
+}
+
+func resourceAwsWafRegionalRegexPatternSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("regex_pattern_strings") {
+		o, n := d.GetChange("regex_pattern_strings")
+		oldPatterns, newPatterns := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalRegexPatternSetPatternStrings(d.Id(), oldPatterns, newPatterns, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error updating WAF Regional Regex Pattern Set: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalRegexPatternSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalRegexPatternSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	oldPatterns := d.Get("regex_pattern_strings").(*schema.Set).List()
+	if len(oldPatterns) > 0 {
+		noPatterns := []interface{}{}
+		err := updateWafRegionalRegexPatternSetPatternStrings(d.Id(), oldPatterns, noPatterns, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error updating WAF Regional Regex Pattern Set: %s", err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteRegexPatternSetInput{
+			ChangeToken:       token,
+			RegexPatternSetId: aws.String(d.Id()),
+		}
+		log.Printf("[INFO] Deleting WAF Regional Regex Pattern Set: %s", req)
+		return conn.DeleteRegexPatternSet(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional Regex Pattern Set: %s", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalRegexPatternSetPatternStrings(id string, oldPatterns, newPatterns []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateRegexPatternSetInput{
+			ChangeToken:       token,
+			RegexPatternSetId: aws.String(id),
+			Updates:           diffWafRegexPatternSetPatternStrings(oldPatterns, newPatterns),
+		}
+
+		return conn.UpdateRegexPatternSet(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating WAF Regional Regex Pattern Set: %s", err)
+	}
+
+	return nil
+}
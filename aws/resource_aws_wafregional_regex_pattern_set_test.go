@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalRegexPatternSet_basic(t *testing.T) {
+	var v waf.RegexPatternSet
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_regex_pattern_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalRegexPatternSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalRegexPatternSetConfig(patternSetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", patternSetName),
+					resource.TestCheckResourceAttr(resourceName, "regex_pattern_strings.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalRegexPatternSetConfig_changePatterns(patternSetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex_pattern_strings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalRegexPatternSetExists(n string, v *waf.RegexPatternSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Regex Pattern Set ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetRegexPatternSet(&waf.GetRegexPatternSetInput{
+			RegexPatternSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.RegexPatternSet.RegexPatternSetId == rs.Primary.ID {
+			*v = *resp.RegexPatternSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional Regex Pattern Set (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegionalRegexPatternSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_regex_pattern_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetRegexPatternSet(&waf.GetRegexPatternSetInput{
+			RegexPatternSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.RegexPatternSet.RegexPatternSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional Regex Pattern Set %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegionalRegexPatternSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["one", "two"]
+}
+`, name)
+}
+
+func testAccAWSWafRegionalRegexPatternSetConfig_changePatterns(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["three"]
+}
+`, name)
+}
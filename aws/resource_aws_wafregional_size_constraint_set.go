@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalSizeConstraintSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalSizeConstraintSetCreate,
+		Read:   resourceAwsWafRegionalSizeConstraintSetRead,
+		Update: resourceAwsWafRegionalSizeConstraintSetUpdate,
+		Delete: resourceAwsWafRegionalSizeConstraintSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size_constraint": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_to_match": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"text_transformation": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalSizeConstraintSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional SizeConstraintSet: %s", d.Get("name").(string))
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateSizeConstraintSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		return conn.CreateSizeConstraintSet(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional SizeConstraintSet: %s", err)
+	}
+	resp := out.(*waf.CreateSizeConstraintSetOutput)
+
+	d.SetId(*resp.SizeConstraintSet.SizeConstraintSetId)
+
+	return resourceAwsWafRegionalSizeConstraintSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalSizeConstraintSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+
+	log.Printf("[INFO] Reading WAF Regional SizeConstraintSet: %s", d.Get("name").(string))
+	params := &waf.GetSizeConstraintSetInput{
+		SizeConstraintSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetSizeConstraintSet(params)
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional SizeConstraintSet (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.SizeConstraintSet.Name)
+	d.Set("size_constraint", flattenWafSizeConstraints(resp.SizeConstraintSet.SizeConstraints))
+
+	return nil
+}
+
+func resourceAwsWafRegionalSizeConstraintSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("size_constraint") {
+		o, n := d.GetChange("size_constraint")
+		oldS, newS := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalSizeConstraintSetResource(d.Id(), oldS, newS, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error updating WAF Regional SizeConstraintSet: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalSizeConstraintSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalSizeConstraintSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	oldConstraints := d.Get("size_constraint").(*schema.Set).List()
+
+	if len(oldConstraints) > 0 {
+		noConstraints := []interface{}{}
+		err := updateWafRegionalSizeConstraintSetResource(d.Id(), oldConstraints, noConstraints, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error deleting WAF Regional SizeConstraintSet: %s", err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteSizeConstraintSetInput{
+			ChangeToken:         token,
+			SizeConstraintSetId: aws.String(d.Id()),
+		}
+		return conn.DeleteSizeConstraintSet(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional SizeConstraintSet: %s", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalSizeConstraintSetResource(id string, oldS, newS []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateSizeConstraintSetInput{
+			ChangeToken:         token,
+			SizeConstraintSetId: aws.String(id),
+			Updates:             diffWafSizeConstraints(oldS, newS),
+		}
+
+		log.Printf("[INFO] Updating WAF Regional SizeConstraintSet constraints: %s", req)
+		return conn.UpdateSizeConstraintSet(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating WAF Regional SizeConstraintSet: %s", err)
+	}
+
+	return nil
+}
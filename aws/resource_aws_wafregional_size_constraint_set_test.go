@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalSizeConstraintSet_basic(t *testing.T) {
+	var v waf.SizeConstraintSet
+	setName := fmt.Sprintf("sizeConstraintSet-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_size_constraint_set.size_constraint_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalSizeConstraintSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalSizeConstraintSetConfig(setName, "EQ", 4096),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalSizeConstraintSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", setName),
+					resource.TestCheckResourceAttr(resourceName, "size_constraint.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalSizeConstraintSetConfig(setName, "GE", 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalSizeConstraintSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "size_constraint.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSWafRegionalSizeConstraintSet_noConstraints(t *testing.T) {
+	var v waf.SizeConstraintSet
+	setName := fmt.Sprintf("sizeConstraintSet-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_size_constraint_set.size_constraint_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalSizeConstraintSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalSizeConstraintSetConfig_noConstraints(setName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalSizeConstraintSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", setName),
+					resource.TestCheckResourceAttr(resourceName, "size_constraint.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalSizeConstraintSetExists(n string, v *waf.SizeConstraintSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional SizeConstraintSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetSizeConstraintSet(&waf.GetSizeConstraintSetInput{
+			SizeConstraintSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.SizeConstraintSet.SizeConstraintSetId == rs.Primary.ID {
+			*v = *resp.SizeConstraintSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional SizeConstraintSet (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegionalSizeConstraintSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_size_constraint_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetSizeConstraintSet(&waf.GetSizeConstraintSetInput{
+			SizeConstraintSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.SizeConstraintSet.SizeConstraintSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional SizeConstraintSet %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegionalSizeConstraintSetConfig(name, operator string, size int) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_size_constraint_set" "size_constraint_set" {
+  name = "%s"
+
+  size_constraint {
+    text_transformation = "NONE"
+    comparison_operator = "%s"
+    size                = %d
+
+    field_to_match {
+      type = "BODY"
+    }
+  }
+}
+`, name, operator, size)
+}
+
+func testAccAWSWafRegionalSizeConstraintSetConfig_noConstraints(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_size_constraint_set" "size_constraint_set" {
+  name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalSqlInjectionMatchSet_basic(t *testing.T) {
+	var v waf.SqlInjectionMatchSet
+	setName := fmt.Sprintf("sqlInjectionMatchSet-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_sql_injection_match_set.sql_injection_match_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalSqlInjectionMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalSqlInjectionMatchSetConfig(setName, "URL_DECODE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalSqlInjectionMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", setName),
+					resource.TestCheckResourceAttr(resourceName, "sql_injection_match_tuple.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalSqlInjectionMatchSetConfig(setName, "HTML_ENTITY_DECODE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalSqlInjectionMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sql_injection_match_tuple.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalSqlInjectionMatchSetExists(n string, v *waf.SqlInjectionMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional SqlInjectionMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetSqlInjectionMatchSet(&waf.GetSqlInjectionMatchSetInput{
+			SqlInjectionMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.SqlInjectionMatchSet.SqlInjectionMatchSetId == rs.Primary.ID {
+			*v = *resp.SqlInjectionMatchSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional SqlInjectionMatchSet (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegionalSqlInjectionMatchSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_sql_injection_match_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetSqlInjectionMatchSet(&waf.GetSqlInjectionMatchSetInput{
+			SqlInjectionMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.SqlInjectionMatchSet.SqlInjectionMatchSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional SqlInjectionMatchSet %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegionalSqlInjectionMatchSetConfig(name, transformation string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_sql_injection_match_set" "sql_injection_match_set" {
+  name = "%s"
+
+  sql_injection_match_tuple {
+    text_transformation = "%s"
+
+    field_to_match {
+      type = "QUERY_STRING"
+    }
+  }
+}
+`, name, transformation)
+}
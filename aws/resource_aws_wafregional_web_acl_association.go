@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalWebAclAssociationCreate,
+		Read:   resourceAwsWafRegionalWebAclAssociationRead,
+		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+
+	webAclID := d.Get("web_acl_id").(string)
+	resourceArn := d.Get("resource_arn").(string)
+
+	log.Printf("[INFO] Associating WAF Regional ACL %s with %s", webAclID, resourceArn)
+	_, err := conn.AssociateWebACL(&wafregional.AssociateWebACLInput{
+		ResourceArn: aws.String(resourceArn),
+		WebACLId:    aws.String(webAclID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error associating WAF Regional ACL %s with %s: %s", webAclID, resourceArn, err)
+	}
+
+	// The resource ARN contains colons, so it goes last.
+	d.SetId(fmt.Sprintf("%s:%s", webAclID, resourceArn))
+
+	return resourceAwsWafRegionalWebAclAssociationRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+
+	webAclID, resourceArn, err := decodeWafRegionalWebAclAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional ACL association (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading WAF Regional ACL association %s: %s", d.Id(), err)
+	}
+
+	if resp.WebACLSummary == nil || aws.StringValue(resp.WebACLSummary.WebACLId) != webAclID {
+		log.Printf("[WARN] WAF Regional ACL association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_arn", resourceArn)
+	d.Set("web_acl_id", webAclID)
+
+	return nil
+}
+
+func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+
+	_, resourceArn, err := decodeWafRegionalWebAclAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Disassociating WAF Regional ACL association: %s", d.Id())
+	_, err = conn.DisassociateWebACL(&wafregional.DisassociateWebACLInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error disassociating WAF Regional ACL association %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeWafRegionalWebAclAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected WEB_ACL_ID:RESOURCE_ARN", id)
+	}
+	return parts[0], parts[1], nil
+}
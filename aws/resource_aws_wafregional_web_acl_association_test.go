@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeWafRegionalWebAclAssociationID(t *testing.T) {
+	webAclID, resourceArn, err := decodeWafRegionalWebAclAssociationID("acl-id:arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188")
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if webAclID != "acl-id" {
+		t.Fatalf("expected web ACL ID %q, got %q", "acl-id", webAclID)
+	}
+	if resourceArn != "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188" {
+		t.Fatalf("unexpected resource ARN %q", resourceArn)
+	}
+
+	for _, id := range []string{"acl-id", ":arn:aws:elasticloadbalancing", "acl-id:"} {
+		if _, _, err := decodeWafRegionalWebAclAssociationID(id); err == nil {
+			t.Fatalf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalWebAclAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalWebAclAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclAssociationExists("aws_wafregional_web_acl_association.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalWebAclAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_web_acl_association" {
+			continue
+		}
+
+		webAclID, resourceArn, err := decodeWafRegionalWebAclAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if err != nil {
+			if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+				continue
+			}
+			return err
+		}
+
+		if resp.WebACLSummary != nil && aws.StringValue(resp.WebACLSummary.WebACLId) == webAclID {
+			return fmt.Errorf("WAF Regional WebACL association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalWebAclAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional WebACL association ID is set")
+		}
+
+		webAclID, resourceArn, err := decodeWafRegionalWebAclAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.WebACLSummary == nil || aws.StringValue(resp.WebACLSummary.WebACLId) != webAclID {
+			return fmt.Errorf("WAF Regional WebACL association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalWebAclAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "%s"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.1.${count.index}.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+}
+
+resource "aws_lb" "test" {
+  name     = "%s"
+  internal = true
+  subnets  = ["${aws_subnet.test.*.id}"]
+}
+
+resource "aws_wafregional_web_acl" "test" {
+  name        = "%s"
+  metric_name = "tfacctest%s"
+
+  default_action {
+    type = "ALLOW"
+  }
+}
+
+resource "aws_wafregional_web_acl_association" "test" {
+  resource_arn = "${aws_lb.test.arn}"
+  web_acl_id   = "${aws_wafregional_web_acl.test.id}"
+}
+`, rName, rName, rName, acctest.RandString(5))
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalWebAcl_basic(t *testing.T) {
+	var v waf.WebACL
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_web_acl.waf_acl"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalWebAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalWebAclConfig(wafAclName, "ALLOW", "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", wafAclName),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", wafAclName),
+					resource.TestCheckResourceAttr(resourceName, "default_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalWebAclConfig(wafAclName, "BLOCK", "COUNT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSWafRegionalWebAcl_noRules(t *testing.T) {
+	var v waf.WebACL
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_web_acl.waf_acl"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalWebAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalWebAclConfig_noRules(wafAclName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", wafAclName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDiffWafWebAclRules(t *testing.T) {
+	testCases := []struct {
+		Old             []interface{}
+		New             []interface{}
+		ExpectedUpdates []*waf.WebACLUpdate
+	}{
+		{
+			// Unchanged
+			Old: []interface{}{
+				map[string]interface{}{
+					"action":   []interface{}{map[string]interface{}{"type": "BLOCK"}},
+					"priority": 1,
+					"rule_id":  "rule-1",
+					"type":     "REGULAR",
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					"action":   []interface{}{map[string]interface{}{"type": "BLOCK"}},
+					"priority": 1,
+					"rule_id":  "rule-1",
+					"type":     "REGULAR",
+				},
+			},
+			ExpectedUpdates: []*waf.WebACLUpdate{},
+		},
+		{
+			// Change of action
+			Old: []interface{}{
+				map[string]interface{}{
+					"action":   []interface{}{map[string]interface{}{"type": "BLOCK"}},
+					"priority": 1,
+					"rule_id":  "rule-1",
+					"type":     "REGULAR",
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					"action":   []interface{}{map[string]interface{}{"type": "COUNT"}},
+					"priority": 1,
+					"rule_id":  "rule-1",
+					"type":     "REGULAR",
+				},
+			},
+			ExpectedUpdates: []*waf.WebACLUpdate{
+				{
+					Action: aws.String(waf.ChangeActionDelete),
+					ActivatedRule: &waf.ActivatedRule{
+						Action:   &waf.WafAction{Type: aws.String("BLOCK")},
+						Priority: aws.Int64(1),
+						RuleId:   aws.String("rule-1"),
+						Type:     aws.String("REGULAR"),
+					},
+				},
+				{
+					Action: aws.String(waf.ChangeActionInsert),
+					ActivatedRule: &waf.ActivatedRule{
+						Action:   &waf.WafAction{Type: aws.String("COUNT")},
+						Priority: aws.Int64(1),
+						RuleId:   aws.String("rule-1"),
+						Type:     aws.String("REGULAR"),
+					},
+				},
+			},
+		},
+		{
+			// Removal
+			Old: []interface{}{
+				map[string]interface{}{
+					"action":   []interface{}{map[string]interface{}{"type": "ALLOW"}},
+					"priority": 2,
+					"rule_id":  "rule-2",
+					"type":     "RATE_BASED",
+				},
+			},
+			New: []interface{}{},
+			ExpectedUpdates: []*waf.WebACLUpdate{
+				{
+					Action: aws.String(waf.ChangeActionDelete),
+					ActivatedRule: &waf.ActivatedRule{
+						Action:   &waf.WafAction{Type: aws.String("ALLOW")},
+						Priority: aws.Int64(2),
+						RuleId:   aws.String("rule-2"),
+						Type:     aws.String("RATE_BASED"),
+					},
+				},
+			},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			updates := diffWafWebAclRules(tc.Old, tc.New)
+			if !reflect.DeepEqual(updates, tc.ExpectedUpdates) {
+				t.Fatalf("WebACL updates don't match.\nGiven: %s\nExpected: %s",
+					updates, tc.ExpectedUpdates)
+			}
+		})
+	}
+}
+
+func testAccCheckAWSWafRegionalWebAclDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_web_acl" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
+			WebACLId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.WebACL.WebACLId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional WebACL %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalWebAclExists(n string, v *waf.WebACL) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional WebACL ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
+			WebACLId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.WebACL.WebACLId == rs.Primary.ID {
+			*v = *resp.WebACL
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional WebACL (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccAWSWafRegionalWebAclConfig(name, defaultAction, ruleAction string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule" "wafrule" {
+  name        = "%s"
+  metric_name = "%s"
+}
+
+resource "aws_wafregional_web_acl" "waf_acl" {
+  name        = "%s"
+  metric_name = "%s"
+
+  default_action {
+    type = "%s"
+  }
+
+  rule {
+    action {
+      type = "%s"
+    }
+
+    priority = 1
+    rule_id  = "${aws_wafregional_rule.wafrule.id}"
+  }
+}
+`, name, name, name, name, defaultAction, ruleAction)
+}
+
+func testAccAWSWafRegionalWebAclConfig_noRules(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_web_acl" "waf_acl" {
+  name        = "%s"
+  metric_name = "%s"
+
+  default_action {
+    type = "ALLOW"
+  }
+}
+`, name, name)
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalXssMatchSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalXssMatchSetCreate,
+		Read:   resourceAwsWafRegionalXssMatchSetRead,
+		Update: resourceAwsWafRegionalXssMatchSetUpdate,
+		Delete: resourceAwsWafRegionalXssMatchSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"xss_match_tuple": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_to_match": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"text_transformation": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalXssMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional XssMatchSet: %s", d.Get("name").(string))
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateXssMatchSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		return conn.CreateXssMatchSet(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional XssMatchSet: %s", err)
+	}
+	resp := out.(*waf.CreateXssMatchSetOutput)
+	d.SetId(*resp.XssMatchSet.XssMatchSetId)
+
+	return resourceAwsWafRegionalXssMatchSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalXssMatchSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+
+	log.Printf("[INFO] Reading WAF Regional XssMatchSet: %s", d.Get("name").(string))
+	params := &waf.GetXssMatchSetInput{
+		XssMatchSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetXssMatchSet(params)
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional XssMatchSet (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.XssMatchSet.Name)
+	d.Set("xss_match_tuple", flattenWafXssMatchTuples(resp.XssMatchSet.XssMatchTuples))
+
+	return nil
+}
+
+func resourceAwsWafRegionalXssMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("xss_match_tuple") {
+		o, n := d.GetChange("xss_match_tuple")
+		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalXssMatchSetResource(d.Id(), oldT, newT, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error updating WAF Regional XssMatchSet: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalXssMatchSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalXssMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).WAFRegional()
+	region := meta.(*AWSClient).region
+
+	oldTuples := d.Get("xss_match_tuple").(*schema.Set).List()
+
+	if len(oldTuples) > 0 {
+		noTuples := []interface{}{}
+		err := updateWafRegionalXssMatchSetResource(d.Id(), oldTuples, noTuples, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error deleting WAF Regional XssMatchSet: %s", err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteXssMatchSetInput{
+			ChangeToken:   token,
+			XssMatchSetId: aws.String(d.Id()),
+		}
+
+		return conn.DeleteXssMatchSet(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional XssMatchSet: %s", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalXssMatchSetResource(id string, oldT, newT []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateXssMatchSetInput{
+			ChangeToken:   token,
+			XssMatchSetId: aws.String(id),
+			Updates:       diffWafXssMatchSetTuples(oldT, newT),
+		}
+
+		log.Printf("[INFO] Updating WAF Regional XssMatchSet: %s", req)
+		return conn.UpdateXssMatchSet(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating WAF Regional XssMatchSet: %s", err)
+	}
+
+	return nil
+}
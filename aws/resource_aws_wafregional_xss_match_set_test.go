@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalXssMatchSet_basic(t *testing.T) {
+	var v waf.XssMatchSet
+	setName := fmt.Sprintf("xssMatchSet-%s", acctest.RandString(5))
+	resourceName := "aws_wafregional_xss_match_set.xss_match_set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalXssMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalXssMatchSetConfig(setName, "URL_DECODE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalXssMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", setName),
+					resource.TestCheckResourceAttr(resourceName, "xss_match_tuple.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalXssMatchSetConfig(setName, "HTML_ENTITY_DECODE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalXssMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "xss_match_tuple.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalXssMatchSetExists(n string, v *waf.XssMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional XssMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetXssMatchSet(&waf.GetXssMatchSetInput{
+			XssMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *resp.XssMatchSet.XssMatchSetId == rs.Primary.ID {
+			*v = *resp.XssMatchSet
+			return nil
+		}
+
+		return fmt.Errorf("WAF Regional XssMatchSet (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSWafRegionalXssMatchSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_xss_match_set" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).WAFRegional()
+		resp, err := conn.GetXssMatchSet(&waf.GetXssMatchSetInput{
+			XssMatchSetId: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if *resp.XssMatchSet.XssMatchSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional XssMatchSet %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSWafRegionalXssMatchSetConfig(name, transformation string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_xss_match_set" "xss_match_set" {
+  name = "%s"
+
+  xss_match_tuple {
+    text_transformation = "%s"
+
+    field_to_match {
+      type = "QUERY_STRING"
+    }
+  }
+}
+`, name, transformation)
+}
@@ -1,106 +1,107 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
-				})
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			req := &s3.PutBucketTaggingInput{
-				Bucket: aws.String(d.Get("bucket").(string)),
-				Tagging: &s3.Tagging{
-					TagSet: create,
-				},
-			}
-
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.PutBucketTagging(req)
-			})
-			if err != nil {
-				return err
-			}
-		}
+// s3BucketTagsAdapter updates the tags of an S3 bucket. S3 only replaces the
+// whole tag set, so each change reads the current set and writes it back.
+type s3BucketTagsAdapter struct {
+	conn   *s3.S3
+	bucket string
+}
+
+func (a s3BucketTagsAdapter) ListTags() (keyValueTags, error) {
+	tagSet, err := getTagSetS3(a.conn, a.bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKeyValueTagsS3(tagSet).IgnoreAws(), nil
+}
+
+func (a s3BucketTagsAdapter) TagResource(tags keyValueTags) error {
+	current, err := a.ListTags()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	for k, v := range tags {
+		current[k] = v
+	}
+	return a.putTags(current)
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+func (a s3BucketTagsAdapter) UntagResource(keys []string) error {
+	current, err := a.ListTags()
+	if err != nil {
+		return err
 	}
 
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
+	for _, k := range keys {
+		delete(current, k)
+	}
+	return a.putTags(current)
+}
+
+func (a s3BucketTagsAdapter) putTags(tags keyValueTags) error {
+	_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+		if len(tags) == 0 {
+			return a.conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
+				Bucket: aws.String(a.bucket),
+			})
 		}
+
+		return a.conn.PutBucketTagging(&s3.PutBucketTaggingInput{
+			Bucket: aws.String(a.bucket),
+			Tagging: &s3.Tagging{
+				TagSet: tags.S3Tags(),
+			},
+		})
+	})
+	return err
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
+	return updateTagsAll(d, s3BucketTagsAdapter{conn: conn, bucket: d.Get("bucket").(string)})
+}
+
+// newKeyValueTagsS3 returns the tags held in a list of S3 tags.
+func newKeyValueTagsS3(ts []*s3.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapS3(create), remove
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
+// S3Tags returns the tags as a list of S3 tags.
+func (tags keyValueTags) S3Tags() []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &s3.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
+	return newKeyValueTags(m).IgnoreAws().S3Tags()
+}
+
 // tagsToMap turns the list of tags into a map.
 func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return newKeyValueTagsS3(ts).IgnoreAws().Map()
 }
 
 // return a slice of s3 tags associated with the given s3 bucket. Essentially
@@ -121,17 +122,3 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 
 	return response.TagSet, nil
 }
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
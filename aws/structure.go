@@ -2691,6 +2691,16 @@ func sliceContainsMap(l []interface{}, m map[string]interface{}) (int, bool) {
 	return -1, false
 }
 
+func sliceContainsString(l []interface{}, s string) (int, bool) {
+	for i, t := range l {
+		if s == t.(string) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
 func expandAwsSsmTargets(d *schema.ResourceData) []*ssm.Target {
 	targets := make([]*ssm.Target, 0)
 
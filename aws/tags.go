@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -32,376 +30,406 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
-			}
+// IgnoreTagsConfig holds the tag keys and key prefixes configured in the
+// provider ignore_tags block. Tags matching them are left alone on every
+// resource unless the resource configures them itself.
+type IgnoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// Ignored returns whether the tag key matches the ignore_tags configuration.
+func (c *IgnoreTagsConfig) Ignored(key string) bool {
+	if c == nil {
+		return false
+	}
+	for _, k := range c.Keys {
+		if key == k {
+			return true
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
+	}
+	for _, p := range c.KeyPrefixes {
+		if strings.HasPrefix(key, p) {
+			return true
 		}
 	}
 
-	return nil
+	return false
 }
 
-func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("volume_tags") {
-		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+// tagsSchemaAll returns the schema to use for the computed tags_all attribute,
+// which holds the resource tags merged over the provider default_tags.
+func tagsSchemaAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
 
-		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
-		if err != nil {
-			return err
-		}
+// setTagsAllDiff is a CustomizeDiff function that plans tags_all as the
+// provider default_tags overridden by the resource tags.
+func setTagsAllDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// Tags with values not known until apply, e.g. interpolated from the
+	// attributes of another resource, have an unknown count and can't be read
+	// from the diff. The count of a new resource without tags is unset too, so
+	// its tags_all is also planned as computed.
+	if _, ok := diff.GetOk("tags.%"); !ok && (diff.Id() == "" || diff.HasChange("tags.%")) {
+		return diff.SetNewComputed("tags_all")
+	}
 
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: volumeIds,
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: volumeIds,
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
+	return diff.SetNew("tags_all", mergeDefaultTags(meta.(*AWSClient).defaultTags, diff.Get("tags").(map[string]interface{})))
+}
+
+// mergeDefaultTags returns the provider default tags overridden by the
+// resource tags.
+func mergeDefaultTags(defaultTags map[string]string, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
 	}
 
-	return nil
+	return result
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
+// setTagsAll saves the tags read from AWS in tags_all, and the ones not
+// inherited from the provider default_tags in tags. A tag with the same key
+// and value as a default tag is only kept in tags if it is already there.
+// Tags matching the provider ignore_tags are dropped unless they are
+// managed by the resource or the default tags.
+func setTagsAll(d *schema.ResourceData, meta interface{}, tags map[string]string) error {
+	client := meta.(*AWSClient)
+	current := d.Get("tags").(map[string]interface{})
+
+	allTags := make(map[string]string, len(tags))
+	resourceTags := make(map[string]string, len(tags))
+	for k, v := range tags {
+		_, inCurrent := current[k]
+		dv, inDefault := client.defaultTags[k]
+		if !inCurrent && !inDefault && client.ignoreTagsConfig.Ignored(k) {
+			continue
 		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
+		allTags[k] = v
+
+		if inDefault && dv == v && !inCurrent {
+			continue
 		}
+		resourceTags[k] = v
 	}
 
-	return nil
+	if err := d.Set("tags", resourceTags); err != nil {
+		return err
+	}
+	return d.Set("tags_all", allTags)
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+// elbv2TagsAdapter updates the tags of an ALB, NLB or target group.
+type elbv2TagsAdapter struct {
+	conn *elbv2.ELBV2
+	arn  string
+}
+
+func (a elbv2TagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.DescribeTags(&elbv2.DescribeTagsInput{
+		ResourceArns: []*string{aws.String(a.arn)},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t)
+	for _, t := range resp.TagDescriptions {
+		if aws.StringValue(t.ResourceArn) == a.arn {
+			return newKeyValueTagsELBv2(t.Tags).IgnoreAws(), nil
 		}
 	}
+	return keyValueTags{}, nil
+}
 
-	return tagsFromMap(create), remove
+func (a elbv2TagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTags(&elbv2.AddTagsInput{
+		ResourceArns: []*string{aws.String(a.arn)},
+		Tags:         tags.ELBv2Tags(),
+	})
+	return err
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
+func (a elbv2TagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTags(&elbv2.RemoveTagsInput{
+		ResourceArns: []*string{aws.String(a.arn)},
+		TagKeys:      aws.StringSlice(keys),
+	})
+	return err
+}
+
+func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
+	return updateTagsAll(d, elbv2TagsAdapter{conn: conn, arn: d.Id()})
+}
+
+// describeElbV2Tags returns the tags of the ELBv2 resource with the given ARN.
+func describeElbV2Tags(conn *elbv2.ELBV2, arn string) (map[string]string, error) {
+	tags, err := elbv2TagsAdapter{conn: conn, arn: arn}.ListTags()
+	if err != nil {
+		return nil, err
 	}
+	return tags.Map(), nil
+}
 
-	return result
+// ec2TagsAdapter updates the tags of one or more EC2 resources, retrying
+// while recently created resources are not found yet.
+type ec2TagsAdapter struct {
+	conn    *ec2.EC2
+	ids     []*string
+	timeout time.Duration
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
+func (a ec2TagsAdapter) ListTags() (keyValueTags, error) {
+	tags := make(keyValueTags)
+	err := a.conn.DescribeTagsPages(&ec2.DescribeTagsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: a.ids,
+			},
+		},
+	}, func(page *ec2.DescribeTagsOutput, lastPage bool) bool {
+		for _, t := range page.Tags {
+			tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return result
+	return tags.IgnoreAws(), nil
+}
+
+func (a ec2TagsAdapter) TagResource(tags keyValueTags) error {
+	return a.retry(func() error {
+		_, err := a.conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: a.ids,
+			Tags:      tags.Ec2Tags(),
+		})
+		return err
+	})
 }
 
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+func (a ec2TagsAdapter) UntagResource(keys []string) error {
+	tags := make([]*ec2.Tag, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, &ec2.Tag{Key: aws.String(k)})
 	}
 
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
+	return a.retry(func() error {
+		_, err := a.conn.DeleteTags(&ec2.DeleteTagsInput{
+			Resources: a.ids,
+			Tags:      tags,
+		})
+		return err
+	})
+}
+
+func (a ec2TagsAdapter) retry(f func() error) error {
+	return resource.Retry(a.timeout, func() *resource.RetryError {
+		err := f()
+		if err != nil {
+			ec2err, ok := err.(awserr.Error)
+			if ok && strings.Contains(ec2err.Code(), ".NotFound") {
+				return resource.RetryableError(err) // retry
+			}
+			return resource.NonRetryableError(err)
 		}
+		return nil
+	})
+}
+
+func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+	if d.HasChange("volume_tags") {
+		oraw, nraw := d.GetChange("volume_tags")
+
+		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
+		if err != nil {
+			return err
+		}
+
+		a := ec2TagsAdapter{conn: conn, ids: volumeIds, timeout: 2 * time.Minute}
+		return updateTags(a, oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 	}
 
-	return tagsFromMapELBv2(create), remove
+	return nil
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
+	return updateTagsAll(d, ec2TagsAdapter{conn: conn, ids: []*string{aws.String(d.Id())}, timeout: 5 * time.Minute})
 }
 
-// tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
+// newKeyValueTagsEc2 returns the tags held in a list of EC2 tags.
+func newKeyValueTagsEc2(ts []*ec2.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// tagsFromMapELBv2 returns the tags for the given map of data.
-func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
+// Ec2Tags returns the tags as a list of EC2 tags.
+func (tags keyValueTags) Ec2Tags() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ec2.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
+	return newKeyValueTags(m).IgnoreAws().Ec2Tags()
 }
 
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsToMap turns the list of tags into a map.
+func tagsToMap(ts []*ec2.Tag) map[string]string {
+	return newKeyValueTagsEc2(ts).IgnoreAws().Map()
 }
 
-// tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
-func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
-	result := make(map[string]string)
+// newKeyValueTagsELBv2 returns the tags held in a list of ELBv2 tags.
+func newKeyValueTagsELBv2(ts []*elbv2.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	return result
+
+	return tags
 }
 
-// tagsFromMapDynamoDb returns the tags for a given map
-func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
-	result := make([]*dynamodb.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dynamodb.Tag{
+// ELBv2Tags returns the tags as a list of ELBv2 tags.
+func (tags keyValueTags) ELBv2Tags() []*elbv2.Tag {
+	result := make([]*elbv2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elbv2.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		result = append(result, t)
+			Value: aws.String(tags[k]),
+		})
 	}
+
 	return result
 }
 
-// setTagsDynamoDb is a helper to set the tags for a dynamoDB resource
-// This is needed because dynamodb requires a completely different set and delete
-// method from the ec2 tag resource handling. Also the `UntagResource` method
-// for dynamoDB only requires a list of tag keys, instead of the full map of keys.
-func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		arn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
-					ResourceArn: aws.String(arn),
-					TagKeys:     remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), "ResourceNotFoundException") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
+// tagsToMapELBv2 turns the list of tags into a map.
+func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
+	return newKeyValueTagsELBv2(ts).IgnoreAws().Map()
+}
+
+// tagsFromMapELBv2 returns the tags for the given map of data.
+func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
+	return newKeyValueTags(m).IgnoreAws().ELBv2Tags()
+}
+
+// dynamoDbTagsAdapter updates the tags of a DynamoDB table, retrying while
+// the table is not found yet.
+type dynamoDbTagsAdapter struct {
+	conn *dynamodb.DynamoDB
+	arn  string
+}
+
+func (a dynamoDbTagsAdapter) ListTags() (keyValueTags, error) {
+	tags := make(keyValueTags)
+	input := &dynamodb.ListTagsOfResourceInput{
+		ResourceArn: aws.String(a.arn),
+	}
+	for {
+		resp, err := a.conn.ListTagsOfResource(input)
+		if err != nil {
+			return nil, err
 		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.TagResource(&dynamodb.TagResourceInput{
-					ResourceArn: aws.String(arn),
-					Tags:        create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), "ResourceNotFoundException") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
+		for k, v := range newKeyValueTagsDynamoDb(resp.Tags) {
+			tags[k] = v
+		}
+		if resp.NextToken == nil {
+			break
 		}
+		input.NextToken = resp.NextToken
 	}
 
-	return nil
+	return tags.IgnoreAws(), nil
 }
 
-// diffTagsDynamoDb takes a local set of dynamodb tags and the ones found remotely
-// and returns the set of tags that must be created as a map, and returns a list of tag keys
-// that must be destroyed.
-func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*string) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a dynamoDbTagsAdapter) TagResource(tags keyValueTags) error {
+	return a.retry(func() error {
+		_, err := a.conn.TagResource(&dynamodb.TagResourceInput{
+			ResourceArn: aws.String(a.arn),
+			Tags:        tags.DynamoDbTags(),
+		})
+		return err
+	})
+}
+
+func (a dynamoDbTagsAdapter) UntagResource(keys []string) error {
+	return a.retry(func() error {
+		_, err := a.conn.UntagResource(&dynamodb.UntagResourceInput{
+			ResourceArn: aws.String(a.arn),
+			TagKeys:     aws.StringSlice(keys),
+		})
+		return err
+	})
+}
 
-	var remove []*string
-	for _, t := range oldTags {
-		// Verify the old tag is not a tag we're currently attempting to create
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t.Key)
+func (a dynamoDbTagsAdapter) retry(f func() error) error {
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		err := f()
+		if err != nil {
+			ec2err, ok := err.(awserr.Error)
+			if ok && strings.Contains(ec2err.Code(), "ResourceNotFoundException") {
+				return resource.RetryableError(err) // retry
+			}
+			return resource.NonRetryableError(err)
 		}
+		return nil
+	})
+}
+
+// newKeyValueTagsDynamoDb returns the tags held in a list of DynamoDB tags.
+func newKeyValueTagsDynamoDb(ts []*dynamodb.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	return tagsFromMapDynamoDb(create), remove
+
+	return tags
+}
+
+// DynamoDbTags returns the tags as a list of DynamoDB tags.
+func (tags keyValueTags) DynamoDbTags() []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
+}
+
+// tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
+func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
+	return newKeyValueTagsDynamoDb(ts).IgnoreAws().Map()
+}
+
+// tagsFromMapDynamoDb returns the tags for a given map
+func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
+	return newKeyValueTags(m).IgnoreAws().DynamoDbTags()
+}
+
+// setTagsDynamoDb is a helper to set the tags for a dynamoDB resource
+func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
+	return updateTagsAll(d, dynamoDbTagsAdapter{conn: conn, arn: d.Get("arn").(string)})
 }
@@ -1,74 +1,39 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*elasticbeanstalk.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elasticbeanstalk.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+// newKeyValueTagsBeanstalk returns the tags held in a list of Elastic Beanstalk tags.
+func newKeyValueTagsBeanstalk(ts []*elasticbeanstalk.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapBeanstalk(create), remove
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
+// BeanstalkTags returns the tags as a list of Elastic Beanstalk tags.
+func (tags keyValueTags) BeanstalkTags() []*elasticbeanstalk.Tag {
 	var result []*elasticbeanstalk.Tag
-	for k, v := range m {
-		t := &elasticbeanstalk.Tag{
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticbeanstalk.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredBeanstalk(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
+	return newKeyValueTags(m).IgnoreAws().BeanstalkTags()
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsToMap turns the list of tags into a map.
+func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
+	return newKeyValueTagsBeanstalk(ts).IgnoreAws().Map()
 }
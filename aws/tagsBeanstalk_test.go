@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,57 +10,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
-func TestDiffBeanstalkTags(t *testing.T) {
-	cases := []struct {
-		Old, New       map[string]interface{}
-		Create, Remove map[string]string
-	}{
-		// Basic add/remove
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"bar": "baz",
-			},
-			Create: map[string]string{
-				"bar": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
-		},
-
-		// Modify
-		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"foo": "baz",
-			},
-			Create: map[string]string{
-				"foo": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
-		},
-	}
-
-	for i, tc := range cases {
-		c, r := diffTagsBeanstalk(tagsFromMapBeanstalk(tc.Old), tagsFromMapBeanstalk(tc.New))
-		cm := tagsToMapBeanstalk(c)
-		rm := tagsToMapBeanstalk(r)
-		if !reflect.DeepEqual(cm, tc.Create) {
-			t.Fatalf("%d: bad create: %#v", i, cm)
-		}
-		if !reflect.DeepEqual(rm, tc.Remove) {
-			t.Fatalf("%d: bad remove: %#v", i, rm)
-		}
-	}
-}
-
 func TestIgnoringTagsBeanstalk(t *testing.T) {
 	var ignoredTags []*elasticbeanstalk.Tag
 	ignoredTags = append(ignoredTags, &elasticbeanstalk.Tag{
@@ -72,10 +20,8 @@ func TestIgnoringTagsBeanstalk(t *testing.T) {
 		Key:   aws.String("aws:foo:bar"),
 		Value: aws.String("baz"),
 	})
-	for _, tag := range ignoredTags {
-		if !tagIgnoredBeanstalk(tag) {
-			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
-		}
+	if m := tagsToMapBeanstalk(ignoredTags); len(m) != 0 {
+		t.Fatalf("AWS specific tags not ignored, but should be: %#v", m)
 	}
 }
 
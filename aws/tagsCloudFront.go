@@ -1,98 +1,82 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
+// cloudFrontTagsAdapter updates the tags of a CloudFront distribution.
+type cloudFrontTagsAdapter struct {
+	conn *cloudfront.CloudFront
+	arn  string
+}
 
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
+func (a cloudFrontTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
+		Resource: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
-				Resource: aws.String(arn),
-				TagKeys: &cloudfront.TagKeys{
-					Items: k,
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
+	return newKeyValueTagsCloudFront(resp.Tags).IgnoreAws(), nil
+}
 
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.TagResource(&cloudfront.TagResourceInput{
-				Resource: aws.String(arn),
-				Tags: &cloudfront.Tags{
-					Items: create,
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
+func (a cloudFrontTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagResource(&cloudfront.TagResourceInput{
+		Resource: aws.String(a.arn),
+		Tags:     tags.CloudFrontTags(),
+	})
+	return err
+}
 
-	}
+func (a cloudFrontTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagResource(&cloudfront.UntagResourceInput{
+		Resource: aws.String(a.arn),
+		TagKeys: &cloudfront.TagKeys{
+			Items: aws.StringSlice(keys),
+		},
+	})
+	return err
+}
 
-	return nil
+func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, cloudFrontTagsAdapter{conn: conn, arn: arn})
 }
-func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags.Items {
-		create[*t.Key] = *t.Value
-	}
 
-	// Build the list of what to remove
-	var remove []*cloudfront.Tag
-	for _, t := range oldTags.Items {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+// newKeyValueTagsCloudFront returns the tags held in CloudFront tags.
+func newKeyValueTagsCloudFront(ts *cloudfront.Tags) keyValueTags {
+	tags := make(keyValueTags)
+	if ts == nil {
+		return tags
+	}
+	for _, t := range ts.Items {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	createTags := tagsFromMapCloudFront(create)
-	return createTags.Items, remove
+	return tags
 }
 
-func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
-	result := make([]*cloudfront.Tag, 0, len(m))
-	for k, v := range m {
+// CloudFrontTags returns the tags as CloudFront tags.
+func (tags keyValueTags) CloudFrontTags() *cloudfront.Tags {
+	result := make([]*cloudfront.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
 		result = append(result, &cloudfront.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
+			Value: aws.String(tags[k]),
 		})
 	}
 
-	tags := &cloudfront.Tags{
+	return &cloudfront.Tags{
 		Items: result,
 	}
+}
 
-	return tags
+func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
+	return newKeyValueTags(m).IgnoreAws().CloudFrontTags()
 }
 
 func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
-	result := make(map[string]string)
-
-	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
+	return newKeyValueTagsCloudFront(ts).IgnoreAws().Map()
 }
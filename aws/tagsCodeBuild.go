@@ -1,67 +1,39 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsCodeBuild(oldTags, newTags []*codebuild.Tag) ([]*codebuild.Tag, []*codebuild.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*codebuild.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+// newKeyValueTagsCodeBuild returns the tags held in a list of CodeBuild tags.
+func newKeyValueTagsCodeBuild(ts []*codebuild.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapCodeBuild(create), remove
+	return tags
 }
 
-func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
+// CodeBuildTags returns the tags as a list of CodeBuild tags.
+func (tags keyValueTags) CodeBuildTags() []*codebuild.Tag {
 	result := []*codebuild.Tag{}
-	for k, v := range m {
+	for _, k := range tags.Keys() {
 		result = append(result, &codebuild.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
+			Value: aws.String(tags[k]),
 		})
 	}
 
 	return result
 }
 
-func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
-	result := map[string]string{}
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
+	return newKeyValueTags(m).IgnoreAws().CodeBuildTags()
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredCodeBuild(t *codebuild.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsToMap turns the list of tags into a map.
+func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
+	return newKeyValueTagsCodeBuild(ts).IgnoreAws().Map()
 }
@@ -1,115 +1,79 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDS(tagsFromMapDS(o), tagsFromMapDS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+// dsTagsAdapter updates the tags of a Directory Service directory.
+type dsTagsAdapter struct {
+	conn *directoryservice.DirectoryService
+	id   string
+}
 
-			_, err := conn.RemoveTagsFromResource(&directoryservice.RemoveTagsFromResourceInput{
-				ResourceId: aws.String(resourceId),
-				TagKeys:    k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&directoryservice.AddTagsToResourceInput{
-				ResourceId: aws.String(resourceId),
-				Tags:       create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+func (a dsTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsForResource(&directoryservice.ListTagsForResourceInput{
+		ResourceId: aws.String(a.id),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return newKeyValueTagsDS(resp.Tags).IgnoreAws(), nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsDS(oldTags, newTags []*directoryservice.Tag) ([]*directoryservice.Tag, []*directoryservice.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a dsTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTagsToResource(&directoryservice.AddTagsToResourceInput{
+		ResourceId: aws.String(a.id),
+		Tags:       tags.DSTags(),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*directoryservice.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+func (a dsTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTagsFromResource(&directoryservice.RemoveTagsFromResourceInput{
+		ResourceId: aws.String(a.id),
+		TagKeys:    aws.StringSlice(keys),
+	})
+	return err
+}
 
-	return tagsFromMapDS(create), remove
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string) error {
+	return updateTagsAll(d, dsTagsAdapter{conn: conn, id: resourceId})
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
-	result := make([]*directoryservice.Tag, 0, len(m))
-	for k, v := range m {
-		t := &directoryservice.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDS(t) {
-			result = append(result, t)
-		}
+// newKeyValueTagsDS returns the tags held in a list of Directory Service tags.
+func newKeyValueTagsDS(ts []*directoryservice.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredDS(t) {
-			result[*t.Key] = *t.Value
-		}
+// DSTags returns the tags as a list of Directory Service tags.
+func (tags keyValueTags) DSTags() []*directoryservice.Tag {
+	result := make([]*directoryservice.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &directoryservice.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredDS(t *directoryservice.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
+	return newKeyValueTags(m).IgnoreAws().DSTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
+	return newKeyValueTagsDS(ts).IgnoreAws().Map()
 }
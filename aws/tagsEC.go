@@ -1,115 +1,79 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEC(tagsFromMapEC(o), tagsFromMapEC(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+// elasticacheTagsAdapter updates the tags of an ElastiCache resource.
+type elasticacheTagsAdapter struct {
+	conn *elasticache.ElastiCache
+	arn  string
+}
 
-			_, err := conn.RemoveTagsFromResource(&elasticache.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.AddTagsToResource(&elasticache.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+func (a elasticacheTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsForResource(&elasticache.ListTagsForResourceInput{
+		ResourceName: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return newKeyValueTagsEC(resp.TagList).IgnoreAws(), nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsEC(oldTags, newTags []*elasticache.Tag) ([]*elasticache.Tag, []*elasticache.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a elasticacheTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTagsToResource(&elasticache.AddTagsToResourceInput{
+		ResourceName: aws.String(a.arn),
+		Tags:         tags.ECTags(),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*elasticache.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+func (a elasticacheTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTagsFromResource(&elasticache.RemoveTagsFromResourceInput{
+		ResourceName: aws.String(a.arn),
+		TagKeys:      aws.StringSlice(keys),
+	})
+	return err
+}
 
-	return tagsFromMapEC(create), remove
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, elasticacheTagsAdapter{conn: conn, arn: arn})
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
-	result := make([]*elasticache.Tag, 0, len(m))
-	for k, v := range m {
-		t := &elasticache.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredEC(t) {
-			result = append(result, t)
-		}
+// newKeyValueTagsEC returns the tags held in a list of ElastiCache tags.
+func newKeyValueTagsEC(ts []*elasticache.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredEC(t) {
-			result[*t.Key] = *t.Value
-		}
+// ECTags returns the tags as a list of ElastiCache tags.
+func (tags keyValueTags) ECTags() []*elasticache.Tag {
+	result := make([]*elasticache.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticache.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredEC(t *elasticache.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
+	return newKeyValueTags(m).IgnoreAws().ECTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
+	return newKeyValueTagsEC(ts).IgnoreAws().Map()
 }
@@ -1,115 +1,79 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKMS(tagsFromMapKMS(o), tagsFromMapKMS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.TagKey
-			}
+// kmsTagsAdapter updates the tags of a KMS key.
+type kmsTagsAdapter struct {
+	conn  *kms.KMS
+	keyId string
+}
 
-			_, err := conn.UntagResource(&kms.UntagResourceInput{
-				KeyId:   aws.String(keyId),
-				TagKeys: k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&kms.TagResourceInput{
-				KeyId: aws.String(keyId),
-				Tags:  create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+func (a kmsTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListResourceTags(&kms.ListResourceTagsInput{
+		KeyId: aws.String(a.keyId),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return newKeyValueTagsKMS(resp.Tags).IgnoreAws(), nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsKMS(oldTags, newTags []*kms.Tag) ([]*kms.Tag, []*kms.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
-	}
+func (a kmsTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagResource(&kms.TagResourceInput{
+		KeyId: aws.String(a.keyId),
+		Tags:  tags.KMSTags(),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*kms.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.TagKey)]
-		if !ok || old != aws.StringValue(t.TagValue) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+func (a kmsTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagResource(&kms.UntagResourceInput{
+		KeyId:   aws.String(a.keyId),
+		TagKeys: aws.StringSlice(keys),
+	})
+	return err
+}
 
-	return tagsFromMapKMS(create), remove
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string) error {
+	return updateTagsAll(d, kmsTagsAdapter{conn: conn, keyId: keyId})
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
-	result := make([]*kms.Tag, 0, len(m))
-	for k, v := range m {
-		t := &kms.Tag{
-			TagKey:   aws.String(k),
-			TagValue: aws.String(v.(string)),
-		}
-		if !tagIgnoredKMS(t) {
-			result = append(result, t)
-		}
+// newKeyValueTagsKMS returns the tags held in a list of KMS tags.
+func newKeyValueTagsKMS(ts []*kms.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
 	}
 
-	return result
+	return tags
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapKMS(ts []*kms.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredKMS(t) {
-			result[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
-		}
+// KMSTags returns the tags as a list of KMS tags.
+func (tags keyValueTags) KMSTags() []*kms.Tag {
+	result := make([]*kms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &kms.Tag{
+			TagKey:   aws.String(k),
+			TagValue: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredKMS(t *kms.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.TagKey)
-		if r, _ := regexp.MatchString(v, *t.TagKey); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.TagKey, *t.TagValue)
-			return true
-		}
-	}
-	return false
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
+	return newKeyValueTags(m).IgnoreAws().KMSTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapKMS(ts []*kms.Tag) map[string]string {
+	return newKeyValueTagsKMS(ts).IgnoreAws().Map()
 }
@@ -1,50 +1,46 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsLambda(conn *lambda.Lambda, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsGeneric(o, n)
+// lambdaTagsAdapter updates the tags of a Lambda function.
+type lambdaTagsAdapter struct {
+	conn *lambda.Lambda
+	arn  string
+}
+
+func (a lambdaTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTags(&lambda.ListTagsInput{
+		Resource: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	return newKeyValueTagsGeneric(resp.Tags).IgnoreAws(), nil
+}
 
-			_, err := conn.UntagResource(&lambda.UntagResourceInput{
-				Resource: aws.String(arn),
-				TagKeys:  keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+func (a lambdaTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagResource(&lambda.TagResourceInput{
+		Resource: aws.String(a.arn),
+		Tags:     tags.GenericTags(),
+	})
+	return err
+}
 
-			_, err := conn.TagResource(&lambda.TagResourceInput{
-				Resource: aws.String(arn),
-				Tags:     create,
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
+func (a lambdaTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagResource(&lambda.UntagResourceInput{
+		Resource: aws.String(a.arn),
+		TagKeys:  aws.StringSlice(keys),
+	})
+	return err
+}
 
-	return nil
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsLambda(conn *lambda.Lambda, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, lambdaTagsAdapter{conn: conn, arn: arn})
 }
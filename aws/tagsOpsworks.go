@@ -1,50 +1,46 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsOpsworks(conn *opsworks.OpsWorks, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsGeneric(o, n)
+// opsworksTagsAdapter updates the tags of an OpsWorks stack or layer.
+type opsworksTagsAdapter struct {
+	conn *opsworks.OpsWorks
+	arn  string
+}
+
+func (a opsworksTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTags(&opsworks.ListTagsInput{
+		ResourceArn: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	return newKeyValueTagsGeneric(resp.Tags).IgnoreAws(), nil
+}
 
-			_, err := conn.UntagResource(&opsworks.UntagResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+func (a opsworksTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagResource(&opsworks.TagResourceInput{
+		ResourceArn: aws.String(a.arn),
+		Tags:        tags.GenericTags(),
+	})
+	return err
+}
 
-			_, err := conn.TagResource(&opsworks.TagResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        create,
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
+func (a opsworksTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagResource(&opsworks.UntagResourceInput{
+		ResourceArn: aws.String(a.arn),
+		TagKeys:     aws.StringSlice(keys),
+	})
+	return err
+}
 
-	return nil
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsOpsworks(conn *opsworks.OpsWorks, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, opsworksTagsAdapter{conn: conn, arn: arn})
 }
@@ -2,132 +2,89 @@ package aws
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&rds.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+// rdsTagsAdapter updates the tags of an RDS resource.
+type rdsTagsAdapter struct {
+	conn *rds.RDS
+	arn  string
+}
+
+func (a rdsTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsForResource(&rds.ListTagsForResourceInput{
+		ResourceName: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return newKeyValueTagsRDS(resp.TagList).IgnoreAws(), nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a rdsTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTagsToResource(&rds.AddTagsToResourceInput{
+		ResourceName: aws.String(a.arn),
+		Tags:         tags.RDSTags(),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*rds.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func (a rdsTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
+		ResourceName: aws.String(a.arn),
+		TagKeys:      aws.StringSlice(keys),
+	})
+	return err
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, rdsTagsAdapter{conn: conn, arn: arn})
+}
+
+// newKeyValueTagsRDS returns the tags held in a list of RDS tags.
+func newKeyValueTagsRDS(ts []*rds.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapRDS(create), remove
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
-	result := make([]*rds.Tag, 0, len(m))
-	for k, v := range m {
-		t := &rds.Tag{
+// RDSTags returns the tags as a list of RDS tags.
+func (tags keyValueTags) RDSTags() []*rds.Tag {
+	result := make([]*rds.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &rds.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRDS(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
+	return newKeyValueTags(m).IgnoreAws().RDSTags()
+}
+
 // tagsToMap turns the list of tags into a map.
 func tagsToMapRDS(ts []*rds.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredRDS(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return newKeyValueTagsRDS(ts).IgnoreAws().Map()
 }
 
-func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
-		ResourceName: aws.String(arn),
-	})
-
+func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, meta interface{}, arn string) error {
+	tags, err := rdsTagsAdapter{conn: conn, arn: arn}.ListTags()
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Error retreiving tags for ARN: %s", arn)
 	}
 
-	var dt []*rds.Tag
-	if len(resp.TagList) > 0 {
-		dt = resp.TagList
-	}
-
-	return d.Set("tags", tagsToMapRDS(dt))
-}
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredRDS(t *rds.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return setTagsAll(d, meta, tags.Map())
 }
@@ -1,108 +1,79 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRedshift(tagsFromMapRedshift(o), tagsFromMapRedshift(n))
+// redshiftTagsAdapter updates the tags of a Redshift resource.
+type redshiftTagsAdapter struct {
+	conn *redshift.Redshift
+	arn  string
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+func (a redshiftTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.DescribeTags(&redshift.DescribeTagsInput{
+		ResourceName: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			_, err := conn.DeleteTags(&redshift.DeleteTagsInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.CreateTags(&redshift.CreateTagsInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
+	tags := make(keyValueTags)
+	for _, r := range resp.TaggedResources {
+		if r.Tag != nil {
+			tags[aws.StringValue(r.Tag.Key)] = aws.StringValue(r.Tag.Value)
 		}
 	}
+	return tags.IgnoreAws(), nil
+}
 
-	return nil
+func (a redshiftTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.CreateTags(&redshift.CreateTagsInput{
+		ResourceName: aws.String(a.arn),
+		Tags:         tags.RedshiftTags(),
+	})
+	return err
 }
 
-func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*redshift.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a redshiftTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.DeleteTags(&redshift.DeleteTagsInput{
+		ResourceName: aws.String(a.arn),
+		TagKeys:      aws.StringSlice(keys),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*redshift.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, redshiftTagsAdapter{conn: conn, arn: arn})
+}
+
+func newKeyValueTagsRedshift(ts []*redshift.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapRedshift(create), remove
+	return tags
 }
 
-func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
-	result := make([]*redshift.Tag, 0, len(m))
-	for k, v := range m {
-		t := &redshift.Tag{
+func (tags keyValueTags) RedshiftTags() []*redshift.Tag {
+	result := make([]*redshift.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &redshift.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRedshift(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredRedshift(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
+	return newKeyValueTags(m).IgnoreAws().RedshiftTags()
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredRedshift(t *redshift.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
+	return newKeyValueTagsRedshift(ts).IgnoreAws().Map()
 }
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// daxTagsAdapter updates the tags of a DAX cluster.
+type daxTagsAdapter struct {
+	conn *dax.DAX
+	arn  string
+}
+
+func (a daxTagsAdapter) ListTags() (keyValueTags, error) {
+	tags := make(keyValueTags)
+	input := &dax.ListTagsInput{
+		ResourceName: aws.String(a.arn),
+	}
+	for {
+		resp, err := a.conn.ListTags(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range newKeyValueTagsDax(resp.Tags) {
+			tags[k] = v
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return tags.IgnoreAws(), nil
+}
+
+func (a daxTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.TagResource(&dax.TagResourceInput{
+		ResourceName: aws.String(a.arn),
+		Tags:         tags.DaxTags(),
+	})
+	return err
+}
+
+func (a daxTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.UntagResource(&dax.UntagResourceInput{
+		ResourceName: aws.String(a.arn),
+		TagKeys:      aws.StringSlice(keys),
+	})
+	return err
+}
+
+// setTagsDax updates the tags of the DAX resource with the given ARN to the
+// merged tags in its "tags_all" field.
+func setTagsDax(conn *dax.DAX, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, daxTagsAdapter{conn: conn, arn: arn})
+}
+
+// newKeyValueTagsDax returns the tags held in a list of DAX tags.
+func newKeyValueTagsDax(ts []*dax.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// DaxTags returns the tags as a list of DAX tags.
+func (tags keyValueTags) DaxTags() []*dax.Tag {
+	var result []*dax.Tag
+	for _, k := range tags.Keys() {
+		result = append(result, &dax.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapDax returns the DAX tags for the given map of data, leaving out
+// tags with the reserved "aws:" prefix.
+func tagsFromMapDax(m map[string]interface{}) []*dax.Tag {
+	return newKeyValueTags(m).IgnoreAws().DaxTags()
+}
+
+// tagsToMapDax turns the list of DAX tags into a map, leaving out tags with
+// the reserved "aws:" prefix.
+func tagsToMapDax(ts []*dax.Tag) map[string]string {
+	return newKeyValueTagsDax(ts).IgnoreAws().Map()
+}
@@ -6,86 +6,72 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func dmsTagsToMap(tags []*dms.Tag) map[string]string {
-	result := make(map[string]string)
+// dmsTagsAdapter updates the tags of a Database Migration Service resource.
+type dmsTagsAdapter struct {
+	conn *dms.DatabaseMigrationService
+	arn  string
+}
+
+func (a dmsTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsForResource(&dms.ListTagsForResourceInput{
+		ResourceArn: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newKeyValueTagsDms(resp.TagList).IgnoreAws(), nil
+}
+
+func (a dmsTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTagsToResource(&dms.AddTagsToResourceInput{
+		ResourceArn: aws.String(a.arn),
+		Tags:        tags.DmsTags(),
+	})
+	return err
+}
+
+func (a dmsTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
+		ResourceArn: aws.String(a.arn),
+		TagKeys:     aws.StringSlice(keys),
+	})
+	return err
+}
+
+func newKeyValueTagsDms(tags []*dms.Tag) keyValueTags {
+	result := make(keyValueTags, len(tags))
 
 	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
+		result[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
 	}
 
 	return result
 }
 
-func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
-	result := make([]*dms.Tag, 0, len(m))
+func (tags keyValueTags) DmsTags() []*dms.Tag {
+	result := make([]*dms.Tag, 0, len(tags))
 
-	for k, v := range m {
+	for _, k := range tags.Keys() {
 		result = append(result, &dms.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
+			Value: aws.String(tags[k]),
 		})
 	}
 
 	return result
 }
 
-func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	remove := []*dms.Tag{}
-	for _, t := range oldTags {
-		v, ok := create[*t.Key]
-		if !ok || v != *t.Value {
-			remove = append(remove, t)
-		}
-	}
-
-	return dmsTagsFromMap(create), remove
+func dmsTagsToMap(tags []*dms.Tag) map[string]string {
+	return newKeyValueTagsDms(tags).IgnoreAws().Map()
 }
 
-func dmsGetTagKeys(tags []*dms.Tag) []*string {
-	keys := []*string{}
-
-	for _, tag := range tags {
-		keys = append(keys, tag.Key)
-	}
-
-	return keys
+func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
+	return newKeyValueTags(m).IgnoreAws().DmsTags()
 }
 
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-
-		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
-
-		if len(remove) > 0 {
-			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     dmsGetTagKeys(remove),
-			})
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(add) > 0 {
-			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        add,
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
+	conn := meta.(*AWSClient).DatabaseMigrationService()
 
-	return nil
+	return updateTagsAll(d, dmsTagsAdapter{conn: conn, arn: arn})
 }
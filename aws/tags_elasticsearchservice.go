@@ -1,114 +1,80 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsElasticsearchService(tagsFromMapElasticsearchService(o), tagsFromMapElasticsearchService(n))
+// elasticsearchServiceTagsAdapter updates the tags of an Elasticsearch domain.
+type elasticsearchServiceTagsAdapter struct {
+	conn *elasticsearch.ElasticsearchService
+	arn  string
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
-			_, err := conn.RemoveTags(&elasticsearch.RemoveTagsInput{
-				ARN:     aws.String(arn),
-				TagKeys: k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.AddTags(&elasticsearch.AddTagsInput{
-				ARN:     aws.String(arn),
-				TagList: create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+func (a elasticsearchServiceTagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTags(&elasticsearch.ListTagsInput{
+		ARN: aws.String(a.arn),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return newKeyValueTagsElasticsearchService(resp.TagList).IgnoreAws(), nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsElasticsearchService(oldTags, newTags []*elasticsearch.Tag) ([]*elasticsearch.Tag, []*elasticsearch.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a elasticsearchServiceTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTags(&elasticsearch.AddTagsInput{
+		ARN:     aws.String(a.arn),
+		TagList: tags.ElasticsearchServiceTags(),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*elasticsearch.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func (a elasticsearchServiceTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTags(&elasticsearch.RemoveTagsInput{
+		ARN:     aws.String(a.arn),
+		TagKeys: aws.StringSlice(keys),
+	})
+	return err
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *schema.ResourceData, arn string) error {
+	return updateTagsAll(d, elasticsearchServiceTagsAdapter{conn: conn, arn: arn})
+}
+
+// newKeyValueTagsElasticsearchService returns the tags held in a list of
+// Elasticsearch tags.
+func newKeyValueTagsElasticsearchService(ts []*elasticsearch.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapElasticsearchService(create), remove
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapElasticsearchService(m map[string]interface{}) []*elasticsearch.Tag {
+// ElasticsearchServiceTags returns the tags as a list of Elasticsearch tags.
+func (tags keyValueTags) ElasticsearchServiceTags() []*elasticsearch.Tag {
 	var result []*elasticsearch.Tag
-	for k, v := range m {
-		t := &elasticsearch.Tag{
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticsearch.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredElasticsearchService(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredElasticsearchService(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapElasticsearchService(m map[string]interface{}) []*elasticsearch.Tag {
+	return newKeyValueTags(m).IgnoreAws().ElasticsearchServiceTags()
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredElasticsearchService(t *elasticsearch.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsToMap turns the list of tags into a map.
+func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
+	return newKeyValueTagsElasticsearchService(ts).IgnoreAws().Map()
 }
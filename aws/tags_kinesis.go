@@ -1,125 +1,97 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
+// kinesisTagsAdapter updates the tags of a Kinesis stream.
+type kinesisTagsAdapter struct {
+	conn       *kinesis.Kinesis
+	streamName string
+}
 
-	sn := d.Get("name").(string)
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKinesis(tagsFromMapKinesis(o), tagsFromMapKinesis(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.RemoveTagsFromStream(&kinesis.RemoveTagsFromStreamInput{
-				StreamName: aws.String(sn),
-				TagKeys:    k,
-			})
-			if err != nil {
-				return err
-			}
+func (a kinesisTagsAdapter) ListTags() (keyValueTags, error) {
+	tags := make(keyValueTags)
+	input := &kinesis.ListTagsForStreamInput{
+		StreamName: aws.String(a.streamName),
+	}
+	for {
+		resp, err := a.conn.ListTagsForStream(input)
+		if err != nil {
+			return nil, err
 		}
 
-		if len(create) > 0 {
-
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			t := make(map[string]*string)
-			for _, tag := range create {
-				t[*tag.Key] = tag.Value
-			}
-
-			_, err := conn.AddTagsToStream(&kinesis.AddTagsToStreamInput{
-				StreamName: aws.String(sn),
-				Tags:       t,
-			})
-			if err != nil {
-				return err
-			}
+		for k, v := range newKeyValueTagsKinesis(resp.Tags) {
+			tags[k] = v
 		}
+
+		if !aws.BoolValue(resp.HasMoreTags) || len(resp.Tags) == 0 {
+			break
+		}
+		input.ExclusiveStartTagKey = resp.Tags[len(resp.Tags)-1].Key
 	}
 
-	return nil
+	return tags.IgnoreAws(), nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsKinesis(oldTags, newTags []*kinesis.Tag) ([]*kinesis.Tag, []*kinesis.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a kinesisTagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.AddTagsToStream(&kinesis.AddTagsToStreamInput{
+		StreamName: aws.String(a.streamName),
+		Tags:       aws.StringMap(tags),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*kinesis.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func (a kinesisTagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.RemoveTagsFromStream(&kinesis.RemoveTagsFromStreamInput{
+		StreamName: aws.String(a.streamName),
+		TagKeys:    aws.StringSlice(keys),
+	})
+	return err
+}
+
+// Kinesis accepts at most 10 tags in a single add or remove call.
+func (a kinesisTagsAdapter) tagsBatchSize() int {
+	return 10
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
+	return updateTagsAll(d, kinesisTagsAdapter{conn: conn, streamName: d.Get("name").(string)})
+}
+
+// newKeyValueTagsKinesis returns the tags held in a list of Kinesis tags.
+func newKeyValueTagsKinesis(ts []*kinesis.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapKinesis(create), remove
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapKinesis(m map[string]interface{}) []*kinesis.Tag {
+// KinesisTags returns the tags as a list of Kinesis tags.
+func (tags keyValueTags) KinesisTags() []*kinesis.Tag {
 	var result []*kinesis.Tag
-	for k, v := range m {
-		t := &kinesis.Tag{
+	for _, k := range tags.Keys() {
+		result = append(result, &kinesis.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredKinesis(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapKinesis(ts []*kinesis.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredKinesis(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapKinesis(m map[string]interface{}) []*kinesis.Tag {
+	return newKeyValueTags(m).IgnoreAws().KinesisTags()
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredKinesis(t *kinesis.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsToMap turns the list of tags into a map.
+func tagsToMapKinesis(ts []*kinesis.Tag) map[string]string {
+	return newKeyValueTagsKinesis(ts).IgnoreAws().Map()
 }
@@ -1,111 +1,92 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsR53(tagsFromMapR53(o), tagsFromMapR53(n))
-
-		// Set tags
-		r := make([]*string, len(remove))
-		for i, t := range remove {
-			r[i] = t.Key
-		}
-		log.Printf("[DEBUG] Changing tags: \n\tadding: %#v\n\tremoving:%#v", create, remove)
-		req := &route53.ChangeTagsForResourceInput{
-			ResourceId:   aws.String(d.Id()),
-			ResourceType: aws.String(resourceType),
-		}
+// route53TagsAdapter updates the tags of a Route 53 hosted zone or health
+// check.
+type route53TagsAdapter struct {
+	conn         *route53.Route53
+	id           string
+	resourceType string
+}
 
-		if len(create) > 0 {
-			req.AddTags = create
-		}
-		if len(r) > 0 {
-			req.RemoveTagKeys = r
-		}
+func (a route53TagsAdapter) ListTags() (keyValueTags, error) {
+	resp, err := a.conn.ListTagsForResource(&route53.ListTagsForResourceInput{
+		ResourceId:   aws.String(a.id),
+		ResourceType: aws.String(a.resourceType),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		_, err := conn.ChangeTagsForResource(req)
-		if err != nil {
-			return err
-		}
+	if resp.ResourceTagSet == nil {
+		return make(keyValueTags), nil
 	}
+	return newKeyValueTagsR53(resp.ResourceTagSet.Tags).IgnoreAws(), nil
+}
 
-	return nil
+func (a route53TagsAdapter) TagResource(tags keyValueTags) error {
+	_, err := a.conn.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+		ResourceId:   aws.String(a.id),
+		ResourceType: aws.String(a.resourceType),
+		AddTags:      tags.R53Tags(),
+	})
+	return err
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsR53(oldTags, newTags []*route53.Tag) ([]*route53.Tag, []*route53.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+func (a route53TagsAdapter) UntagResource(keys []string) error {
+	_, err := a.conn.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+		ResourceId:    aws.String(a.id),
+		ResourceType:  aws.String(a.resourceType),
+		RemoveTagKeys: aws.StringSlice(keys),
+	})
+	return err
+}
 
-	// Build the list of what to remove
-	var remove []*route53.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+// Route 53 accepts at most 10 tags in a single change call.
+func (a route53TagsAdapter) tagsBatchSize() int {
+	return 10
+}
 
-	return tagsFromMapR53(create), remove
+// setTags is a helper to set the tags for a resource. It expects the
+// merged tags field to be named "tags_all"
+func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string) error {
+	return updateTagsAll(d, route53TagsAdapter{conn: conn, id: d.Id(), resourceType: resourceType})
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
-	result := make([]*route53.Tag, 0, len(m))
-	for k, v := range m {
-		t := &route53.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRoute53(t) {
-			result = append(result, t)
-		}
+// newKeyValueTagsR53 returns the tags held in a list of Route 53 tags.
+func newKeyValueTagsR53(ts []*route53.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapR53(ts []*route53.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredRoute53(t) {
-			result[*t.Key] = *t.Value
-		}
+// R53Tags returns the tags as a list of Route 53 tags.
+func (tags keyValueTags) R53Tags() []*route53.Tag {
+	result := make([]*route53.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &route53.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredRoute53(t *route53.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
+	return newKeyValueTags(m).IgnoreAws().R53Tags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapR53(ts []*route53.Tag) map[string]string {
+	return newKeyValueTagsR53(ts).IgnoreAws().Map()
 }
@@ -3,61 +3,157 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
-func TestDiffTags(t *testing.T) {
+func TestMergeDefaultTags(t *testing.T) {
 	cases := []struct {
-		Old, New       map[string]interface{}
-		Create, Remove map[string]string
+		Default  map[string]string
+		Tags     map[string]interface{}
+		Expected map[string]interface{}
 	}{
-		// Basic add/remove
 		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"bar": "baz",
-			},
-			Create: map[string]string{
-				"bar": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
+			Default:  nil,
+			Tags:     map[string]interface{}{"foo": "bar"},
+			Expected: map[string]interface{}{"foo": "bar"},
+		},
+		{
+			Default:  map[string]string{"foo": "bar"},
+			Tags:     map[string]interface{}{},
+			Expected: map[string]interface{}{"foo": "bar"},
+		},
+		{
+			Default:  map[string]string{"foo": "bar", "env": "default"},
+			Tags:     map[string]interface{}{"env": "production"},
+			Expected: map[string]interface{}{"foo": "bar", "env": "production"},
 		},
+	}
+
+	for i, tc := range cases {
+		m := mergeDefaultTags(tc.Default, tc.Tags)
+		if !reflect.DeepEqual(m, tc.Expected) {
+			t.Fatalf("%d: bad merged tags: %#v", i, m)
+		}
+	}
+}
 
-		// Modify
+func TestSetTagsAllDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+		CustomizeDiff: setTagsAllDiff,
+	}
+	meta := &AWSClient{defaultTags: map[string]string{"env": "default"}}
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"tags.%":        "1",
+			"tags.Name":     "test",
+			"tags_all.%":    "2",
+			"tags_all.Name": "test",
+			"tags_all.env":  "default",
+		},
+	}
+
+	cases := []struct {
+		State            *terraform.InstanceState
+		Tags             map[string]interface{}
+		Expected         map[string]string
+		ExpectedComputed bool
+	}{
 		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-			},
-			New: map[string]interface{}{
-				"foo": "baz",
-			},
-			Create: map[string]string{
-				"foo": "baz",
-			},
-			Remove: map[string]string{
-				"foo": "bar",
+			Tags:     map[string]interface{}{"Name": "test"},
+			Expected: map[string]string{"tags_all.%": "2", "tags_all.Name": "test", "tags_all.env": "default"},
+		},
+		{
+			Tags:     map[string]interface{}{"env": "production"},
+			Expected: map[string]string{"tags_all.%": "1", "tags_all.env": "production"},
+		},
+		{
+			State:    state,
+			Tags:     map[string]interface{}{"Name": "changed"},
+			Expected: map[string]string{"tags_all.Name": "changed"},
+		},
+		{
+			State:    state,
+			Tags:     map[string]interface{}{"Name": "test"},
+			Expected: map[string]string{},
+		},
+		{
+			State: &terraform.InstanceState{
+				ID:         "test",
+				Attributes: map[string]string{"tags.%": "0", "tags_all.%": "1", "tags_all.env": "default"},
 			},
+			Tags:     map[string]interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			// The count of the tags of a new resource without tags is unset
+			Tags:             map[string]interface{}{},
+			ExpectedComputed: true,
+		},
+		{
+			// The value is interpolated from an attribute not known until apply
+			Tags:             map[string]interface{}{"Name": "test", "Vpc": config.UnknownVariableValue},
+			ExpectedComputed: true,
+		},
+		{
+			State:            state,
+			Tags:             map[string]interface{}{"Name": "test", "Vpc": config.UnknownVariableValue},
+			ExpectedComputed: true,
 		},
 	}
 
 	for i, tc := range cases {
-		c, r := diffTags(tagsFromMap(tc.Old), tagsFromMap(tc.New))
-		cm := tagsToMap(c)
-		rm := tagsToMap(r)
-		if !reflect.DeepEqual(cm, tc.Create) {
-			t.Fatalf("%d: bad create: %#v", i, cm)
+		raw, err := config.NewRawConfig(map[string]interface{}{"tags": tc.Tags})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := r.Diff(tc.State, terraform.NewResourceConfig(raw), meta)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
 		}
-		if !reflect.DeepEqual(rm, tc.Remove) {
-			t.Fatalf("%d: bad remove: %#v", i, rm)
+
+		tagsAll := make(map[string]string)
+		computed := false
+		if diff != nil {
+			for k, a := range diff.Attributes {
+				if !strings.HasPrefix(k, "tags_all.") {
+					continue
+				}
+				if a.NewComputed {
+					computed = true
+					continue
+				}
+				if a.NewRemoved && tc.ExpectedComputed {
+					continue
+				}
+				tagsAll[k] = a.New
+			}
+		}
+
+		if computed != tc.ExpectedComputed {
+			t.Fatalf("%d: expected tags_all computed to be %t, got diff %#v", i, tc.ExpectedComputed, diff)
+		}
+		if tc.ExpectedComputed {
+			if len(tagsAll) > 0 {
+				t.Fatalf("%d: unexpected tags_all values in diff: %#v", i, tagsAll)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(tagsAll, tc.Expected) {
+			t.Fatalf("%d: bad tags_all in diff: %#v", i, tagsAll)
 		}
 	}
 }
@@ -73,11 +169,34 @@ func TestIgnoringTags(t *testing.T) {
 		Key:   aws.String("aws:foo:bar"),
 		Value: aws.String("baz"),
 	})
-	for _, tag := range ignoredTags {
-		if !tagIgnored(tag) {
-			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
+	if m := tagsToMap(ignoredTags); len(m) != 0 {
+		t.Fatalf("AWS specific tags not ignored, but should be: %#v", m)
+	}
+}
+
+func TestIgnoreTagsConfigIgnored(t *testing.T) {
+	c := &IgnoreTagsConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"kubernetes.io/"},
+	}
+	cases := map[string]bool{
+		"CostCenter":                    true,
+		"CostCenterOwner":               false,
+		"kubernetes.io/cluster/example": true,
+		"kubernetes":                    false,
+		"Name":                          false,
+	}
+
+	for k, expected := range cases {
+		if ignored := c.Ignored(k); ignored != expected {
+			t.Fatalf("%s: expected ignored %t, got %t", k, expected, ignored)
 		}
 	}
+
+	var nilConfig *IgnoreTagsConfig
+	if nilConfig.Ignored("CostCenter") {
+		t.Fatal("nil config should not ignore any tag")
+	}
 }
 
 // testAccCheckTags can be used to check the tags on a resource.
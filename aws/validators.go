@@ -2025,3 +2025,98 @@ func validateAwsElastiCacheReplicationGroupAuthToken(v interface{}, k string) (w
 	}
 	return
 }
+
+func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf("%q cannot be less than 3 characters", k))
+	} else if len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z()./_-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can only contain alphanumeric characters and ()./_- symbols", k))
+	}
+	return
+}
+
+func validateLaunchTemplateNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	// uuid is 26 characters, limit the prefix to 102.
+	value := v.(string)
+	if len(value) > 102 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 102 characters, name is limited to 128", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z()./_-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can only contain alphanumeric characters and ()./_- symbols", k))
+	}
+	return
+}
+
+func validateRFC3339TimeString(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: cannot parse '%s' as RFC3339 Timestamp Format", k, v))
+	}
+	return
+}
+
+func validateBudgetTimePeriodTimestamp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(budgetsTimePeriodLayout, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: cannot parse '%s' as a time period in the format YYYY-MM-DD_HH:MM", k, v))
+	}
+	return
+}
+
+func validateAppsyncName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter or underscore and contain only alphanumeric characters and underscores: %q", k, value))
+	}
+	return
+}
+
+func validateIoTTopicRuleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9_]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric characters and underscores: %q", k, value))
+	}
+	return
+}
+
+// validateDynamoDbName validates the name of a DynamoDB table, global table
+// or backup, which all follow the same naming rules.
+func validateDynamoDbName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 || len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 255 characters: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9_.-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric characters, underscores, dots and hyphens: %q", k, value))
+	}
+	return
+}
+
+func validateDynamoDbTableItem(v interface{}, k string) (ws []string, errors []error) {
+	attributes, err := expandDynamoDbTableItemAttributes(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a DynamoDB item in JSON format: %s", k, err))
+		return
+	}
+	if len(attributes) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one attribute", k))
+	}
+	return
+}
+
+func validateCloudFormationStackSetName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must start with a letter and contain only alphanumeric characters and hyphens", k))
+	}
+	return
+}
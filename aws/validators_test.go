@@ -2890,3 +2890,195 @@ func TestValidateCognitoUserPoolDomain(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"tf-launch-template",
+		"web_servers/v1.2",
+		"(staging)",
+		strings.Repeat("W", 128),
+	}
+	for _, v := range validNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid launch template name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"lt",
+		"launch template",
+		"launch-template!",
+		strings.Repeat("W", 129), // > 128
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid launch template name", v)
+		}
+	}
+}
+
+func TestValidateBudgetTimePeriodTimestamp(t *testing.T) {
+	validTimestamps := []string{
+		"2017-01-01_00:00",
+		"2087-06-15_00:00",
+	}
+	for _, v := range validTimestamps {
+		_, errors := validateBudgetTimePeriodTimestamp(v, "time_period_start")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid time period timestamp: %q", v, errors)
+		}
+	}
+
+	invalidTimestamps := []string{
+		"2017-01-01",
+		"2017-01-01T00:00:00Z",
+		"01/01/2017 00:00",
+	}
+	for _, v := range invalidTimestamps {
+		_, errors := validateBudgetTimePeriodTimestamp(v, "time_period_start")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid time period timestamp", v)
+		}
+	}
+}
+
+func TestValidateAppsyncName(t *testing.T) {
+	validNames := []string{
+		"tf_test",
+		"_tf_test",
+		"TFTest01",
+	}
+	for _, v := range validNames {
+		_, errors := validateAppsyncName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid AppSync name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"01tf_test",
+		"tf-test",
+		"tf test",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAppsyncName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid AppSync name", v)
+		}
+	}
+}
+
+func TestValidateIoTTopicRuleName(t *testing.T) {
+	validNames := []string{
+		"tf_test",
+		"TFTest01",
+		"01_rule",
+	}
+	for _, v := range validNames {
+		_, errors := validateIoTTopicRuleName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT topic rule name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"tf-test",
+		"tf test",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateIoTTopicRuleName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT topic rule name", v)
+		}
+	}
+}
+
+func TestValidateDynamoDbName(t *testing.T) {
+	validNames := []string{
+		"tf-test",
+		"tf_test.backup",
+		"TFTest01",
+		strings.Repeat("W", 255),
+	}
+	for _, v := range validNames {
+		_, errors := validateDynamoDbName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DynamoDB name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"tf",
+		"tf test",
+		"tf/test",
+		strings.Repeat("W", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateDynamoDbName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DynamoDB name", v)
+		}
+	}
+}
+
+func TestValidateDynamoDbTableItem(t *testing.T) {
+	validItems := []string{
+		`{"id": {"S": "one"}}`,
+		`{"id": {"S": "one"}, "count": {"N": "11"}, "tags": {"SS": ["a", "b"]}}`,
+		`{"id": {"N": "1"}, "config": {"M": {"enabled": {"BOOL": true}}}}`,
+	}
+	for _, v := range validItems {
+		_, errors := validateDynamoDbTableItem(v, "item")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DynamoDB item: %q", v, errors)
+		}
+	}
+
+	invalidItems := []string{
+		``,
+		`{}`,
+		`{"id": "one"}`,
+		`{"id": {"S": 1}}`,
+		`[{"id": {"S": "one"}}]`,
+	}
+	for _, v := range invalidItems {
+		_, errors := validateDynamoDbTableItem(v, "item")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DynamoDB item", v)
+		}
+	}
+}
+
+func TestValidateCloudFormationStackSetName(t *testing.T) {
+	validNames := []string{
+		"tf-test",
+		"TFTest01",
+		strings.Repeat("W", 128),
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudFormationStackSetName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudFormation Stack Set name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1tf-test",
+		"tf_test",
+		"tf test",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudFormationStackSetName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudFormation Stack Set name", v)
+		}
+	}
+}
@@ -17,15 +17,28 @@ type WafRetryer struct {
 type withTokenFunc func(token *string) (interface{}, error)
 
 func (t *WafRetryer) RetryWithToken(f withTokenFunc) (interface{}, error) {
-	awsMutexKV.Lock(t.Region)
-	defer awsMutexKV.Unlock(t.Region)
+	return retryWithWafChangeToken(t.Region, t.Connection.GetChangeToken, f)
+}
+
+func newWafRetryer(conn *waf.WAF, region string) *WafRetryer {
+	return &WafRetryer{Connection: conn, Region: region}
+}
+
+// retryWithWafChangeToken calls f with a fresh change token until it no
+// longer fails with stale data. Global and regional WAF share the token
+// semantics, so both retryers use it with their own GetChangeToken.
+// Changes are serialized per region, as WAF only hands out one valid token
+// at a time.
+func retryWithWafChangeToken(region string, getChangeToken func(*waf.GetChangeTokenInput) (*waf.GetChangeTokenOutput, error), f withTokenFunc) (interface{}, error) {
+	awsMutexKV.Lock(region)
+	defer awsMutexKV.Unlock(region)
 
 	var out interface{}
 	err := resource.Retry(15*time.Minute, func() *resource.RetryError {
 		var err error
 		var tokenOut *waf.GetChangeTokenOutput
 
-		tokenOut, err = t.Connection.GetChangeToken(&waf.GetChangeTokenInput{})
+		tokenOut, err = getChangeToken(&waf.GetChangeTokenInput{})
 		if err != nil {
 			return resource.NonRetryableError(errwrap.Wrapf("Failed to acquire change token: {{err}}", err))
 		}
@@ -43,7 +56,3 @@ func (t *WafRetryer) RetryWithToken(f withTokenFunc) (interface{}, error) {
 
 	return out, err
 }
-
-func newWafRetryer(conn *waf.WAF, region string) *WafRetryer {
-	return &WafRetryer{Connection: conn, Region: region}
-}